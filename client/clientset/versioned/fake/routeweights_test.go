@@ -0,0 +1,118 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fake
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	networkingv1beta1 "github.com/banzaicloud/istio-client-go/pkg/networking/v1beta1"
+)
+
+func TestPatchRouteWeights(t *testing.T) {
+	weight := func(w int32) *int32 {
+		return &w
+	}
+	subset := func(s string) *string {
+		return &s
+	}
+	destination := func(s string, w *int32) networkingv1beta1.HTTPRouteDestination {
+		return networkingv1beta1.HTTPRouteDestination{
+			Destination: &networkingv1beta1.Destination{Host: "reviews", Subset: subset(s)},
+			Weight:      w,
+		}
+	}
+	route := func(destinations ...networkingv1beta1.HTTPRouteDestination) []*networkingv1beta1.HTTPRouteDestination {
+		out := make([]*networkingv1beta1.HTTPRouteDestination, len(destinations))
+		for i := range destinations {
+			out[i] = &destinations[i]
+		}
+		return out
+	}
+
+	tests := []struct {
+		name     string
+		live     []*networkingv1beta1.HTTPRouteDestination
+		weights  []networkingv1beta1.HTTPRouteDestination
+		expected []*networkingv1beta1.HTTPRouteDestination
+		fails    bool
+	}{
+		{
+			name:     "weights",
+			live:     route(destination("v1", nil), destination("v2", weight(0))),
+			weights:  []networkingv1beta1.HTTPRouteDestination{destination("v1", weight(80)), destination("v2", weight(20))},
+			expected: route(destination("v1", weight(80)), destination("v2", weight(20))),
+		},
+		{
+			name:     "nil weight",
+			live:     route(destination("v1", weight(50)), destination("v2", weight(50))),
+			weights:  []networkingv1beta1.HTTPRouteDestination{destination("v1", weight(100)), destination("v2", nil)},
+			expected: route(destination("v1", weight(100)), destination("v2", weight(0))),
+		},
+		{
+			name:    "extra destination",
+			live:    route(destination("v1", weight(50)), destination("v2", weight(25)), destination("v3", weight(25))),
+			weights: []networkingv1beta1.HTTPRouteDestination{destination("v1", weight(80)), destination("v2", weight(20))},
+			fails:   true,
+		},
+		{
+			name:    "changed destination",
+			live:    route(destination("v1", weight(50)), destination("v3", weight(50))),
+			weights: []networkingv1beta1.HTTPRouteDestination{destination("v1", weight(80)), destination("v2", weight(20))},
+			fails:   true,
+		},
+		{
+			name:    "missing destination",
+			live:    route(destination("v1", weight(100))),
+			weights: []networkingv1beta1.HTTPRouteDestination{{Weight: weight(100)}},
+			fails:   true,
+		},
+	}
+
+	for _, test := range tests {
+		vs := &networkingv1beta1.VirtualService{
+			ObjectMeta: metav1.ObjectMeta{Name: "reviews", Namespace: "default"},
+			Spec: networkingv1beta1.VirtualServiceSpec{
+				Hosts: []string{"reviews"},
+				HTTP:  []networkingv1beta1.HTTPRoute{{Route: test.live}},
+			},
+		}
+		client := NewSimpleClientset(vs).NetworkingV1beta1().VirtualServices("default")
+
+		patched, err := client.PatchRouteWeights(context.Background(), "reviews", 0, test.weights, metav1.PatchOptions{})
+		if test.fails {
+			if err == nil {
+				t.Errorf("%s: expected the patch to fail", test.name)
+			}
+			actual, err := client.Get(context.Background(), "reviews", metav1.GetOptions{})
+			if err != nil {
+				t.Errorf("%s: unexpected error %v", test.name, err)
+			} else if !reflect.DeepEqual(actual.Spec.HTTP[0].Route, test.live) {
+				t.Errorf("%s: the failed patch changed the route to %v", test.name, actual.Spec.HTTP[0].Route)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error %v", test.name, err)
+			continue
+		}
+		if !reflect.DeepEqual(patched.Spec.HTTP[0].Route, test.expected) {
+			t.Errorf("%s: unexpected route %v, expected %v", test.name, patched.Spec.HTTP[0].Route, test.expected)
+		}
+	}
+}
@@ -0,0 +1,84 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fake
+
+import (
+	"context"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	clienttesting "k8s.io/client-go/testing"
+
+	metav1alpha1 "github.com/banzaicloud/istio-client-go/pkg/meta/v1alpha1"
+	networkingv1beta1 "github.com/banzaicloud/istio-client-go/pkg/networking/v1beta1"
+	securityv1beta1 "github.com/banzaicloud/istio-client-go/pkg/security/v1beta1"
+)
+
+func TestUpdateStatus(t *testing.T) {
+	vs := &networkingv1beta1.VirtualService{
+		ObjectMeta: metav1.ObjectMeta{Name: "reviews", Namespace: "default"},
+		Spec:       networkingv1beta1.VirtualServiceSpec{Hosts: []string{"reviews"}},
+	}
+	clientset := NewSimpleClientset(vs)
+
+	vs = vs.DeepCopy()
+	vs.Status = &metav1alpha1.IstioStatus{ObservedGeneration: 1}
+	vs.Status.SetCondition(metav1alpha1.IstioCondition{Type: metav1alpha1.ConditionReconciled, Status: metav1alpha1.ConditionStatusTrue})
+	updated, err := clientset.NetworkingV1beta1().VirtualServices("default").UpdateStatus(context.Background(), vs, metav1.UpdateOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if !updated.Status.IsReconciled(1) {
+		t.Errorf("unexpected status %v", updated.Status)
+	}
+
+	checkStatusAction(t, clientset.Actions(), "update")
+}
+
+func TestPatchStatus(t *testing.T) {
+	policy := &securityv1beta1.AuthorizationPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "deny-all", Namespace: "default"},
+		Spec:       securityv1beta1.AuthorizationPolicySpec{Action: securityv1beta1.AuthorizationPolicyActionDeny},
+	}
+	clientset := NewSimpleClientset(policy)
+
+	patch := []byte(`{"status":{"observedGeneration":1,"conditions":[{"type":"Reconciled","status":"True"}]}}`)
+	patched, err := clientset.SecurityV1beta1().AuthorizationPolicies("default").PatchStatus(context.Background(), "deny-all", types.MergePatchType, patch, metav1.PatchOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if !patched.Status.IsReconciled(1) {
+		t.Errorf("unexpected status %v", patched.Status)
+	}
+	if patched.Spec.Action != securityv1beta1.AuthorizationPolicyActionDeny {
+		t.Errorf("unexpected action %q", patched.Spec.Action)
+	}
+
+	checkStatusAction(t, clientset.Actions(), "patch")
+}
+
+// checkStatusAction checks that the only action is the given verb on the
+// status subresource.
+func checkStatusAction(t *testing.T, actions []clienttesting.Action, verb string) {
+	t.Helper()
+
+	if len(actions) != 1 {
+		t.Fatalf("unexpected actions %v", actions)
+	}
+	if actions[0].GetVerb() != verb || actions[0].GetSubresource() != "status" {
+		t.Errorf("unexpected action %s of %q, expected %s of the status", actions[0].GetVerb(), actions[0].GetSubresource(), verb)
+	}
+}
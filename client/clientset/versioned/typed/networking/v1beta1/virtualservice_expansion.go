@@ -0,0 +1,72 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1beta1
+
+import (
+	"context"
+	"fmt"
+
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/banzaicloud/istio-client-go/pkg/jsonpatch"
+	v1beta1 "github.com/banzaicloud/istio-client-go/pkg/networking/v1beta1"
+)
+
+// VirtualServiceExpansion has methods to work with VirtualService resources beyond the generated ones.
+type VirtualServiceExpansion interface {
+	// PatchStatus applies the patch to the status subresource of the virtualService.
+	PatchStatus(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions) (*v1beta1.VirtualService, error)
+	// PatchRouteWeights sets the weights of the destinations of the HTTP route at the given index,
+	// in the order of the destinations of the route. The destinations must be the ones of the route
+	// as they were read: the patch fails unless the route has exactly these destinations, so weights
+	// are never applied to a route which has been changed in the meantime. A nil weight is set as 0.
+	PatchRouteWeights(ctx context.Context, name string, route int, weights []v1beta1.HTTPRouteDestination, opts v1.PatchOptions) (*v1beta1.VirtualService, error)
+}
+
+// PatchStatus applies the patch to the status subresource of the virtualService and returns the patched virtualService.
+func (c *virtualServices) PatchStatus(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions) (*v1beta1.VirtualService, error) {
+	return c.Patch(ctx, name, pt, data, opts, "status")
+}
+
+// PatchRouteWeights sets the weights of the destinations of the HTTP route at the given index.
+func (c *virtualServices) PatchRouteWeights(ctx context.Context, name string, route int, weights []v1beta1.HTTPRouteDestination, opts v1.PatchOptions) (*v1beta1.VirtualService, error) {
+	path := jsonpatch.Path("spec", "http", route, "route")
+	expected := make([]v1beta1.HTTPRouteDestination, len(weights))
+	var patch jsonpatch.Patch
+	for i, weight := range weights {
+		if weight.Destination == nil {
+			return nil, fmt.Errorf("destination %d of route %d is missing", i, route)
+		}
+
+		var value int32
+		if weight.Weight != nil {
+			value = *weight.Weight
+		}
+		patch = patch.Add(path+jsonpatch.Path(i, "weight"), value)
+		weight.DeepCopyInto(&expected[i])
+		expected[i].Weight = &value
+	}
+	// the whole route is tested once weighted, so that the patch fails if destinations
+	// have been changed, added or removed in the meantime
+	patch = patch.Test(path, expected)
+
+	data, err := patch.Data()
+	if err != nil {
+		return nil, err
+	}
+
+	return c.Patch(ctx, name, types.JSONPatchType, data, opts)
+}
@@ -0,0 +1,40 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fake
+
+import (
+	"context"
+
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/banzaicloud/istio-client-go/pkg/jsonpatch"
+	v1beta1 "github.com/banzaicloud/istio-client-go/pkg/security/v1beta1"
+)
+
+// PatchStatus applies the patch to the status subresource of the authorizationPolicy and returns the patched authorizationPolicy.
+func (c *FakeAuthorizationPolicies) PatchStatus(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions) (*v1beta1.AuthorizationPolicy, error) {
+	return c.Patch(ctx, name, pt, data, opts, "status")
+}
+
+// SetAction sets the action of the authorizationPolicy.
+func (c *FakeAuthorizationPolicies) SetAction(ctx context.Context, name string, action v1beta1.AuthorizationPolicyAction, opts v1.PatchOptions) (*v1beta1.AuthorizationPolicy, error) {
+	data, err := jsonpatch.Patch{}.Add(jsonpatch.Path("spec", "action"), action).Data()
+	if err != nil {
+		return nil, err
+	}
+
+	return c.Patch(ctx, name, types.JSONPatchType, data, opts)
+}
@@ -0,0 +1,35 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1beta1
+
+import (
+	"context"
+
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	v1beta1 "github.com/banzaicloud/istio-client-go/pkg/security/v1beta1"
+)
+
+// PeerAuthenticationExpansion has methods to work with PeerAuthentication resources beyond the generated ones.
+type PeerAuthenticationExpansion interface {
+	// PatchStatus applies the patch to the status subresource of the peerAuthentication.
+	PatchStatus(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions) (*v1beta1.PeerAuthentication, error)
+}
+
+// PatchStatus applies the patch to the status subresource of the peerAuthentication and returns the patched peerAuthentication.
+func (c *peerAuthentications) PatchStatus(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions) (*v1beta1.PeerAuthentication, error) {
+	return c.Patch(ctx, name, pt, data, opts, "status")
+}
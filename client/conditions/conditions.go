@@ -0,0 +1,93 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conditions
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	metav1alpha1 "github.com/banzaicloud/istio-client-go/pkg/meta/v1alpha1"
+)
+
+// ToCondition converts an Istio condition to a metav1.Condition. The last probe
+// time has no counterpart in metav1.Condition and is dropped.
+func ToCondition(in *metav1alpha1.IstioCondition, observedGeneration int64) metav1.Condition {
+	return metav1.Condition{
+		Type:               in.Type,
+		Status:             metav1.ConditionStatus(in.Status),
+		ObservedGeneration: observedGeneration,
+		LastTransitionTime: toTime(in.LastTransitionTime),
+		Reason:             in.Reason,
+		Message:            in.Message,
+	}
+}
+
+// FromCondition converts a metav1.Condition to an Istio condition.
+func FromCondition(in metav1.Condition) *metav1alpha1.IstioCondition {
+	return &metav1alpha1.IstioCondition{
+		Type:               in.Type,
+		Status:             string(in.Status),
+		LastTransitionTime: fromTime(in.LastTransitionTime),
+		Reason:             in.Reason,
+		Message:            in.Message,
+	}
+}
+
+// ToConditions converts the conditions of an Istio status to metav1.Conditions.
+// The observed generation of the status is set on every converted condition.
+func ToConditions(status *metav1alpha1.IstioStatus) []metav1.Condition {
+	if status == nil || len(status.Conditions) == 0 {
+		return nil
+	}
+
+	conditions := make([]metav1.Condition, 0, len(status.Conditions))
+	for _, condition := range status.Conditions {
+		if condition == nil {
+			continue
+		}
+		conditions = append(conditions, ToCondition(condition, status.ObservedGeneration))
+	}
+
+	return conditions
+}
+
+// FromConditions converts metav1.Conditions to Istio conditions.
+func FromConditions(conditions []metav1.Condition) []*metav1alpha1.IstioCondition {
+	if len(conditions) == 0 {
+		return nil
+	}
+
+	out := make([]*metav1alpha1.IstioCondition, 0, len(conditions))
+	for _, condition := range conditions {
+		out = append(out, FromCondition(condition))
+	}
+
+	return out
+}
+
+func toTime(in *metav1.Time) metav1.Time {
+	if in == nil {
+		return metav1.Time{}
+	}
+
+	return *in.DeepCopy()
+}
+
+func fromTime(in metav1.Time) *metav1.Time {
+	if in.IsZero() {
+		return nil
+	}
+
+	return in.DeepCopy()
+}
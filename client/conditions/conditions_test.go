@@ -0,0 +1,162 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conditions
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	metav1alpha1 "github.com/banzaicloud/istio-client-go/pkg/meta/v1alpha1"
+)
+
+func TestToConditions(t *testing.T) {
+	transition := metav1.NewTime(time.Unix(1600000000, 0))
+	probe := metav1.NewTime(time.Unix(1600000100, 0))
+
+	tests := []struct {
+		name     string
+		status   *metav1alpha1.IstioStatus
+		expected []metav1.Condition
+	}{
+		{
+			name: "conditions",
+			status: &metav1alpha1.IstioStatus{
+				ObservedGeneration: 4,
+				Conditions: []*metav1alpha1.IstioCondition{
+					{
+						Type:               metav1alpha1.ConditionReconciled,
+						Status:             metav1alpha1.ConditionStatusTrue,
+						LastProbeTime:      &probe,
+						LastTransitionTime: &transition,
+						Reason:             "Reconciled",
+						Message:            "configuration distributed to all proxies",
+					},
+					nil,
+					{
+						Type:   metav1alpha1.ConditionHealthy,
+						Status: metav1alpha1.ConditionStatusUnknown,
+					},
+				},
+			},
+			expected: []metav1.Condition{
+				{
+					Type:               metav1alpha1.ConditionReconciled,
+					Status:             metav1.ConditionTrue,
+					ObservedGeneration: 4,
+					LastTransitionTime: transition,
+					Reason:             "Reconciled",
+					Message:            "configuration distributed to all proxies",
+				},
+				{
+					Type:               metav1alpha1.ConditionHealthy,
+					Status:             metav1.ConditionUnknown,
+					ObservedGeneration: 4,
+				},
+			},
+		},
+		{
+			name:   "no conditions",
+			status: &metav1alpha1.IstioStatus{ObservedGeneration: 4},
+		},
+		{
+			name: "nil status",
+		},
+	}
+
+	for _, test := range tests {
+		if actual := ToConditions(test.status); !reflect.DeepEqual(actual, test.expected) {
+			t.Errorf("%s: unexpected conditions %v, expected %v", test.name, actual, test.expected)
+		}
+	}
+}
+
+func TestFromConditions(t *testing.T) {
+	transition := metav1.NewTime(time.Unix(1600000000, 0))
+
+	tests := []struct {
+		name       string
+		conditions []metav1.Condition
+		expected   []*metav1alpha1.IstioCondition
+	}{
+		{
+			name: "conditions",
+			conditions: []metav1.Condition{
+				{
+					Type:               metav1alpha1.ConditionReconciled,
+					Status:             metav1.ConditionFalse,
+					ObservedGeneration: 4,
+					LastTransitionTime: transition,
+					Reason:             "Pending",
+					Message:            "waiting for the proxies",
+				},
+				{
+					Type:   metav1alpha1.ConditionHealthy,
+					Status: metav1.ConditionTrue,
+				},
+			},
+			expected: []*metav1alpha1.IstioCondition{
+				{
+					Type:               metav1alpha1.ConditionReconciled,
+					Status:             metav1alpha1.ConditionStatusFalse,
+					LastTransitionTime: &transition,
+					Reason:             "Pending",
+					Message:            "waiting for the proxies",
+				},
+				{
+					Type:   metav1alpha1.ConditionHealthy,
+					Status: metav1alpha1.ConditionStatusTrue,
+				},
+			},
+		},
+		{
+			name: "no conditions",
+		},
+	}
+
+	for _, test := range tests {
+		if actual := FromConditions(test.conditions); !reflect.DeepEqual(actual, test.expected) {
+			t.Errorf("%s: unexpected conditions %v, expected %v", test.name, actual, test.expected)
+		}
+	}
+}
+
+func TestConditionRoundTrip(t *testing.T) {
+	transition := metav1.NewTime(time.Unix(1600000000, 0))
+	in := &metav1alpha1.IstioCondition{
+		Type:               metav1alpha1.ConditionReconciled,
+		Status:             metav1alpha1.ConditionStatusTrue,
+		LastTransitionTime: &transition,
+		Reason:             "Reconciled",
+		Message:            "configuration distributed to all proxies",
+	}
+
+	condition := ToCondition(in, 7)
+	if condition.ObservedGeneration != 7 {
+		t.Errorf("unexpected observed generation %d, expected 7", condition.ObservedGeneration)
+	}
+	if out := FromCondition(condition); !reflect.DeepEqual(out, in) {
+		t.Errorf("unexpected condition %v, expected %v", out, in)
+	}
+
+	// the converted condition does not share the transition time of the
+	// original one
+	condition.LastTransitionTime.Time = time.Time{}
+	if in.LastTransitionTime.IsZero() {
+		t.Error("modifying the converted condition changed the original")
+	}
+}
@@ -0,0 +1,149 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package conversion converts the networking kinds served in more than one
+// version between their versions, and serves the conversion webhook of their
+// CustomResourceDefinitions.
+//
+// Conversions go through the hub version of a kind, the type with a Hub
+// method, the same way the conversion webhook of controller-runtime does.
+// The spoke versions do not implement the Convertible interface of
+// controller-runtime, because that would make the API types depend on it;
+// their conversions to and from the hub are registered here instead.
+package conversion
+
+import (
+	"fmt"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	networkingconversion "github.com/banzaicloud/istio-client-go/pkg/networking/conversion"
+	networkingv1alpha3 "github.com/banzaicloud/istio-client-go/pkg/networking/v1alpha3"
+	networkingv1beta1 "github.com/banzaicloud/istio-client-go/pkg/networking/v1beta1"
+	"github.com/banzaicloud/istio-client-go/pkg/registry"
+)
+
+// Func converts in into out, an object of the same kind in another version.
+type Func func(in, out runtime.Object) error
+
+type conversionKey struct {
+	from, to schema.GroupVersionKind
+}
+
+var (
+	funcsMu sync.RWMutex
+	funcs   = map[conversionKey]Func{}
+)
+
+func init() {
+	for _, kinds := range [][2]schema.GroupVersionKind{
+		{networkingv1alpha3.DestinationRuleGroupVersionKind, networkingv1beta1.DestinationRuleGroupVersionKind},
+		{networkingv1alpha3.GatewayGroupVersionKind, networkingv1beta1.GatewayGroupVersionKind},
+		{networkingv1alpha3.ServiceEntryGroupVersionKind, networkingv1beta1.ServiceEntryGroupVersionKind},
+		{networkingv1alpha3.SidecarGroupVersionKind, networkingv1beta1.SidecarGroupVersionKind},
+		{networkingv1alpha3.VirtualServiceGroupVersionKind, networkingv1beta1.VirtualServiceGroupVersionKind},
+		{networkingv1alpha3.WorkloadEntryGroupVersionKind, networkingv1beta1.WorkloadEntryGroupVersionKind},
+	} {
+		Register(kinds[0], kinds[1], networkingconversion.Convert)
+		Register(kinds[1], kinds[0], networkingconversion.Convert)
+	}
+}
+
+// Register registers the function that converts from one version of a kind
+// to another, replacing the previously registered one. Conversions are only
+// looked up between a hub and its spokes.
+func Register(from, to schema.GroupVersionKind, fn Func) {
+	funcsMu.Lock()
+	defer funcsMu.Unlock()
+
+	funcs[conversionKey{from: from, to: to}] = fn
+}
+
+func lookup(from, to schema.GroupVersionKind) (Func, error) {
+	funcsMu.RLock()
+	defer funcsMu.RUnlock()
+
+	fn, ok := funcs[conversionKey{from: from, to: to}]
+	if !ok {
+		return nil, fmt.Errorf("no conversion from %s to %s", from, to)
+	}
+
+	return fn, nil
+}
+
+// Convert converts the object to the given version of its kind. The object
+// must have its group version kind set. The object is returned as is when it
+// already has the requested version.
+func Convert(in runtime.Object, version string) (runtime.Object, error) {
+	from := in.GetObjectKind().GroupVersionKind()
+	if from.Empty() {
+		return nil, fmt.Errorf("object of type %T has no group version kind", in)
+	}
+	if from.Version == version {
+		return in, nil
+	}
+
+	to := from.GroupKind().WithVersion(version)
+	hub, err := hubOf(from.GroupKind())
+	if err != nil {
+		return nil, err
+	}
+
+	if from != hub && to != hub {
+		in, err = convert(in, from, hub)
+		if err != nil {
+			return nil, err
+		}
+		from = hub
+	}
+
+	return convert(in, from, to)
+}
+
+func convert(in runtime.Object, from, to schema.GroupVersionKind) (runtime.Object, error) {
+	fn, err := lookup(from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	kind, ok := registry.ForKind(to)
+	if !ok {
+		return nil, fmt.Errorf("unknown kind %s", to)
+	}
+
+	out := kind.New()
+	if err := fn(in, out); err != nil {
+		return nil, fmt.Errorf("could not convert %s to %s: %w", from, to, err)
+	}
+	out.GetObjectKind().SetGroupVersionKind(to)
+
+	return out, nil
+}
+
+// hubOf returns the version of the group kind that implements the Hub
+// interface of controller-runtime.
+func hubOf(gk schema.GroupKind) (schema.GroupVersionKind, error) {
+	for _, kind := range registry.Kinds() {
+		if kind.GroupVersionKind.GroupKind() != gk {
+			continue
+		}
+		if _, ok := kind.New().(interface{ Hub() }); ok {
+			return kind.GroupVersionKind, nil
+		}
+	}
+
+	return schema.GroupVersionKind{}, fmt.Errorf("%s has no hub version", gk)
+}
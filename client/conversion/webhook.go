@@ -0,0 +1,125 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conversion
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/banzaicloud/istio-client-go/pkg/registry"
+)
+
+// WebhookPath is the path the conversion webhook is usually mounted at.
+const WebhookPath = "/convert"
+
+// Webhook serves the ConversionReview requests the API server sends for the
+// CustomResourceDefinitions with the Webhook conversion strategy. Mount it on
+// a TLS server the API server can reach, e.g.
+//
+//	mux.Handle(conversion.WebhookPath, &conversion.Webhook{})
+//
+// and point the CRDs at it with crd.SetConversionWebhook.
+type Webhook struct{}
+
+// ServeHTTP implements http.Handler.
+func (wh *Webhook) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	review := &apiextensionsv1.ConversionReview{}
+	if err := json.NewDecoder(r.Body).Decode(review); err != nil {
+		http.Error(w, fmt.Sprintf("could not decode conversion review: %v", err), http.StatusBadRequest)
+		return
+	}
+	if review.Request == nil {
+		http.Error(w, "conversion review has no request", http.StatusBadRequest)
+		return
+	}
+
+	review.Response = wh.convert(review.Request)
+	review.Request = nil
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(review); err != nil {
+		http.Error(w, fmt.Sprintf("could not encode conversion review: %v", err), http.StatusInternalServerError)
+	}
+}
+
+func (wh *Webhook) convert(req *apiextensionsv1.ConversionRequest) *apiextensionsv1.ConversionResponse {
+	resp := &apiextensionsv1.ConversionResponse{
+		UID: req.UID,
+	}
+
+	desired, err := schema.ParseGroupVersion(req.DesiredAPIVersion)
+	if err != nil {
+		resp.Result = failure(err)
+		return resp
+	}
+
+	resp.ConvertedObjects = make([]runtime.RawExtension, 0, len(req.Objects))
+	for _, object := range req.Objects {
+		converted, err := convertRaw(object.Raw, desired)
+		if err != nil {
+			resp.ConvertedObjects = nil
+			resp.Result = failure(err)
+			return resp
+		}
+		resp.ConvertedObjects = append(resp.ConvertedObjects, runtime.RawExtension{Raw: converted})
+	}
+	resp.Result = metav1.Status{
+		Status: metav1.StatusSuccess,
+	}
+
+	return resp
+}
+
+func convertRaw(data []byte, desired schema.GroupVersion) ([]byte, error) {
+	typeMeta := &metav1.TypeMeta{}
+	if err := json.Unmarshal(data, typeMeta); err != nil {
+		return nil, err
+	}
+
+	gvk := typeMeta.GroupVersionKind()
+	if gvk.Group != desired.Group {
+		return nil, fmt.Errorf("cannot convert %s to %s", gvk, desired)
+	}
+
+	kind, ok := registry.ForKind(gvk)
+	if !ok {
+		return nil, fmt.Errorf("unknown kind %s", gvk)
+	}
+
+	in := kind.New()
+	if err := json.Unmarshal(data, in); err != nil {
+		return nil, fmt.Errorf("could not decode %s: %w", gvk, err)
+	}
+
+	out, err := Convert(in, desired.Version)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(out)
+}
+
+func failure(err error) metav1.Status {
+	return metav1.Status{
+		Status:  metav1.StatusFailure,
+		Message: err.Error(),
+	}
+}
@@ -0,0 +1,195 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conversion
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+
+	networkingv1alpha3 "github.com/banzaicloud/istio-client-go/pkg/networking/v1alpha3"
+	networkingv1beta1 "github.com/banzaicloud/istio-client-go/pkg/networking/v1beta1"
+)
+
+func TestConvert(t *testing.T) {
+	in := &networkingv1beta1.VirtualService{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "networking.istio.io/v1beta1", Kind: "VirtualService"},
+		ObjectMeta: metav1.ObjectMeta{Name: "reviews", Namespace: "default"},
+		Spec: networkingv1beta1.VirtualServiceSpec{
+			Hosts:    []string{"reviews"},
+			Gateways: []string{"mesh"},
+		},
+	}
+
+	spoke, err := Convert(in, "v1alpha3")
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	vs, ok := spoke.(*networkingv1alpha3.VirtualService)
+	if !ok {
+		t.Fatalf("unexpected type %T", spoke)
+	}
+	if vs.APIVersion != "networking.istio.io/v1alpha3" || !reflect.DeepEqual(vs.Spec.Hosts, in.Spec.Hosts) {
+		t.Errorf("unexpected conversion %v", vs)
+	}
+
+	hub, err := Convert(spoke, "v1beta1")
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if !reflect.DeepEqual(hub, in) {
+		t.Errorf("the round trip changed the object to %v", hub)
+	}
+
+	if same, err := Convert(in, "v1beta1"); err != nil || same != runtime.Object(in) {
+		t.Errorf("unexpected conversion to the same version %v, %v", same, err)
+	}
+	if _, err := Convert(&networkingv1beta1.VirtualService{}, "v1alpha3"); err == nil {
+		t.Error("expected an object without its kind to be rejected")
+	}
+}
+
+func TestWebhook(t *testing.T) {
+	vs := []byte(`{"apiVersion":"networking.istio.io/v1alpha3","kind":"VirtualService","metadata":{"name":"reviews","namespace":"default"},"spec":{"hosts":["reviews"]}}`)
+
+	tests := []struct {
+		name               string
+		desiredAPIVersion  string
+		objects            [][]byte
+		expectedStatus     string
+		expectedAPIVersion string
+	}{
+		{
+			name:               "convert",
+			desiredAPIVersion:  "networking.istio.io/v1beta1",
+			objects:            [][]byte{vs, vs},
+			expectedStatus:     metav1.StatusSuccess,
+			expectedAPIVersion: "networking.istio.io/v1beta1",
+		},
+		{
+			name:               "same version",
+			desiredAPIVersion:  "networking.istio.io/v1alpha3",
+			objects:            [][]byte{vs},
+			expectedStatus:     metav1.StatusSuccess,
+			expectedAPIVersion: "networking.istio.io/v1alpha3",
+		},
+		{
+			name:              "other group",
+			desiredAPIVersion: "security.istio.io/v1beta1",
+			objects:           [][]byte{vs},
+			expectedStatus:    metav1.StatusFailure,
+		},
+		{
+			name:              "unknown version",
+			desiredAPIVersion: "networking.istio.io/v2",
+			objects:           [][]byte{vs},
+			expectedStatus:    metav1.StatusFailure,
+		},
+		{
+			name:              "invalid object",
+			desiredAPIVersion: "networking.istio.io/v1beta1",
+			objects:           [][]byte{vs, []byte(`{"apiVersion":"networking.istio.io/v1alpha3","kind":"VirtualService","spec":{"hosts":"reviews"}}`)},
+			expectedStatus:    metav1.StatusFailure,
+		},
+	}
+
+	for _, test := range tests {
+		request := &apiextensionsv1.ConversionRequest{UID: types.UID(test.name), DesiredAPIVersion: test.desiredAPIVersion}
+		for _, object := range test.objects {
+			request.Objects = append(request.Objects, runtime.RawExtension{Raw: object})
+		}
+		review := serve(t, &apiextensionsv1.ConversionReview{Request: request}, http.StatusOK)
+		if review == nil {
+			continue
+		}
+
+		response := review.Response
+		if review.Request != nil || response == nil || response.UID != request.UID {
+			t.Errorf("%s: unexpected review %v", test.name, review)
+			continue
+		}
+		if response.Result.Status != test.expectedStatus {
+			t.Errorf("%s: unexpected status %q, expected %q: %s", test.name, response.Result.Status, test.expectedStatus, response.Result.Message)
+			continue
+		}
+		if test.expectedStatus == metav1.StatusFailure {
+			if len(response.ConvertedObjects) != 0 {
+				t.Errorf("%s: unexpected converted objects of a failed conversion", test.name)
+			}
+			continue
+		}
+
+		if len(response.ConvertedObjects) != len(test.objects) {
+			t.Errorf("%s: unexpected number of converted objects %d", test.name, len(response.ConvertedObjects))
+			continue
+		}
+		for _, converted := range response.ConvertedObjects {
+			out := &networkingv1beta1.VirtualService{}
+			if err := json.Unmarshal(converted.Raw, out); err != nil {
+				t.Errorf("%s: unexpected error %v", test.name, err)
+				continue
+			}
+			if out.APIVersion != test.expectedAPIVersion || out.Name != "reviews" || !reflect.DeepEqual(out.Spec.Hosts, []string{"reviews"}) {
+				t.Errorf("%s: unexpected converted object %s", test.name, converted.Raw)
+			}
+		}
+	}
+}
+
+func TestWebhookBadRequest(t *testing.T) {
+	serve(t, &apiextensionsv1.ConversionReview{}, http.StatusBadRequest)
+
+	recorder := httptest.NewRecorder()
+	(&Webhook{}).ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, WebhookPath, bytes.NewBufferString("{")))
+	if recorder.Code != http.StatusBadRequest {
+		t.Errorf("unexpected status code %d of an invalid review", recorder.Code)
+	}
+}
+
+// serve sends the review to the webhook and returns the response review if
+// the webhook replies with the expected status code.
+func serve(t *testing.T, review *apiextensionsv1.ConversionReview, expectedCode int) *apiextensionsv1.ConversionReview {
+	t.Helper()
+
+	data, err := json.Marshal(review)
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	recorder := httptest.NewRecorder()
+	(&Webhook{}).ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, WebhookPath, bytes.NewReader(data)))
+	if recorder.Code != expectedCode {
+		t.Errorf("unexpected status code %d, expected %d: %s", recorder.Code, expectedCode, recorder.Body)
+		return nil
+	}
+	if expectedCode != http.StatusOK {
+		return nil
+	}
+
+	out := &apiextensionsv1.ConversionReview{}
+	if err := json.Unmarshal(recorder.Body.Bytes(), out); err != nil {
+		t.Errorf("unexpected error %v", err)
+		return nil
+	}
+
+	return out
+}
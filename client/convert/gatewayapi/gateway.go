@@ -0,0 +1,215 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gatewayapi
+
+import (
+	"fmt"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gatewayapiv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	networkingv1beta1 "github.com/banzaicloud/istio-client-go/pkg/networking/v1beta1"
+)
+
+// namespaceNameLabel is the label Kubernetes sets to the name of every namespace.
+const namespaceNameLabel = "kubernetes.io/metadata.name"
+
+// ConvertGateway translates the Istio gateway into a Gateway of the given
+// gateway class. Every host of every server becomes a listener; the servers
+// that cannot be translated are left out and reported.
+func ConvertGateway(in *networkingv1beta1.Gateway, gatewayClassName string) (*gatewayapiv1alpha2.Gateway, []Issue) {
+	var is issues
+	out := &gatewayapiv1alpha2.Gateway{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: APIVersion,
+			Kind:       "Gateway",
+		},
+		ObjectMeta: objectMeta(in.ObjectMeta),
+		Spec: gatewayapiv1alpha2.GatewaySpec{
+			GatewayClassName: gatewayapiv1alpha2.ObjectName(gatewayClassName),
+		},
+	}
+
+	if len(in.Spec.Selector) > 0 {
+		is.add("spec.selector", "the gateway class selects the deployment of the gateway")
+	}
+
+	names := map[gatewayapiv1alpha2.SectionName]bool{}
+	for i, server := range in.Spec.Servers {
+		field := fmt.Sprintf("spec.servers[%d]", i)
+		listener, ok := convertServer(server, field, &is)
+		if !ok {
+			continue
+		}
+
+		hosts := server.Hosts
+		if len(hosts) == 0 {
+			hosts = []string{"*"}
+		}
+		for j, host := range hosts {
+			listener := listener
+			listener.Name = uniqueName(listenerName(server, j, len(hosts)), names)
+			listener.Hostname, listener.AllowedRoutes = convertServerHost(host)
+			out.Spec.Listeners = append(out.Spec.Listeners, listener)
+		}
+	}
+
+	return out, is
+}
+
+func convertServer(server networkingv1beta1.Server, field string, is *issues) (gatewayapiv1alpha2.Listener, bool) {
+	listener := gatewayapiv1alpha2.Listener{}
+	if server.Port == nil {
+		is.add(field+".port", "the server has no port")
+		return listener, false
+	}
+	listener.Port = gatewayapiv1alpha2.PortNumber(server.Port.Number)
+
+	switch protocol := networkingv1beta1.PortProtocol(strings.ToUpper(string(server.Port.Protocol))); protocol {
+	case networkingv1beta1.ProtocolHTTP, networkingv1beta1.ProtocolHTTP2, networkingv1beta1.ProtocolGRPC, "GRPC-WEB":
+		listener.Protocol = gatewayapiv1alpha2.HTTPProtocolType
+	case networkingv1beta1.ProtocolHTTPS:
+		listener.Protocol = gatewayapiv1alpha2.HTTPSProtocolType
+	case networkingv1beta1.ProtocolTLS:
+		listener.Protocol = gatewayapiv1alpha2.TLSProtocolType
+	case networkingv1beta1.ProtocolTCP:
+		listener.Protocol = gatewayapiv1alpha2.TCPProtocolType
+	default:
+		is.add(field+".port.protocol", "protocol %s has no Gateway API equivalent", server.Port.Protocol)
+		return listener, false
+	}
+
+	if server.DefaultEndpoint != nil {
+		is.add(field+".defaultEndpoint", "the Gateway API has no default endpoints")
+	}
+
+	if server.TLS == nil {
+		return listener, true
+	}
+
+	tls, ok := convertTLSOptions(server.TLS, field+".tls", is)
+	if !ok {
+		return listener, false
+	}
+	if tls != nil && *tls.Mode == gatewayapiv1alpha2.TLSModePassthrough {
+		listener.Protocol = gatewayapiv1alpha2.TLSProtocolType
+	}
+	listener.TLS = tls
+
+	return listener, true
+}
+
+func convertTLSOptions(options *networkingv1beta1.TLSOptions, field string, is *issues) (*gatewayapiv1alpha2.GatewayTLSConfig, bool) {
+	if options.HTTPSRedirect != nil && *options.HTTPSRedirect {
+		is.add(field+".httpsRedirect", "use a RequestRedirect filter on the HTTPRoutes of the listener instead")
+	}
+	if options.MinProtocolVersion != nil || options.MaxProtocolVersion != nil || len(options.CipherSuites) > 0 {
+		is.add(field, "the Gateway API has no TLS protocol versions and cipher suites")
+	}
+	if len(options.SubjectAltNames) > 0 || len(options.VerifyCertificateSpki) > 0 || len(options.VerifyCertificateHash) > 0 {
+		is.add(field, "the Gateway API does not verify client certificates")
+	}
+
+	var mode gatewayapiv1alpha2.TLSModeType
+	switch options.Mode {
+	case "":
+		return nil, true
+	case networkingv1beta1.TLSModeSimple:
+		mode = gatewayapiv1alpha2.TLSModeTerminate
+	case networkingv1beta1.TLSModePassThrough:
+		mode = gatewayapiv1alpha2.TLSModePassthrough
+	default:
+		is.add(field+".mode", "TLS mode %s has no Gateway API equivalent", options.Mode)
+		return nil, false
+	}
+
+	tls := &gatewayapiv1alpha2.GatewayTLSConfig{
+		Mode: &mode,
+	}
+	if mode == gatewayapiv1alpha2.TLSModePassthrough {
+		return tls, true
+	}
+
+	if options.CredentialName == nil {
+		is.add(field+".serverCertificate", "the Gateway API only references certificates stored in secrets")
+		return nil, false
+	}
+	group, kind := gatewayapiv1alpha2.Group(""), gatewayapiv1alpha2.Kind("Secret")
+	tls.CertificateRefs = []*gatewayapiv1alpha2.SecretObjectReference{
+		{
+			Group: &group,
+			Kind:  &kind,
+			Name:  gatewayapiv1alpha2.ObjectName(*options.CredentialName),
+		},
+	}
+
+	return tls, true
+}
+
+// convertServerHost translates a host of a server, which may be prefixed with
+// the namespace of the virtual services it accepts, e.g. prod/*.example.com.
+func convertServerHost(host string) (*gatewayapiv1alpha2.Hostname, *gatewayapiv1alpha2.AllowedRoutes) {
+	namespace := "*"
+	if i := strings.Index(host, "/"); i >= 0 {
+		namespace, host = host[:i], host[i+1:]
+	}
+
+	var hostname *gatewayapiv1alpha2.Hostname
+	if host != "*" {
+		h := gatewayapiv1alpha2.Hostname(host)
+		hostname = &h
+	}
+
+	namespaces := &gatewayapiv1alpha2.RouteNamespaces{}
+	switch namespace {
+	case "*":
+		from := gatewayapiv1alpha2.NamespacesFromAll
+		namespaces.From = &from
+	case ".":
+		from := gatewayapiv1alpha2.NamespacesFromSame
+		namespaces.From = &from
+	default:
+		from := gatewayapiv1alpha2.NamespacesFromSelector
+		namespaces.From = &from
+		namespaces.Selector = &metav1.LabelSelector{
+			MatchLabels: map[string]string{namespaceNameLabel: namespace},
+		}
+	}
+
+	return hostname, &gatewayapiv1alpha2.AllowedRoutes{Namespaces: namespaces}
+}
+
+func listenerName(server networkingv1beta1.Server, host, hosts int) string {
+	name := server.Port.Name
+	if name == "" {
+		name = fmt.Sprintf("%s-%d", strings.ToLower(string(server.Port.Protocol)), server.Port.Number)
+	}
+	if hosts > 1 {
+		name = fmt.Sprintf("%s-%d", name, host)
+	}
+
+	return name
+}
+
+func uniqueName(name string, names map[gatewayapiv1alpha2.SectionName]bool) gatewayapiv1alpha2.SectionName {
+	unique := gatewayapiv1alpha2.SectionName(name)
+	for i := 1; names[unique]; i++ {
+		unique = gatewayapiv1alpha2.SectionName(fmt.Sprintf("%s-%d", name, i))
+	}
+	names[unique] = true
+
+	return unique
+}
@@ -0,0 +1,121 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gatewayapi
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gatewayapiv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	networkingv1beta1 "github.com/banzaicloud/istio-client-go/pkg/networking/v1beta1"
+)
+
+// describeListener summarizes the listener as its name, protocol, port,
+// hostname, the namespaces of its routes and its TLS mode.
+func describeListener(listener gatewayapiv1alpha2.Listener) string {
+	hostname := "*"
+	if listener.Hostname != nil {
+		hostname = string(*listener.Hostname)
+	}
+	from := string(*listener.AllowedRoutes.Namespaces.From)
+	if selector := listener.AllowedRoutes.Namespaces.Selector; selector != nil {
+		from += "=" + selector.MatchLabels[namespaceNameLabel]
+	}
+	s := fmt.Sprintf("%s %s %d %s %s", listener.Name, listener.Protocol, listener.Port, hostname, from)
+	if listener.TLS != nil {
+		s += " " + string(*listener.TLS.Mode)
+		for _, ref := range listener.TLS.CertificateRefs {
+			s += " " + string(ref.Name)
+		}
+	}
+
+	return s
+}
+
+func TestConvertGateway(t *testing.T) {
+	credentialName := "reviews-cert"
+	in := &networkingv1beta1.Gateway{
+		ObjectMeta: metav1.ObjectMeta{Name: "ingress", Namespace: "istio-system", Labels: map[string]string{"app": "ingress"}},
+		Spec: networkingv1beta1.GatewaySpec{
+			Selector: map[string]string{"istio": "ingressgateway"},
+			Servers: []networkingv1beta1.Server{
+				{
+					Port:  &networkingv1beta1.Port{Number: 80, Protocol: networkingv1beta1.ProtocolHTTP, Name: "http"},
+					Hosts: []string{"prod/reviews.example.com", "*"},
+				},
+				{
+					Port:  &networkingv1beta1.Port{Number: 443, Protocol: networkingv1beta1.ProtocolHTTPS},
+					Hosts: []string{"./reviews.example.com"},
+					TLS:   &networkingv1beta1.TLSOptions{Mode: networkingv1beta1.TLSModeSimple, CredentialName: &credentialName},
+				},
+				{
+					Port:  &networkingv1beta1.Port{Number: 15443, Protocol: networkingv1beta1.ProtocolTLS, Name: "http-0"},
+					Hosts: []string{"*.example.com"},
+					TLS:   &networkingv1beta1.TLSOptions{Mode: networkingv1beta1.TLSModePassThrough},
+				},
+				{
+					Port:  &networkingv1beta1.Port{Number: 8443, Protocol: networkingv1beta1.ProtocolHTTPS},
+					Hosts: []string{"*"},
+					TLS:   &networkingv1beta1.TLSOptions{Mode: networkingv1beta1.TLSModeMutual, CredentialName: &credentialName},
+				},
+				{
+					Port:  &networkingv1beta1.Port{Number: 27017, Protocol: "MONGO"},
+					Hosts: []string{"*"},
+				},
+				{
+					Hosts: []string{"*"},
+				},
+			},
+		},
+	}
+
+	out, issues := ConvertGateway(in, "istio")
+
+	if out.APIVersion != APIVersion || out.Kind != "Gateway" || out.Name != "ingress" || out.Namespace != "istio-system" ||
+		!reflect.DeepEqual(out.Labels, in.Labels) || out.Spec.GatewayClassName != "istio" {
+		t.Errorf("unexpected gateway %v", out)
+	}
+
+	var listeners []string
+	for _, listener := range out.Spec.Listeners {
+		listeners = append(listeners, describeListener(listener))
+	}
+	expectedListeners := []string{
+		"http-0 HTTP 80 reviews.example.com Selector=prod",
+		"http-1 HTTP 80 * All",
+		"https-443 HTTPS 443 reviews.example.com Same Terminate reviews-cert",
+		"http-0-1 TLS 15443 *.example.com All Passthrough",
+	}
+	if !reflect.DeepEqual(listeners, expectedListeners) {
+		t.Errorf("unexpected listeners %q, expected %q", listeners, expectedListeners)
+	}
+
+	var messages []string
+	for _, issue := range issues {
+		messages = append(messages, issue.String())
+	}
+	expectedMessages := []string{
+		"spec.selector: the gateway class selects the deployment of the gateway",
+		"spec.servers[3].tls.mode: TLS mode MUTUAL has no Gateway API equivalent",
+		"spec.servers[4].port.protocol: protocol MONGO has no Gateway API equivalent",
+		"spec.servers[5].port: the server has no port",
+	}
+	if !reflect.DeepEqual(messages, expectedMessages) {
+		t.Errorf("unexpected issues %q, expected %q", messages, expectedMessages)
+	}
+}
@@ -0,0 +1,69 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package gatewayapi translates the Istio Gateway and VirtualService resources
+// into the Gateway and HTTPRoute resources of the Kubernetes Gateway API
+// (gateway.networking.k8s.io/v1alpha2), to help migrating to the Gateway API.
+//
+// Not every feature of Istio has an equivalent in the Gateway API. The
+// translations leave those out and report them as issues, which should be
+// reviewed before the translated resources are applied.
+package gatewayapi
+
+import (
+	"fmt"
+	"sort"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// APIVersion is the API version of the translated resources.
+const APIVersion = "gateway.networking.k8s.io/v1alpha2"
+
+// Issue describes a field of an Istio resource that could not be translated.
+type Issue struct {
+	// Field is the path of the field, e.g. spec.http[0].fault
+	Field string
+	// Message explains why the field was left out
+	Message string
+}
+
+func (i Issue) String() string {
+	return fmt.Sprintf("%s: %s", i.Field, i.Message)
+}
+
+type issues []Issue
+
+func (is *issues) add(field, format string, args ...interface{}) {
+	*is = append(*is, Issue{Field: field, Message: fmt.Sprintf(format, args...)})
+}
+
+func objectMeta(in metav1.ObjectMeta) metav1.ObjectMeta {
+	return metav1.ObjectMeta{
+		Name:        in.Name,
+		Namespace:   in.Namespace,
+		Labels:      in.Labels,
+		Annotations: in.Annotations,
+	}
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
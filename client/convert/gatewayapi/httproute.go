@@ -0,0 +1,367 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gatewayapi
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gatewayapiv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	"github.com/banzaicloud/istio-client-go/pkg/common/v1alpha1"
+	networkingv1beta1 "github.com/banzaicloud/istio-client-go/pkg/networking/v1beta1"
+)
+
+// meshGateway is the reserved gateway name of the sidecars of the mesh.
+const meshGateway = "mesh"
+
+// ConvertVirtualService translates the HTTP routes of the virtual service into
+// an HTTPRoute attached to the gateways of the virtual service. The features
+// of the routes that cannot be translated are left out and reported.
+func ConvertVirtualService(in *networkingv1beta1.VirtualService) (*gatewayapiv1alpha2.HTTPRoute, []Issue) {
+	var is issues
+	out := &gatewayapiv1alpha2.HTTPRoute{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: APIVersion,
+			Kind:       "HTTPRoute",
+		},
+		ObjectMeta: objectMeta(in.ObjectMeta),
+	}
+
+	if len(in.Spec.Gateways) == 0 {
+		is.add("spec.gateways", "the virtual service applies to the sidecars of the mesh only")
+	}
+	for i, gateway := range in.Spec.Gateways {
+		if gateway == meshGateway {
+			is.add(fmt.Sprintf("spec.gateways[%d]", i), "the Gateway API does not route the traffic of the mesh")
+			continue
+		}
+		out.Spec.ParentRefs = append(out.Spec.ParentRefs, parentRef(gateway))
+	}
+
+	for _, host := range in.Spec.Hosts {
+		if host == "*" {
+			out.Spec.Hostnames = nil
+			break
+		}
+		out.Spec.Hostnames = append(out.Spec.Hostnames, gatewayapiv1alpha2.Hostname(host))
+	}
+
+	if len(in.Spec.TLS) > 0 {
+		is.add("spec.tls", "only the HTTP routes are translated")
+	}
+	if len(in.Spec.TCP) > 0 {
+		is.add("spec.tcp", "only the HTTP routes are translated")
+	}
+	if len(in.Spec.ExportTo) > 0 {
+		is.add("spec.exportTo", "the Gateway API has no route visibility")
+	}
+
+	for i, route := range in.Spec.HTTP {
+		out.Spec.Rules = append(out.Spec.Rules, convertHTTPRoute(route, in.Namespace, fmt.Sprintf("spec.http[%d]", i), &is))
+	}
+
+	return out, is
+}
+
+// parentRef references a gateway of a virtual service, which may be prefixed
+// with its namespace, e.g. istio-system/ingress.
+func parentRef(gateway string) gatewayapiv1alpha2.ParentRef {
+	ref := gatewayapiv1alpha2.ParentRef{
+		Name: gateway,
+	}
+	if i := strings.Index(gateway, "/"); i >= 0 {
+		namespace := gatewayapiv1alpha2.Namespace(gateway[:i])
+		ref.Namespace = &namespace
+		ref.Name = gateway[i+1:]
+	}
+
+	return ref
+}
+
+func convertHTTPRoute(route networkingv1beta1.HTTPRoute, namespace, field string, is *issues) gatewayapiv1alpha2.HTTPRouteRule {
+	rule := gatewayapiv1alpha2.HTTPRouteRule{}
+
+	for i, match := range route.Match {
+		if match == nil {
+			continue
+		}
+		rule.Matches = append(rule.Matches, convertHTTPMatchRequest(match, fmt.Sprintf("%s.match[%d]", field, i), is))
+	}
+
+	for i, destination := range route.Route {
+		if destination == nil {
+			continue
+		}
+		destinationField := fmt.Sprintf("%s.route[%d]", field, i)
+		backendRef, ok := convertDestination(destination.Destination, namespace, destinationField+".destination", is)
+		if !ok {
+			continue
+		}
+
+		httpBackendRef := gatewayapiv1alpha2.HTTPBackendRef{
+			BackendRef: gatewayapiv1alpha2.BackendRef{
+				BackendObjectReference: backendRef,
+			},
+		}
+		if destination.Weight != nil {
+			weight := *destination.Weight
+			httpBackendRef.Weight = &weight
+		}
+		if filter, ok := convertHeaders(destination.Headers, destinationField+".headers", is); ok {
+			httpBackendRef.Filters = append(httpBackendRef.Filters, filter)
+		}
+		rule.BackendRefs = append(rule.BackendRefs, httpBackendRef)
+	}
+
+	if filter, ok := convertHeaders(route.Headers, field+".headers", is); ok {
+		rule.Filters = append(rule.Filters, filter)
+	}
+	if route.Redirect != nil {
+		rule.Filters = append(rule.Filters, convertRedirect(route.Redirect, field+".redirect", is))
+	}
+	if route.Mirror != nil {
+		if backendRef, ok := convertDestination(route.Mirror, namespace, field+".mirror", is); ok {
+			rule.Filters = append(rule.Filters, gatewayapiv1alpha2.HTTPRouteFilter{
+				Type: gatewayapiv1alpha2.HTTPRouteFilterRequestMirror,
+				RequestMirror: &gatewayapiv1alpha2.HTTPRequestMirrorFilter{
+					BackendRef: backendRef,
+				},
+			})
+		}
+	}
+	if route.Delegate != nil {
+		is.add(field+".delegate", "the delegate virtual services must be flattened first")
+	}
+	if route.MirrorPercent != nil || route.MirrorPercentage != nil {
+		is.add(field+".mirrorPercentage", "the Gateway API mirrors every request")
+	}
+	if route.Rewrite != nil {
+		is.add(field+".rewrite", "the Gateway API has no rewrites")
+	}
+	if route.Timeout != nil {
+		is.add(field+".timeout", "the Gateway API has no timeouts")
+	}
+	if route.Retries != nil {
+		is.add(field+".retries", "the Gateway API has no retries")
+	}
+	if route.Fault != nil {
+		is.add(field+".fault", "the Gateway API has no fault injection")
+	}
+	if route.CorsPolicy != nil {
+		is.add(field+".corsPolicy", "the Gateway API has no CORS policies")
+	}
+
+	return rule
+}
+
+func convertHTTPMatchRequest(match *networkingv1beta1.HTTPMatchRequest, field string, is *issues) gatewayapiv1alpha2.HTTPRouteMatch {
+	out := gatewayapiv1alpha2.HTTPRouteMatch{}
+
+	if match.URI != nil {
+		if matchType, value, ok := stringMatch(match.URI, field+".uri", is); ok {
+			pathMatchType := gatewayapiv1alpha2.PathMatchType(matchType)
+			if matchType == matchPrefix {
+				pathMatchType = gatewayapiv1alpha2.PathMatchPathPrefix
+			}
+			out.Path = &gatewayapiv1alpha2.HTTPPathMatch{
+				Type:  &pathMatchType,
+				Value: &value,
+			}
+		}
+	}
+
+	if match.Method != nil {
+		if match.Method.Exact != "" {
+			method := gatewayapiv1alpha2.HTTPMethod(match.Method.Exact)
+			out.Method = &method
+		} else {
+			is.add(field+".method", "the Gateway API only matches exact methods")
+		}
+	}
+
+	names := make([]string, 0, len(match.Headers))
+	for name := range match.Headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		header, headerField := match.Headers[name], fmt.Sprintf("%s.headers.%s", field, name)
+		matchType, value, ok := stringMatch(&header, headerField, is)
+		if !ok {
+			continue
+		}
+		if matchType == matchPrefix {
+			is.add(headerField, "the Gateway API does not match header prefixes")
+			continue
+		}
+		headerMatchType := gatewayapiv1alpha2.HeaderMatchType(matchType)
+		out.Headers = append(out.Headers, gatewayapiv1alpha2.HTTPHeaderMatch{
+			Type:  &headerMatchType,
+			Name:  gatewayapiv1alpha2.HTTPHeaderName(name),
+			Value: value,
+		})
+	}
+
+	names = names[:0]
+	for name := range match.QueryParams {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		param, paramField := match.QueryParams[name], fmt.Sprintf("%s.queryParams.%s", field, name)
+		if param == nil {
+			continue
+		}
+		matchType, value, ok := stringMatch(param, paramField, is)
+		if !ok {
+			continue
+		}
+		if matchType == matchPrefix {
+			is.add(paramField, "the Gateway API does not match query parameter prefixes")
+			continue
+		}
+		queryParamMatchType := gatewayapiv1alpha2.QueryParamMatchType(matchType)
+		out.QueryParams = append(out.QueryParams, gatewayapiv1alpha2.HTTPQueryParamMatch{
+			Type:  &queryParamMatchType,
+			Name:  name,
+			Value: value,
+		})
+	}
+
+	if match.Scheme != nil {
+		is.add(field+".scheme", "the Gateway API does not match schemes")
+	}
+	if match.Authority != nil {
+		is.add(field+".authority", "match the authority with the hostnames of the route instead")
+	}
+	if match.Port != nil {
+		is.add(field+".port", "attach the route to the listener of the port instead")
+	}
+	if len(match.SourceLabels) > 0 {
+		is.add(field+".sourceLabels", "the Gateway API does not match the source of the traffic")
+	}
+	if match.IgnoreURICase != nil && *match.IgnoreURICase {
+		is.add(field+".ignoreUriCase", "the Gateway API matches paths case sensitively")
+	}
+
+	return out
+}
+
+// Types of the string matches, named after the Gateway API match types
+const (
+	matchExact  = "Exact"
+	matchPrefix = "Prefix"
+	matchRegex  = "RegularExpression"
+)
+
+// stringMatch returns the type and the value of the string match. Suffix
+// matches are reported, since the Gateway API has no equivalent.
+func stringMatch(match *v1alpha1.StringMatch, field string, is *issues) (string, string, bool) {
+	switch {
+	case match.Exact != "":
+		return matchExact, match.Exact, true
+	case match.Prefix != "":
+		return matchPrefix, match.Prefix, true
+	case match.Regex != "":
+		return matchRegex, match.Regex, true
+	case match.Suffix != "":
+		is.add(field, "the Gateway API does not match suffixes")
+	}
+
+	return "", "", false
+}
+
+// convertDestination references the Kubernetes service of the destination,
+// given by its short or fully qualified name.
+func convertDestination(destination *networkingv1beta1.Destination, namespace, field string, is *issues) (gatewayapiv1alpha2.BackendObjectReference, bool) {
+	ref := gatewayapiv1alpha2.BackendObjectReference{}
+	if destination == nil {
+		return ref, false
+	}
+
+	parts := strings.Split(destination.Host, ".")
+	if len(parts) > 2 && parts[2] != "svc" {
+		is.add(field+".host", "%s is not a Kubernetes service", destination.Host)
+		return ref, false
+	}
+	ref.Name = gatewayapiv1alpha2.ObjectName(parts[0])
+	if len(parts) > 1 && parts[1] != namespace {
+		backendNamespace := gatewayapiv1alpha2.Namespace(parts[1])
+		ref.Namespace = &backendNamespace
+	}
+
+	if destination.Port != nil {
+		port := gatewayapiv1alpha2.PortNumber(destination.Port.Number)
+		ref.Port = &port
+	} else {
+		is.add(field+".port", "the Gateway API requires the port of the service")
+	}
+	if destination.Subset != nil {
+		is.add(field+".subset", "the Gateway API has no subsets, route to a service of the subset instead")
+	}
+
+	return ref, true
+}
+
+func convertHeaders(headers *networkingv1beta1.Headers, field string, is *issues) (gatewayapiv1alpha2.HTTPRouteFilter, bool) {
+	filter := gatewayapiv1alpha2.HTTPRouteFilter{
+		Type: gatewayapiv1alpha2.HTTPRouteFilterRequestHeaderModifier,
+	}
+	if headers == nil {
+		return filter, false
+	}
+	if headers.Response != nil {
+		is.add(field+".response", "the Gateway API does not modify the headers of responses")
+	}
+	if headers.Request == nil {
+		return filter, false
+	}
+
+	modifier := &gatewayapiv1alpha2.HTTPRequestHeaderFilter{
+		Remove: headers.Request.Remove,
+	}
+	for _, name := range sortedKeys(headers.Request.Set) {
+		modifier.Set = append(modifier.Set, gatewayapiv1alpha2.HTTPHeader{Name: gatewayapiv1alpha2.HTTPHeaderName(name), Value: headers.Request.Set[name]})
+	}
+	for _, name := range sortedKeys(headers.Request.Add) {
+		modifier.Add = append(modifier.Add, gatewayapiv1alpha2.HTTPHeader{Name: gatewayapiv1alpha2.HTTPHeaderName(name), Value: headers.Request.Add[name]})
+	}
+	filter.RequestHeaderModifier = modifier
+
+	return filter, true
+}
+
+func convertRedirect(redirect *networkingv1beta1.HTTPRedirect, field string, is *issues) gatewayapiv1alpha2.HTTPRouteFilter {
+	out := &gatewayapiv1alpha2.HTTPRequestRedirectFilter{}
+	if redirect.Authority != nil {
+		hostname := gatewayapiv1alpha2.PreciseHostname(*redirect.Authority)
+		out.Hostname = &hostname
+	}
+	if redirect.RedirectCode != nil {
+		statusCode := int(*redirect.RedirectCode)
+		out.StatusCode = &statusCode
+	}
+	if redirect.URI != nil {
+		is.add(field+".uri", "the Gateway API does not redirect to other paths")
+	}
+
+	return gatewayapiv1alpha2.HTTPRouteFilter{
+		Type:            gatewayapiv1alpha2.HTTPRouteFilterRequestRedirect,
+		RequestRedirect: out,
+	}
+}
@@ -0,0 +1,202 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gatewayapi
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gatewayapiv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	"github.com/banzaicloud/istio-client-go/pkg/common/v1alpha1"
+	networkingv1beta1 "github.com/banzaicloud/istio-client-go/pkg/networking/v1beta1"
+)
+
+func TestConvertVirtualService(t *testing.T) {
+	weight := func(w int32) *int32 {
+		return &w
+	}
+	subset, authority, redirectCode := "v1", "reviews.example.org", uint32(301)
+	in := &networkingv1beta1.VirtualService{
+		ObjectMeta: metav1.ObjectMeta{Name: "reviews", Namespace: "prod"},
+		Spec: networkingv1beta1.VirtualServiceSpec{
+			Hosts:    []string{"reviews.example.com"},
+			Gateways: []string{"istio-system/ingress", "mesh"},
+			ExportTo: []string{"."},
+			HTTP: []networkingv1beta1.HTTPRoute{
+				{
+					Match: []*networkingv1beta1.HTTPMatchRequest{
+						{
+							URI:    &v1alpha1.StringMatch{Prefix: "/api"},
+							Method: &v1alpha1.StringMatch{Exact: "GET"},
+							Headers: map[string]v1alpha1.StringMatch{
+								"x-user":   {Exact: "jason"},
+								"x-prefix": {Prefix: "test"},
+							},
+							QueryParams: map[string]*v1alpha1.StringMatch{
+								"version": {Regex: "v[0-9]+"},
+							},
+						},
+					},
+					Route: []*networkingv1beta1.HTTPRouteDestination{
+						{
+							Destination: &networkingv1beta1.Destination{
+								Host: "reviews.prod.svc.cluster.local",
+								Port: &networkingv1beta1.PortSelector{Number: 9080},
+							},
+							Weight: weight(80),
+						},
+						{
+							Destination: &networkingv1beta1.Destination{Host: "ratings.test", Subset: &subset},
+							Weight:      weight(20),
+						},
+						{
+							Destination: &networkingv1beta1.Destination{Host: "api.example.org"},
+						},
+					},
+					Headers: &networkingv1beta1.Headers{
+						Request: &networkingv1beta1.HeaderOperations{
+							Set: map[string]string{"x-route": "api"},
+							Add: map[string]string{"x-b": "2", "x-a": "1"},
+						},
+					},
+					Timeout: v1alpha1.NewDuration(time.Second),
+				},
+				{
+					Redirect: &networkingv1beta1.HTTPRedirect{Authority: &authority, RedirectCode: &redirectCode},
+				},
+			},
+		},
+	}
+
+	out, issues := ConvertVirtualService(in)
+
+	if out.APIVersion != APIVersion || out.Kind != "HTTPRoute" || out.Name != "reviews" || out.Namespace != "prod" {
+		t.Errorf("unexpected route %v", out)
+	}
+	namespace := gatewayapiv1alpha2.Namespace("istio-system")
+	if expected := []gatewayapiv1alpha2.ParentRef{{Namespace: &namespace, Name: "ingress"}}; !reflect.DeepEqual(out.Spec.ParentRefs, expected) {
+		t.Errorf("unexpected parent references %v, expected %v", out.Spec.ParentRefs, expected)
+	}
+	if expected := []gatewayapiv1alpha2.Hostname{"reviews.example.com"}; !reflect.DeepEqual(out.Spec.Hostnames, expected) {
+		t.Errorf("unexpected hostnames %v, expected %v", out.Spec.Hostnames, expected)
+	}
+	if len(out.Spec.Rules) != 2 {
+		t.Fatalf("unexpected rules %v", out.Spec.Rules)
+	}
+
+	pathPrefix, path := gatewayapiv1alpha2.PathMatchPathPrefix, "/api"
+	method := gatewayapiv1alpha2.HTTPMethod("GET")
+	headerExact := gatewayapiv1alpha2.HeaderMatchType(matchExact)
+	queryParamRegex := gatewayapiv1alpha2.QueryParamMatchType(matchRegex)
+	expectedMatches := []gatewayapiv1alpha2.HTTPRouteMatch{
+		{
+			Path:        &gatewayapiv1alpha2.HTTPPathMatch{Type: &pathPrefix, Value: &path},
+			Method:      &method,
+			Headers:     []gatewayapiv1alpha2.HTTPHeaderMatch{{Type: &headerExact, Name: "x-user", Value: "jason"}},
+			QueryParams: []gatewayapiv1alpha2.HTTPQueryParamMatch{{Type: &queryParamRegex, Name: "version", Value: "v[0-9]+"}},
+		},
+	}
+	if !reflect.DeepEqual(out.Spec.Rules[0].Matches, expectedMatches) {
+		t.Errorf("unexpected matches %v, expected %v", out.Spec.Rules[0].Matches, expectedMatches)
+	}
+
+	port, test := gatewayapiv1alpha2.PortNumber(9080), gatewayapiv1alpha2.Namespace("test")
+	expectedBackendRefs := []gatewayapiv1alpha2.HTTPBackendRef{
+		{BackendRef: gatewayapiv1alpha2.BackendRef{
+			BackendObjectReference: gatewayapiv1alpha2.BackendObjectReference{Name: "reviews", Port: &port},
+			Weight:                 weight(80),
+		}},
+		{BackendRef: gatewayapiv1alpha2.BackendRef{
+			BackendObjectReference: gatewayapiv1alpha2.BackendObjectReference{Name: "ratings", Namespace: &test},
+			Weight:                 weight(20),
+		}},
+	}
+	if !reflect.DeepEqual(out.Spec.Rules[0].BackendRefs, expectedBackendRefs) {
+		t.Errorf("unexpected backend references %v, expected %v", out.Spec.Rules[0].BackendRefs, expectedBackendRefs)
+	}
+
+	expectedFilters := []gatewayapiv1alpha2.HTTPRouteFilter{
+		{
+			Type: gatewayapiv1alpha2.HTTPRouteFilterRequestHeaderModifier,
+			RequestHeaderModifier: &gatewayapiv1alpha2.HTTPRequestHeaderFilter{
+				Set: []gatewayapiv1alpha2.HTTPHeader{{Name: "x-route", Value: "api"}},
+				Add: []gatewayapiv1alpha2.HTTPHeader{{Name: "x-a", Value: "1"}, {Name: "x-b", Value: "2"}},
+			},
+		},
+	}
+	if !reflect.DeepEqual(out.Spec.Rules[0].Filters, expectedFilters) {
+		t.Errorf("unexpected filters %v, expected %v", out.Spec.Rules[0].Filters, expectedFilters)
+	}
+
+	hostname, statusCode := gatewayapiv1alpha2.PreciseHostname(authority), 301
+	expectedFilters = []gatewayapiv1alpha2.HTTPRouteFilter{
+		{
+			Type:            gatewayapiv1alpha2.HTTPRouteFilterRequestRedirect,
+			RequestRedirect: &gatewayapiv1alpha2.HTTPRequestRedirectFilter{Hostname: &hostname, StatusCode: &statusCode},
+		},
+	}
+	if !reflect.DeepEqual(out.Spec.Rules[1].Filters, expectedFilters) {
+		t.Errorf("unexpected filters %v, expected %v", out.Spec.Rules[1].Filters, expectedFilters)
+	}
+
+	var messages []string
+	for _, issue := range issues {
+		messages = append(messages, issue.String())
+	}
+	expectedMessages := []string{
+		"spec.gateways[1]: the Gateway API does not route the traffic of the mesh",
+		"spec.exportTo: the Gateway API has no route visibility",
+		"spec.http[0].match[0].headers.x-prefix: the Gateway API does not match header prefixes",
+		"spec.http[0].route[1].destination.port: the Gateway API requires the port of the service",
+		"spec.http[0].route[1].destination.subset: the Gateway API has no subsets, route to a service of the subset instead",
+		"spec.http[0].route[2].destination.host: api.example.org is not a Kubernetes service",
+		"spec.http[0].timeout: the Gateway API has no timeouts",
+	}
+	if !reflect.DeepEqual(messages, expectedMessages) {
+		t.Errorf("unexpected issues %q, expected %q", messages, expectedMessages)
+	}
+}
+
+func TestConvertVirtualServiceOfTheMesh(t *testing.T) {
+	in := &networkingv1beta1.VirtualService{
+		ObjectMeta: metav1.ObjectMeta{Name: "reviews", Namespace: "prod"},
+		Spec: networkingv1beta1.VirtualServiceSpec{
+			Hosts: []string{"*"},
+			HTTP:  []networkingv1beta1.HTTPRoute{{Delegate: &networkingv1beta1.Delegate{Name: "reviews-api"}}},
+			TCP:   []networkingv1beta1.TCPRoute{{}},
+		},
+	}
+
+	out, issues := ConvertVirtualService(in)
+
+	if len(out.Spec.ParentRefs) != 0 || len(out.Spec.Hostnames) != 0 {
+		t.Errorf("unexpected route %v", out.Spec)
+	}
+	var messages []string
+	for _, issue := range issues {
+		messages = append(messages, issue.String())
+	}
+	expectedMessages := []string{
+		"spec.gateways: the virtual service applies to the sidecars of the mesh only",
+		"spec.tcp: only the HTTP routes are translated",
+		"spec.http[0].delegate: the delegate virtual services must be flattened first",
+	}
+	if !reflect.DeepEqual(messages, expectedMessages) {
+		t.Errorf("unexpected issues %q, expected %q", messages, expectedMessages)
+	}
+}
@@ -0,0 +1,178 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package crd ships the CustomResourceDefinitions of the kinds modeled by
+// this client, so that test environments and operators can install the
+// Istio CRDs they depend on themselves.
+//
+// The manifests in the bases directory are generated from the kubebuilder
+// markers of the API types by `make manifests`.
+package crd
+
+import (
+	"context"
+	"embed"
+	"fmt"
+	"path"
+	"sort"
+	"time"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apiextensionsclient "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/yaml"
+)
+
+// PollInterval is the interval WaitEstablished checks the definitions with.
+var PollInterval = time.Second
+
+//go:embed bases/*.yaml
+var manifests embed.FS
+
+// CustomResourceDefinitions returns the embedded definitions ordered by name.
+// Every call decodes the manifests again, so the caller is free to modify the
+// returned objects.
+func CustomResourceDefinitions() ([]*apiextensionsv1.CustomResourceDefinition, error) {
+	files, err := manifests.ReadDir("bases")
+	if err != nil {
+		return nil, err
+	}
+
+	crds := make([]*apiextensionsv1.CustomResourceDefinition, 0, len(files))
+	for _, file := range files {
+		data, err := manifests.ReadFile(path.Join("bases", file.Name()))
+		if err != nil {
+			return nil, err
+		}
+
+		crd := &apiextensionsv1.CustomResourceDefinition{}
+		if err := yaml.Unmarshal(data, crd); err != nil {
+			return nil, fmt.Errorf("could not decode %s: %w", file.Name(), err)
+		}
+		crds = append(crds, crd)
+	}
+	sort.Slice(crds, func(i, j int) bool { return crds[i].Name < crds[j].Name })
+
+	return crds, nil
+}
+
+// InstallAll creates the embedded definitions, or updates them if they already
+// exist in the cluster. It does not wait for them to become established, see
+// WaitEstablished.
+func InstallAll(ctx context.Context, client apiextensionsclient.Interface) error {
+	crds, err := CustomResourceDefinitions()
+	if err != nil {
+		return err
+	}
+
+	for _, crd := range crds {
+		if err := install(ctx, client, crd); err != nil {
+			return fmt.Errorf("could not install %s: %w", crd.Name, err)
+		}
+	}
+
+	return nil
+}
+
+func install(ctx context.Context, client apiextensionsclient.Interface, crd *apiextensionsv1.CustomResourceDefinition) error {
+	crds := client.ApiextensionsV1().CustomResourceDefinitions()
+
+	_, err := crds.Create(ctx, crd, metav1.CreateOptions{})
+	if !apierrors.IsAlreadyExists(err) {
+		return err
+	}
+
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		existing, err := crds.Get(ctx, crd.Name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+
+		desired := crd.DeepCopy()
+		desired.ResourceVersion = existing.ResourceVersion
+		_, err = crds.Update(ctx, desired, metav1.UpdateOptions{})
+		return err
+	})
+}
+
+// WaitEstablished waits until the definitions with the given names are
+// established and their names are accepted by the API server, or until the
+// context is done. Without names it waits for all the embedded definitions.
+func WaitEstablished(ctx context.Context, client apiextensionsclient.Interface, names ...string) error {
+	if len(names) == 0 {
+		crds, err := CustomResourceDefinitions()
+		if err != nil {
+			return err
+		}
+		for _, crd := range crds {
+			names = append(names, crd.Name)
+		}
+	}
+
+	for _, name := range names {
+		name := name
+		err := wait.PollImmediateUntil(PollInterval, func() (bool, error) {
+			crd, err := client.ApiextensionsV1().CustomResourceDefinitions().Get(ctx, name, metav1.GetOptions{})
+			if apierrors.IsNotFound(err) {
+				return false, nil
+			}
+			if err != nil {
+				return false, err
+			}
+
+			return IsEstablished(crd), nil
+		}, ctx.Done())
+		if err != nil {
+			return fmt.Errorf("%s is not established: %w", name, err)
+		}
+	}
+
+	return nil
+}
+
+// IsEstablished returns true if the definition is established and its names
+// are accepted.
+func IsEstablished(crd *apiextensionsv1.CustomResourceDefinition) bool {
+	var established, namesAccepted bool
+	for _, condition := range crd.Status.Conditions {
+		switch condition.Type {
+		case apiextensionsv1.Established:
+			established = condition.Status == apiextensionsv1.ConditionTrue
+		case apiextensionsv1.NamesAccepted:
+			namesAccepted = condition.Status == apiextensionsv1.ConditionTrue
+		}
+	}
+
+	return established && namesAccepted
+}
+
+// SetConversionWebhook makes the API server convert the versions of the
+// definition with the conversion webhook reachable through the client config.
+// Definitions with a single version are left unchanged.
+func SetConversionWebhook(crd *apiextensionsv1.CustomResourceDefinition, clientConfig apiextensionsv1.WebhookClientConfig) {
+	if len(crd.Spec.Versions) < 2 {
+		return
+	}
+
+	crd.Spec.Conversion = &apiextensionsv1.CustomResourceConversion{
+		Strategy: apiextensionsv1.WebhookConverter,
+		Webhook: &apiextensionsv1.WebhookConversion{
+			ClientConfig:             &clientConfig,
+			ConversionReviewVersions: []string{"v1"},
+		},
+	}
+}
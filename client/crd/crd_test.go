@@ -0,0 +1,175 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package crd
+
+import (
+	"context"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset/fake"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestCustomResourceDefinitions(t *testing.T) {
+	crds, err := CustomResourceDefinitions()
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	files, err := manifests.ReadDir("bases")
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if len(crds) != len(files) {
+		t.Errorf("unexpected number of definitions %d, expected %d", len(crds), len(files))
+	}
+	if !sort.SliceIsSorted(crds, func(i, j int) bool { return crds[i].Name < crds[j].Name }) {
+		t.Error("the definitions are not ordered by name")
+	}
+
+	for _, crd := range crds {
+		if crd.Name != crd.Spec.Names.Plural+"."+crd.Spec.Group || !strings.HasSuffix(crd.Spec.Group, ".istio.io") {
+			t.Errorf("%s: unexpected names %s of group %s", crd.Name, crd.Spec.Names.Plural, crd.Spec.Group)
+		}
+		storage := 0
+		for _, version := range crd.Spec.Versions {
+			if version.Storage {
+				storage++
+			}
+		}
+		if storage != 1 {
+			t.Errorf("%s: unexpected number of storage versions %d", crd.Name, storage)
+		}
+	}
+
+	// the definitions are decoded again, so modifying them does not change
+	// the ones returned later
+	crds[0].Spec.Group = "modified"
+	again, err := CustomResourceDefinitions()
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if again[0].Spec.Group == "modified" {
+		t.Error("modifying a definition changed the embedded one")
+	}
+}
+
+func TestInstallAll(t *testing.T) {
+	crds, err := CustomResourceDefinitions()
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	outdated := crds[0].DeepCopy()
+	outdated.ResourceVersion = "1"
+	outdated.Spec.Versions = outdated.Spec.Versions[:1]
+	outdated.Spec.Versions[0].Name = "v1alpha0"
+	client := fake.NewSimpleClientset(outdated)
+
+	if err := InstallAll(context.Background(), client); err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	installed, err := client.ApiextensionsV1().CustomResourceDefinitions().List(context.Background(), metav1.ListOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if len(installed.Items) != len(crds) {
+		t.Errorf("unexpected number of installed definitions %d, expected %d", len(installed.Items), len(crds))
+	}
+	for _, item := range installed.Items {
+		for _, crd := range crds {
+			if crd.Name == item.Name && !reflect.DeepEqual(crd.Spec, item.Spec) {
+				t.Errorf("%s: the installed definition differs from the embedded one", crd.Name)
+			}
+		}
+	}
+}
+
+func TestWaitEstablished(t *testing.T) {
+	defer func(interval time.Duration) { PollInterval = interval }(PollInterval)
+	PollInterval = time.Millisecond
+
+	crd := func(name string, conditions ...apiextensionsv1.CustomResourceDefinitionConditionType) *apiextensionsv1.CustomResourceDefinition {
+		crd := &apiextensionsv1.CustomResourceDefinition{ObjectMeta: metav1.ObjectMeta{Name: name}}
+		for _, condition := range conditions {
+			crd.Status.Conditions = append(crd.Status.Conditions, apiextensionsv1.CustomResourceDefinitionCondition{
+				Type:   condition,
+				Status: apiextensionsv1.ConditionTrue,
+			})
+		}
+		return crd
+	}
+	client := fake.NewSimpleClientset(
+		crd("gateways.networking.istio.io", apiextensionsv1.Established, apiextensionsv1.NamesAccepted),
+		crd("sidecars.networking.istio.io", apiextensionsv1.NamesAccepted),
+	)
+
+	tests := []struct {
+		name  string
+		names []string
+		fails bool
+	}{
+		{
+			name:  "established",
+			names: []string{"gateways.networking.istio.io"},
+		},
+		{
+			name:  "not established",
+			names: []string{"gateways.networking.istio.io", "sidecars.networking.istio.io"},
+			fails: true,
+		},
+		{
+			name:  "missing",
+			names: []string{"virtualservices.networking.istio.io"},
+			fails: true,
+		},
+	}
+
+	for _, test := range tests {
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		err := WaitEstablished(ctx, client, test.names...)
+		cancel()
+		if test.fails != (err != nil) {
+			t.Errorf("%s: unexpected error %v", test.name, err)
+		}
+	}
+}
+
+func TestSetConversionWebhook(t *testing.T) {
+	crds, err := CustomResourceDefinitions()
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	clientConfig := apiextensionsv1.WebhookClientConfig{
+		Service: &apiextensionsv1.ServiceReference{Namespace: "istio-system", Name: "conversion"},
+	}
+	for _, crd := range crds {
+		SetConversionWebhook(crd, clientConfig)
+		switch {
+		case len(crd.Spec.Versions) < 2 && crd.Spec.Conversion != nil && crd.Spec.Conversion.Strategy == apiextensionsv1.WebhookConverter:
+			t.Errorf("%s: unexpected conversion webhook of a single version", crd.Name)
+		case len(crd.Spec.Versions) >= 2 && (crd.Spec.Conversion == nil || crd.Spec.Conversion.Webhook == nil ||
+			!reflect.DeepEqual(*crd.Spec.Conversion.Webhook.ClientConfig, clientConfig)):
+			t.Errorf("%s: unexpected conversion %v", crd.Name, crd.Spec.Conversion)
+		}
+	}
+}
@@ -0,0 +1,261 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package createorupdate makes sure that an object exists with the desired
+// content, following the CreateOrUpdate and CreateOrPatch pattern of
+// controller-runtime. Conflicting writes and create races are retried.
+//
+// The functions of this package work with controller-runtime clients, the
+// functions of the group version subpackages with the generated clientset.
+package createorupdate
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	jsonpatch "github.com/evanphx/json-patch"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+// CreateOrUpdate is controllerutil.CreateOrUpdate retried on conflicts and
+// create races.
+func CreateOrUpdate(ctx context.Context, c client.Client, obj client.Object, mutate controllerutil.MutateFn) (controllerutil.OperationResult, error) {
+	result := controllerutil.OperationResultNone
+	err := retry.OnError(retry.DefaultRetry, isRetriable, func() (err error) {
+		result, err = controllerutil.CreateOrUpdate(ctx, c, obj, mutate)
+		return err
+	})
+
+	return result, err
+}
+
+// CreateOrPatch is controllerutil.CreateOrPatch retried on conflicts and create
+// races.
+func CreateOrPatch(ctx context.Context, c client.Client, obj client.Object, mutate controllerutil.MutateFn) (controllerutil.OperationResult, error) {
+	result := controllerutil.OperationResultNone
+	err := retry.OnError(retry.DefaultRetry, isRetriable, func() (err error) {
+		result, err = controllerutil.CreateOrPatch(ctx, c, obj, mutate)
+		return err
+	})
+
+	return result, err
+}
+
+// Funcs adapts the typed client of a kind from the generated clientset. The
+// objects passed to and returned by the functions are of the same type.
+type Funcs struct {
+	Get         func(ctx context.Context, name string) (runtime.Object, error)
+	Create      func(ctx context.Context, obj runtime.Object) (runtime.Object, error)
+	Update      func(ctx context.Context, obj runtime.Object) (runtime.Object, error)
+	Patch       func(ctx context.Context, name string, data []byte) (runtime.Object, error)
+	PatchStatus func(ctx context.Context, name string, data []byte) (runtime.Object, error)
+}
+
+// CreateOrUpdate gets the object with the name of obj into obj and calls mutate
+// to set the desired state on it. The object is created if it does not exist
+// and updated if mutate changed it. obj is set to the server's representation
+// of the object in the end.
+func (f Funcs) CreateOrUpdate(ctx context.Context, obj client.Object, mutate controllerutil.MutateFn) (controllerutil.OperationResult, error) {
+	result := controllerutil.OperationResultNone
+	err := retry.OnError(retry.DefaultRetry, isRetriable, func() error {
+		existing, created, err := f.getOrCreate(ctx, obj, mutate)
+		if err != nil {
+			return err
+		}
+		if created {
+			result = controllerutil.OperationResultCreated
+			return nil
+		}
+
+		if err := into(existing, obj); err != nil {
+			return err
+		}
+		before := obj.DeepCopyObject()
+		if err := mutateObject(obj, mutate); err != nil {
+			return err
+		}
+		if equality.Semantic.DeepEqual(before, obj) {
+			result = controllerutil.OperationResultNone
+			return nil
+		}
+
+		updated, err := f.Update(ctx, obj)
+		if err != nil {
+			return err
+		}
+		result = controllerutil.OperationResultUpdated
+
+		return into(updated, obj)
+	})
+
+	return result, err
+}
+
+// CreateOrPatch works like CreateOrUpdate, but sends the changes made by mutate
+// as merge patches. Changes to the status are patched through the status
+// subresource.
+func (f Funcs) CreateOrPatch(ctx context.Context, obj client.Object, mutate controllerutil.MutateFn) (controllerutil.OperationResult, error) {
+	result := controllerutil.OperationResultNone
+	err := retry.OnError(retry.DefaultRetry, isRetriable, func() error {
+		existing, created, err := f.getOrCreate(ctx, obj, mutate)
+		if err != nil {
+			return err
+		}
+		if created {
+			result = controllerutil.OperationResultCreated
+			return nil
+		}
+
+		if err := into(existing, obj); err != nil {
+			return err
+		}
+		before := obj.DeepCopyObject()
+		if err := mutateObject(obj, mutate); err != nil {
+			return err
+		}
+
+		patch, statusPatch, err := mergePatches(before, obj)
+		if err != nil {
+			return err
+		}
+
+		result = controllerutil.OperationResultNone
+		if patch != nil {
+			patched, err := f.Patch(ctx, obj.GetName(), patch)
+			if err != nil {
+				return err
+			}
+			if err := into(patched, obj); err != nil {
+				return err
+			}
+			result = controllerutil.OperationResultUpdated
+		}
+		if statusPatch != nil {
+			patched, err := f.PatchStatus(ctx, obj.GetName(), statusPatch)
+			if err != nil {
+				return err
+			}
+			if err := into(patched, obj); err != nil {
+				return err
+			}
+			if result == controllerutil.OperationResultUpdated {
+				result = controllerutil.OperationResultUpdatedStatus
+			} else {
+				result = controllerutil.OperationResultUpdatedStatusOnly
+			}
+		}
+
+		return nil
+	})
+
+	return result, err
+}
+
+// getOrCreate returns the existing object or creates obj after calling mutate
+// if it does not exist yet.
+func (f Funcs) getOrCreate(ctx context.Context, obj client.Object, mutate controllerutil.MutateFn) (runtime.Object, bool, error) {
+	existing, err := f.Get(ctx, obj.GetName())
+	if err == nil {
+		return existing, false, nil
+	}
+	if !apierrors.IsNotFound(err) {
+		return nil, false, err
+	}
+
+	if err := mutateObject(obj, mutate); err != nil {
+		return nil, false, err
+	}
+	created, err := f.Create(ctx, obj)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return nil, true, into(created, obj)
+}
+
+func mutateObject(obj client.Object, mutate controllerutil.MutateFn) error {
+	name, namespace := obj.GetName(), obj.GetNamespace()
+	if err := mutate(); err != nil {
+		return err
+	}
+	if obj.GetName() != name || obj.GetNamespace() != namespace {
+		return fmt.Errorf("mutate must not change the name or namespace of the object")
+	}
+
+	return nil
+}
+
+// mergePatches returns the merge patch of the changes between before and after,
+// and the merge patch of the status changes separately. A patch is nil if
+// there are no changes.
+func mergePatches(before, after runtime.Object) ([]byte, []byte, error) {
+	beforeJSON, err := json.Marshal(before)
+	if err != nil {
+		return nil, nil, err
+	}
+	afterJSON, err := json.Marshal(after)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	data, err := jsonpatch.CreateMergePatch(beforeJSON, afterJSON)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var patch map[string]interface{}
+	if err := json.Unmarshal(data, &patch); err != nil {
+		return nil, nil, err
+	}
+
+	var statusPatch []byte
+	if status, ok := patch["status"]; ok {
+		delete(patch, "status")
+		if statusPatch, err = json.Marshal(map[string]interface{}{"status": status}); err != nil {
+			return nil, nil, err
+		}
+	}
+	if len(patch) == 0 {
+		return nil, statusPatch, nil
+	}
+
+	data, err = json.Marshal(patch)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return data, statusPatch, nil
+}
+
+// into copies src into dst, both have to be pointers to the same type.
+func into(src runtime.Object, dst client.Object) error {
+	srcValue, dstValue := reflect.ValueOf(src), reflect.ValueOf(dst)
+	if srcValue.Type() != dstValue.Type() {
+		return fmt.Errorf("cannot copy %T into %T", src, dst)
+	}
+	dstValue.Elem().Set(srcValue.Elem())
+
+	return nil
+}
+
+func isRetriable(err error) bool {
+	return apierrors.IsConflict(err) || apierrors.IsAlreadyExists(err)
+}
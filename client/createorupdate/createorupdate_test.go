@@ -0,0 +1,86 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package createorupdate
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	metav1alpha1 "github.com/banzaicloud/istio-client-go/pkg/meta/v1alpha1"
+	networkingv1beta1 "github.com/banzaicloud/istio-client-go/pkg/networking/v1beta1"
+)
+
+func TestMergePatches(t *testing.T) {
+	before := &networkingv1beta1.VirtualService{
+		ObjectMeta: metav1.ObjectMeta{Name: "reviews", Namespace: "default"},
+		Spec:       networkingv1beta1.VirtualServiceSpec{Hosts: []string{"reviews"}},
+	}
+
+	tests := []struct {
+		name                string
+		mutate              func(vs *networkingv1beta1.VirtualService)
+		expectedPatch       string
+		expectedStatusPatch string
+	}{
+		{
+			name: "no changes",
+			mutate: func(vs *networkingv1beta1.VirtualService) {
+				vs.Spec.Hosts = []string{"reviews"}
+			},
+		},
+		{
+			name: "spec",
+			mutate: func(vs *networkingv1beta1.VirtualService) {
+				vs.Spec.Gateways = []string{"mesh"}
+			},
+			expectedPatch: `{"spec":{"gateways":["mesh"]}}`,
+		},
+		{
+			name: "status",
+			mutate: func(vs *networkingv1beta1.VirtualService) {
+				vs.Status = &metav1alpha1.IstioStatus{ObservedGeneration: 2}
+			},
+			expectedStatusPatch: `{"status":{"observedGeneration":2}}`,
+		},
+		{
+			name: "metadata, spec and status",
+			mutate: func(vs *networkingv1beta1.VirtualService) {
+				vs.Labels = map[string]string{"app": "reviews"}
+				vs.Spec.Hosts = nil
+				vs.Status = &metav1alpha1.IstioStatus{ObservedGeneration: 2}
+			},
+			expectedPatch:       `{"metadata":{"labels":{"app":"reviews"}},"spec":{"hosts":null}}`,
+			expectedStatusPatch: `{"status":{"observedGeneration":2}}`,
+		},
+	}
+
+	for _, test := range tests {
+		after := before.DeepCopy()
+		test.mutate(after)
+
+		patch, statusPatch, err := mergePatches(before, after)
+		if err != nil {
+			t.Errorf("%s: unexpected error %v", test.name, err)
+			continue
+		}
+		if string(patch) != test.expectedPatch {
+			t.Errorf("%s: unexpected patch %s, expected %s", test.name, patch, test.expectedPatch)
+		}
+		if string(statusPatch) != test.expectedStatusPatch {
+			t.Errorf("%s: unexpected status patch %s, expected %s", test.name, statusPatch, test.expectedStatusPatch)
+		}
+	}
+}
@@ -0,0 +1,60 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha3
+
+import (
+	"context"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	v1alpha3 "github.com/banzaicloud/istio-client-go/client/clientset/versioned/typed/networking/v1alpha3"
+	"github.com/banzaicloud/istio-client-go/client/createorupdate"
+	networkingv1alpha3 "github.com/banzaicloud/istio-client-go/pkg/networking/v1alpha3"
+)
+
+// CreateOrUpdateSidecar creates the sidecar or updates it with the changes made by
+// mutate. See createorupdate.Funcs.CreateOrUpdate for details.
+func CreateOrUpdateSidecar(ctx context.Context, client v1alpha3.SidecarInterface, obj *networkingv1alpha3.Sidecar, mutate controllerutil.MutateFn) (controllerutil.OperationResult, error) {
+	return sidecarFuncs(client).CreateOrUpdate(ctx, obj, mutate)
+}
+
+// CreateOrPatchSidecar creates the sidecar or patches it with the changes made by
+// mutate. See createorupdate.Funcs.CreateOrPatch for details.
+func CreateOrPatchSidecar(ctx context.Context, client v1alpha3.SidecarInterface, obj *networkingv1alpha3.Sidecar, mutate controllerutil.MutateFn) (controllerutil.OperationResult, error) {
+	return sidecarFuncs(client).CreateOrPatch(ctx, obj, mutate)
+}
+
+func sidecarFuncs(client v1alpha3.SidecarInterface) createorupdate.Funcs {
+	return createorupdate.Funcs{
+		Get: func(ctx context.Context, name string) (runtime.Object, error) {
+			return client.Get(ctx, name, metav1.GetOptions{})
+		},
+		Create: func(ctx context.Context, obj runtime.Object) (runtime.Object, error) {
+			return client.Create(ctx, obj.(*networkingv1alpha3.Sidecar), metav1.CreateOptions{})
+		},
+		Update: func(ctx context.Context, obj runtime.Object) (runtime.Object, error) {
+			return client.Update(ctx, obj.(*networkingv1alpha3.Sidecar), metav1.UpdateOptions{})
+		},
+		Patch: func(ctx context.Context, name string, data []byte) (runtime.Object, error) {
+			return client.Patch(ctx, name, types.MergePatchType, data, metav1.PatchOptions{})
+		},
+		PatchStatus: func(ctx context.Context, name string, data []byte) (runtime.Object, error) {
+			return client.PatchStatus(ctx, name, types.MergePatchType, data, metav1.PatchOptions{})
+		},
+	}
+}
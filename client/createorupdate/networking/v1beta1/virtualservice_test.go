@@ -0,0 +1,249 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1beta1
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	clienttesting "k8s.io/client-go/testing"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	"github.com/banzaicloud/istio-client-go/client/clientset/versioned/fake"
+	metav1alpha1 "github.com/banzaicloud/istio-client-go/pkg/meta/v1alpha1"
+	networkingv1beta1 "github.com/banzaicloud/istio-client-go/pkg/networking/v1beta1"
+)
+
+var virtualServices = schema.GroupResource{Group: "networking.istio.io", Resource: "virtualservices"}
+
+func newVirtualService(hosts ...string) *networkingv1beta1.VirtualService {
+	return &networkingv1beta1.VirtualService{
+		ObjectMeta: metav1.ObjectMeta{Name: "reviews", Namespace: "default"},
+		Spec:       networkingv1beta1.VirtualServiceSpec{Hosts: hosts},
+	}
+}
+
+// failOnce makes the first call of the verb fail with the error.
+func failOnce(clientset *fake.Clientset, verb string, err error) {
+	failed := false
+	clientset.PrependReactor(verb, "virtualservices", func(clienttesting.Action) (bool, runtime.Object, error) {
+		if failed {
+			return false, nil, nil
+		}
+		failed = true
+		return true, nil, err
+	})
+}
+
+// verbs returns the verbs of the actions, with their subresource if any.
+func verbs(actions []clienttesting.Action) []string {
+	var out []string
+	for _, action := range actions {
+		verb := action.GetVerb()
+		if action.GetSubresource() != "" {
+			verb += " " + action.GetSubresource()
+		}
+		out = append(out, verb)
+	}
+
+	return out
+}
+
+func TestCreateOrUpdateVirtualService(t *testing.T) {
+	tests := []struct {
+		name           string
+		existing       []runtime.Object
+		prepare        func(clientset *fake.Clientset)
+		hosts          []string
+		expectedResult controllerutil.OperationResult
+		expectedVerbs  []string
+	}{
+		{
+			name:           "create",
+			hosts:          []string{"reviews"},
+			expectedResult: controllerutil.OperationResultCreated,
+			expectedVerbs:  []string{"get", "create"},
+		},
+		{
+			name:           "unchanged",
+			existing:       []runtime.Object{newVirtualService("reviews")},
+			hosts:          []string{"reviews"},
+			expectedResult: controllerutil.OperationResultNone,
+			expectedVerbs:  []string{"get"},
+		},
+		{
+			name:           "update",
+			existing:       []runtime.Object{newVirtualService("reviews")},
+			hosts:          []string{"reviews", "reviews.default.svc.cluster.local"},
+			expectedResult: controllerutil.OperationResultUpdated,
+			expectedVerbs:  []string{"get", "update"},
+		},
+		{
+			name:     "conflict",
+			existing: []runtime.Object{newVirtualService("reviews")},
+			prepare: func(clientset *fake.Clientset) {
+				failOnce(clientset, "update", apierrors.NewConflict(virtualServices, "reviews", nil))
+			},
+			hosts:          []string{"reviews", "reviews.default.svc.cluster.local"},
+			expectedResult: controllerutil.OperationResultUpdated,
+			expectedVerbs:  []string{"get", "update", "get", "update"},
+		},
+		{
+			name:     "create race",
+			existing: []runtime.Object{newVirtualService("reviews")},
+			prepare: func(clientset *fake.Clientset) {
+				failOnce(clientset, "get", apierrors.NewNotFound(virtualServices, "reviews"))
+			},
+			hosts:          []string{"reviews", "reviews.default.svc.cluster.local"},
+			expectedResult: controllerutil.OperationResultUpdated,
+			expectedVerbs:  []string{"get", "create", "get", "update"},
+		},
+	}
+
+	for _, test := range tests {
+		clientset := fake.NewSimpleClientset(test.existing...)
+		if test.prepare != nil {
+			test.prepare(clientset)
+		}
+		client := clientset.NetworkingV1beta1().VirtualServices("default")
+
+		vs := newVirtualService()
+		result, err := CreateOrUpdateVirtualService(context.Background(), client, vs, func() error {
+			vs.Spec.Hosts = test.hosts
+			return nil
+		})
+		if err != nil {
+			t.Errorf("%s: unexpected error %v", test.name, err)
+			continue
+		}
+		if result != test.expectedResult {
+			t.Errorf("%s: unexpected result %q, expected %q", test.name, result, test.expectedResult)
+		}
+		if actual := verbs(clientset.Actions()); !reflect.DeepEqual(actual, test.expectedVerbs) {
+			t.Errorf("%s: unexpected actions %q, expected %q", test.name, actual, test.expectedVerbs)
+		}
+
+		actual, err := client.Get(context.Background(), "reviews", metav1.GetOptions{})
+		if err != nil {
+			t.Errorf("%s: unexpected error %v", test.name, err)
+		} else if !reflect.DeepEqual(actual.Spec.Hosts, test.hosts) {
+			t.Errorf("%s: unexpected hosts %q, expected %q", test.name, actual.Spec.Hosts, test.hosts)
+		}
+	}
+}
+
+func TestCreateOrPatchVirtualService(t *testing.T) {
+	tests := []struct {
+		name           string
+		mutate         func(vs *networkingv1beta1.VirtualService)
+		prepare        func(clientset *fake.Clientset)
+		expectedResult controllerutil.OperationResult
+		expectedVerbs  []string
+	}{
+		{
+			name: "unchanged",
+			mutate: func(vs *networkingv1beta1.VirtualService) {
+				vs.Spec.Hosts = []string{"reviews"}
+			},
+			expectedResult: controllerutil.OperationResultNone,
+			expectedVerbs:  []string{"get"},
+		},
+		{
+			name: "spec",
+			mutate: func(vs *networkingv1beta1.VirtualService) {
+				vs.Spec.Gateways = []string{"mesh"}
+			},
+			expectedResult: controllerutil.OperationResultUpdated,
+			expectedVerbs:  []string{"get", "patch"},
+		},
+		{
+			name: "status",
+			mutate: func(vs *networkingv1beta1.VirtualService) {
+				vs.Status = &metav1alpha1.IstioStatus{ObservedGeneration: 2}
+			},
+			expectedResult: controllerutil.OperationResultUpdatedStatusOnly,
+			expectedVerbs:  []string{"get", "patch status"},
+		},
+		{
+			name: "spec and status",
+			mutate: func(vs *networkingv1beta1.VirtualService) {
+				vs.Spec.Gateways = []string{"mesh"}
+				vs.Status = &metav1alpha1.IstioStatus{ObservedGeneration: 2}
+			},
+			expectedResult: controllerutil.OperationResultUpdatedStatus,
+			expectedVerbs:  []string{"get", "patch", "patch status"},
+		},
+		{
+			name: "conflict",
+			mutate: func(vs *networkingv1beta1.VirtualService) {
+				vs.Spec.Gateways = []string{"mesh"}
+			},
+			prepare: func(clientset *fake.Clientset) {
+				failOnce(clientset, "patch", apierrors.NewConflict(virtualServices, "reviews", nil))
+			},
+			expectedResult: controllerutil.OperationResultUpdated,
+			expectedVerbs:  []string{"get", "patch", "get", "patch"},
+		},
+	}
+
+	for _, test := range tests {
+		clientset := fake.NewSimpleClientset(newVirtualService("reviews"))
+		if test.prepare != nil {
+			test.prepare(clientset)
+		}
+		client := clientset.NetworkingV1beta1().VirtualServices("default")
+
+		vs := newVirtualService()
+		result, err := CreateOrPatchVirtualService(context.Background(), client, vs, func() error {
+			test.mutate(vs)
+			return nil
+		})
+		if err != nil {
+			t.Errorf("%s: unexpected error %v", test.name, err)
+			continue
+		}
+		if result != test.expectedResult {
+			t.Errorf("%s: unexpected result %q, expected %q", test.name, result, test.expectedResult)
+		}
+		actions := clientset.Actions()
+		if actual := verbs(actions); !reflect.DeepEqual(actual, test.expectedVerbs) {
+			t.Errorf("%s: unexpected actions %q, expected %q", test.name, actual, test.expectedVerbs)
+			continue
+		}
+
+		// the status is only patched through the status subresource
+		for _, action := range actions {
+			patch, ok := action.(clienttesting.PatchAction)
+			if !ok {
+				continue
+			}
+			var content map[string]interface{}
+			if err := json.Unmarshal(patch.GetPatch(), &content); err != nil {
+				t.Errorf("%s: unexpected error %v", test.name, err)
+				continue
+			}
+			_, hasStatus := content["status"]
+			if hasStatus != (action.GetSubresource() == "status") {
+				t.Errorf("%s: unexpected patch %s of %q", test.name, patch.GetPatch(), action.GetSubresource())
+			}
+		}
+	}
+}
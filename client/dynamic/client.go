@@ -0,0 +1,195 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package dynamic converts between the unstructured objects of a dynamic
+// client and the typed Istio structs. The typed wrappers for each kind live
+// in the group version subpackages.
+package dynamic
+
+import (
+	"context"
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/dynamic"
+)
+
+// Client works with the objects of a single resource through a dynamic client
+// and converts them to and from typed objects.
+type Client struct {
+	resource dynamic.ResourceInterface
+	gvk      schema.GroupVersionKind
+}
+
+// New returns a client for the resource in the namespace. The kind is set on
+// every object sent to the API server, since typed objects usually come with
+// an empty TypeMeta.
+func New(client dynamic.Interface, gvk schema.GroupVersionKind, gvr schema.GroupVersionResource, namespace string) *Client {
+	var resource dynamic.ResourceInterface = client.Resource(gvr)
+	if namespace != "" {
+		resource = client.Resource(gvr).Namespace(namespace)
+	}
+
+	return &Client{
+		resource: resource,
+		gvk:      gvk,
+	}
+}
+
+// Get fetches the object with the given name into obj.
+func (c *Client) Get(ctx context.Context, name string, opts metav1.GetOptions, obj runtime.Object) error {
+	u, err := c.resource.Get(ctx, name, opts)
+	if err != nil {
+		return err
+	}
+
+	return FromUnstructured(u.UnstructuredContent(), obj)
+}
+
+// List lists the objects into the typed list.
+func (c *Client) List(ctx context.Context, opts metav1.ListOptions, list runtime.Object) error {
+	u, err := c.resource.List(ctx, opts)
+	if err != nil {
+		return err
+	}
+
+	return FromUnstructured(u.UnstructuredContent(), list)
+}
+
+// Watch watches the objects and converts the objects of the events with
+// newFunc. Events with objects that cannot be converted are turned into
+// error events.
+func (c *Client) Watch(ctx context.Context, opts metav1.ListOptions, newFunc func() runtime.Object) (watch.Interface, error) {
+	w, err := c.resource.Watch(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return watch.Filter(w, func(in watch.Event) (watch.Event, bool) {
+		u, ok := in.Object.(*unstructured.Unstructured)
+		if !ok || in.Type == watch.Error {
+			return in, true
+		}
+
+		obj := newFunc()
+		if err := FromUnstructured(u.UnstructuredContent(), obj); err != nil {
+			return watch.Event{
+				Type: watch.Error,
+				Object: &metav1.Status{
+					Status:  metav1.StatusFailure,
+					Message: err.Error(),
+				},
+			}, true
+		}
+
+		return watch.Event{Type: in.Type, Object: obj}, true
+	}), nil
+}
+
+// Create creates obj and stores the server's representation of it into result.
+func (c *Client) Create(ctx context.Context, obj runtime.Object, opts metav1.CreateOptions, result runtime.Object) error {
+	u, err := c.toUnstructured(obj)
+	if err != nil {
+		return err
+	}
+
+	u, err = c.resource.Create(ctx, u, opts)
+	if err != nil {
+		return err
+	}
+
+	return FromUnstructured(u.UnstructuredContent(), result)
+}
+
+// Update updates obj and stores the server's representation of it into result.
+func (c *Client) Update(ctx context.Context, obj runtime.Object, opts metav1.UpdateOptions, result runtime.Object) error {
+	u, err := c.toUnstructured(obj)
+	if err != nil {
+		return err
+	}
+
+	u, err = c.resource.Update(ctx, u, opts)
+	if err != nil {
+		return err
+	}
+
+	return FromUnstructured(u.UnstructuredContent(), result)
+}
+
+// UpdateStatus updates the status subresource of obj and stores the server's
+// representation of it into result.
+func (c *Client) UpdateStatus(ctx context.Context, obj runtime.Object, opts metav1.UpdateOptions, result runtime.Object) error {
+	u, err := c.toUnstructured(obj)
+	if err != nil {
+		return err
+	}
+
+	u, err = c.resource.UpdateStatus(ctx, u, opts)
+	if err != nil {
+		return err
+	}
+
+	return FromUnstructured(u.UnstructuredContent(), result)
+}
+
+// Delete deletes the object with the given name.
+func (c *Client) Delete(ctx context.Context, name string, opts metav1.DeleteOptions) error {
+	return c.resource.Delete(ctx, name, opts)
+}
+
+// Patch patches the object with the given name and stores the result into result.
+func (c *Client) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions, result runtime.Object, subresources ...string) error {
+	u, err := c.resource.Patch(ctx, name, pt, data, opts, subresources...)
+	if err != nil {
+		return err
+	}
+
+	return FromUnstructured(u.UnstructuredContent(), result)
+}
+
+func (c *Client) toUnstructured(obj runtime.Object) (*unstructured.Unstructured, error) {
+	content, err := ToUnstructured(obj)
+	if err != nil {
+		return nil, err
+	}
+
+	u := &unstructured.Unstructured{Object: content}
+	u.SetGroupVersionKind(c.gvk)
+
+	return u, nil
+}
+
+// FromUnstructured converts the content of an unstructured object or list to obj.
+func FromUnstructured(content map[string]interface{}, obj runtime.Object) error {
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(content, obj); err != nil {
+		return fmt.Errorf("could not convert unstructured object to %T: %w", obj, err)
+	}
+
+	return nil
+}
+
+// ToUnstructured converts obj to the content of an unstructured object.
+func ToUnstructured(obj runtime.Object) (map[string]interface{}, error) {
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return nil, fmt.Errorf("could not convert %T to unstructured object: %w", obj, err)
+	}
+
+	return content, nil
+}
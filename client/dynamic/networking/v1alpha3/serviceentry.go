@@ -0,0 +1,113 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha3
+
+import (
+	"context"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/dynamic"
+
+	istiodynamic "github.com/banzaicloud/istio-client-go/client/dynamic"
+	networkingv1alpha3 "github.com/banzaicloud/istio-client-go/pkg/networking/v1alpha3"
+)
+
+// ServiceEntryClient works with ServiceEntries through a dynamic client.
+type ServiceEntryClient struct {
+	client *istiodynamic.Client
+}
+
+// ServiceEntries returns a client for the ServiceEntries in the namespace.
+func ServiceEntries(client dynamic.Interface, namespace string) *ServiceEntryClient {
+	return &ServiceEntryClient{
+		client: istiodynamic.New(client, networkingv1alpha3.ServiceEntryGroupVersionKind, networkingv1alpha3.ServiceEntryGroupVersionResource, namespace),
+	}
+}
+
+// Get returns the serviceEntry with the given name.
+func (c *ServiceEntryClient) Get(ctx context.Context, name string, opts metav1.GetOptions) (*networkingv1alpha3.ServiceEntry, error) {
+	result := &networkingv1alpha3.ServiceEntry{}
+	if err := c.client.Get(ctx, name, opts, result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// List returns the serviceEntries matching the options.
+func (c *ServiceEntryClient) List(ctx context.Context, opts metav1.ListOptions) (*networkingv1alpha3.ServiceEntryList, error) {
+	result := &networkingv1alpha3.ServiceEntryList{}
+	if err := c.client.List(ctx, opts, result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// Watch watches the serviceEntries matching the options. The objects of the events are
+// *networkingv1alpha3.ServiceEntry.
+func (c *ServiceEntryClient) Watch(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error) {
+	return c.client.Watch(ctx, opts, func() runtime.Object {
+		return &networkingv1alpha3.ServiceEntry{}
+	})
+}
+
+// Create creates the serviceEntry and returns the server's representation of it.
+func (c *ServiceEntryClient) Create(ctx context.Context, serviceEntry *networkingv1alpha3.ServiceEntry, opts metav1.CreateOptions) (*networkingv1alpha3.ServiceEntry, error) {
+	result := &networkingv1alpha3.ServiceEntry{}
+	if err := c.client.Create(ctx, serviceEntry, opts, result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// Update updates the serviceEntry and returns the server's representation of it.
+func (c *ServiceEntryClient) Update(ctx context.Context, serviceEntry *networkingv1alpha3.ServiceEntry, opts metav1.UpdateOptions) (*networkingv1alpha3.ServiceEntry, error) {
+	result := &networkingv1alpha3.ServiceEntry{}
+	if err := c.client.Update(ctx, serviceEntry, opts, result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// UpdateStatus updates the status of the serviceEntry and returns the server's representation of it.
+func (c *ServiceEntryClient) UpdateStatus(ctx context.Context, serviceEntry *networkingv1alpha3.ServiceEntry, opts metav1.UpdateOptions) (*networkingv1alpha3.ServiceEntry, error) {
+	result := &networkingv1alpha3.ServiceEntry{}
+	if err := c.client.UpdateStatus(ctx, serviceEntry, opts, result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// Delete deletes the serviceEntry with the given name.
+func (c *ServiceEntryClient) Delete(ctx context.Context, name string, opts metav1.DeleteOptions) error {
+	return c.client.Delete(ctx, name, opts)
+}
+
+// Patch applies the patch to the serviceEntry with the given name and returns the patched serviceEntry.
+func (c *ServiceEntryClient) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions, subresources ...string) (*networkingv1alpha3.ServiceEntry, error) {
+	result := &networkingv1alpha3.ServiceEntry{}
+	if err := c.client.Patch(ctx, name, pt, data, opts, result, subresources...); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
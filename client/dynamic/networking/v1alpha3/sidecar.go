@@ -0,0 +1,113 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha3
+
+import (
+	"context"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/dynamic"
+
+	istiodynamic "github.com/banzaicloud/istio-client-go/client/dynamic"
+	networkingv1alpha3 "github.com/banzaicloud/istio-client-go/pkg/networking/v1alpha3"
+)
+
+// SidecarClient works with Sidecars through a dynamic client.
+type SidecarClient struct {
+	client *istiodynamic.Client
+}
+
+// Sidecars returns a client for the Sidecars in the namespace.
+func Sidecars(client dynamic.Interface, namespace string) *SidecarClient {
+	return &SidecarClient{
+		client: istiodynamic.New(client, networkingv1alpha3.SidecarGroupVersionKind, networkingv1alpha3.SidecarGroupVersionResource, namespace),
+	}
+}
+
+// Get returns the sidecar with the given name.
+func (c *SidecarClient) Get(ctx context.Context, name string, opts metav1.GetOptions) (*networkingv1alpha3.Sidecar, error) {
+	result := &networkingv1alpha3.Sidecar{}
+	if err := c.client.Get(ctx, name, opts, result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// List returns the sidecars matching the options.
+func (c *SidecarClient) List(ctx context.Context, opts metav1.ListOptions) (*networkingv1alpha3.SidecarList, error) {
+	result := &networkingv1alpha3.SidecarList{}
+	if err := c.client.List(ctx, opts, result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// Watch watches the sidecars matching the options. The objects of the events are
+// *networkingv1alpha3.Sidecar.
+func (c *SidecarClient) Watch(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error) {
+	return c.client.Watch(ctx, opts, func() runtime.Object {
+		return &networkingv1alpha3.Sidecar{}
+	})
+}
+
+// Create creates the sidecar and returns the server's representation of it.
+func (c *SidecarClient) Create(ctx context.Context, sidecar *networkingv1alpha3.Sidecar, opts metav1.CreateOptions) (*networkingv1alpha3.Sidecar, error) {
+	result := &networkingv1alpha3.Sidecar{}
+	if err := c.client.Create(ctx, sidecar, opts, result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// Update updates the sidecar and returns the server's representation of it.
+func (c *SidecarClient) Update(ctx context.Context, sidecar *networkingv1alpha3.Sidecar, opts metav1.UpdateOptions) (*networkingv1alpha3.Sidecar, error) {
+	result := &networkingv1alpha3.Sidecar{}
+	if err := c.client.Update(ctx, sidecar, opts, result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// UpdateStatus updates the status of the sidecar and returns the server's representation of it.
+func (c *SidecarClient) UpdateStatus(ctx context.Context, sidecar *networkingv1alpha3.Sidecar, opts metav1.UpdateOptions) (*networkingv1alpha3.Sidecar, error) {
+	result := &networkingv1alpha3.Sidecar{}
+	if err := c.client.UpdateStatus(ctx, sidecar, opts, result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// Delete deletes the sidecar with the given name.
+func (c *SidecarClient) Delete(ctx context.Context, name string, opts metav1.DeleteOptions) error {
+	return c.client.Delete(ctx, name, opts)
+}
+
+// Patch applies the patch to the sidecar with the given name and returns the patched sidecar.
+func (c *SidecarClient) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions, subresources ...string) (*networkingv1alpha3.Sidecar, error) {
+	result := &networkingv1alpha3.Sidecar{}
+	if err := c.client.Patch(ctx, name, pt, data, opts, result, subresources...); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
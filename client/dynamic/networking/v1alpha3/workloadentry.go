@@ -0,0 +1,113 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha3
+
+import (
+	"context"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/dynamic"
+
+	istiodynamic "github.com/banzaicloud/istio-client-go/client/dynamic"
+	networkingv1alpha3 "github.com/banzaicloud/istio-client-go/pkg/networking/v1alpha3"
+)
+
+// WorkloadEntryClient works with WorkloadEntries through a dynamic client.
+type WorkloadEntryClient struct {
+	client *istiodynamic.Client
+}
+
+// WorkloadEntries returns a client for the WorkloadEntries in the namespace.
+func WorkloadEntries(client dynamic.Interface, namespace string) *WorkloadEntryClient {
+	return &WorkloadEntryClient{
+		client: istiodynamic.New(client, networkingv1alpha3.WorkloadEntryGroupVersionKind, networkingv1alpha3.WorkloadEntryGroupVersionResource, namespace),
+	}
+}
+
+// Get returns the workloadEntry with the given name.
+func (c *WorkloadEntryClient) Get(ctx context.Context, name string, opts metav1.GetOptions) (*networkingv1alpha3.WorkloadEntry, error) {
+	result := &networkingv1alpha3.WorkloadEntry{}
+	if err := c.client.Get(ctx, name, opts, result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// List returns the workloadEntries matching the options.
+func (c *WorkloadEntryClient) List(ctx context.Context, opts metav1.ListOptions) (*networkingv1alpha3.WorkloadEntryList, error) {
+	result := &networkingv1alpha3.WorkloadEntryList{}
+	if err := c.client.List(ctx, opts, result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// Watch watches the workloadEntries matching the options. The objects of the events are
+// *networkingv1alpha3.WorkloadEntry.
+func (c *WorkloadEntryClient) Watch(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error) {
+	return c.client.Watch(ctx, opts, func() runtime.Object {
+		return &networkingv1alpha3.WorkloadEntry{}
+	})
+}
+
+// Create creates the workloadEntry and returns the server's representation of it.
+func (c *WorkloadEntryClient) Create(ctx context.Context, workloadEntry *networkingv1alpha3.WorkloadEntry, opts metav1.CreateOptions) (*networkingv1alpha3.WorkloadEntry, error) {
+	result := &networkingv1alpha3.WorkloadEntry{}
+	if err := c.client.Create(ctx, workloadEntry, opts, result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// Update updates the workloadEntry and returns the server's representation of it.
+func (c *WorkloadEntryClient) Update(ctx context.Context, workloadEntry *networkingv1alpha3.WorkloadEntry, opts metav1.UpdateOptions) (*networkingv1alpha3.WorkloadEntry, error) {
+	result := &networkingv1alpha3.WorkloadEntry{}
+	if err := c.client.Update(ctx, workloadEntry, opts, result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// UpdateStatus updates the status of the workloadEntry and returns the server's representation of it.
+func (c *WorkloadEntryClient) UpdateStatus(ctx context.Context, workloadEntry *networkingv1alpha3.WorkloadEntry, opts metav1.UpdateOptions) (*networkingv1alpha3.WorkloadEntry, error) {
+	result := &networkingv1alpha3.WorkloadEntry{}
+	if err := c.client.UpdateStatus(ctx, workloadEntry, opts, result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// Delete deletes the workloadEntry with the given name.
+func (c *WorkloadEntryClient) Delete(ctx context.Context, name string, opts metav1.DeleteOptions) error {
+	return c.client.Delete(ctx, name, opts)
+}
+
+// Patch applies the patch to the workloadEntry with the given name and returns the patched workloadEntry.
+func (c *WorkloadEntryClient) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions, subresources ...string) (*networkingv1alpha3.WorkloadEntry, error) {
+	result := &networkingv1alpha3.WorkloadEntry{}
+	if err := c.client.Patch(ctx, name, pt, data, opts, result, subresources...); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
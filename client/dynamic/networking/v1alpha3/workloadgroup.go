@@ -0,0 +1,113 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha3
+
+import (
+	"context"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/dynamic"
+
+	istiodynamic "github.com/banzaicloud/istio-client-go/client/dynamic"
+	networkingv1alpha3 "github.com/banzaicloud/istio-client-go/pkg/networking/v1alpha3"
+)
+
+// WorkloadGroupClient works with WorkloadGroups through a dynamic client.
+type WorkloadGroupClient struct {
+	client *istiodynamic.Client
+}
+
+// WorkloadGroups returns a client for the WorkloadGroups in the namespace.
+func WorkloadGroups(client dynamic.Interface, namespace string) *WorkloadGroupClient {
+	return &WorkloadGroupClient{
+		client: istiodynamic.New(client, networkingv1alpha3.WorkloadGroupGroupVersionKind, networkingv1alpha3.WorkloadGroupGroupVersionResource, namespace),
+	}
+}
+
+// Get returns the workloadGroup with the given name.
+func (c *WorkloadGroupClient) Get(ctx context.Context, name string, opts metav1.GetOptions) (*networkingv1alpha3.WorkloadGroup, error) {
+	result := &networkingv1alpha3.WorkloadGroup{}
+	if err := c.client.Get(ctx, name, opts, result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// List returns the workloadGroups matching the options.
+func (c *WorkloadGroupClient) List(ctx context.Context, opts metav1.ListOptions) (*networkingv1alpha3.WorkloadGroupList, error) {
+	result := &networkingv1alpha3.WorkloadGroupList{}
+	if err := c.client.List(ctx, opts, result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// Watch watches the workloadGroups matching the options. The objects of the events are
+// *networkingv1alpha3.WorkloadGroup.
+func (c *WorkloadGroupClient) Watch(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error) {
+	return c.client.Watch(ctx, opts, func() runtime.Object {
+		return &networkingv1alpha3.WorkloadGroup{}
+	})
+}
+
+// Create creates the workloadGroup and returns the server's representation of it.
+func (c *WorkloadGroupClient) Create(ctx context.Context, workloadGroup *networkingv1alpha3.WorkloadGroup, opts metav1.CreateOptions) (*networkingv1alpha3.WorkloadGroup, error) {
+	result := &networkingv1alpha3.WorkloadGroup{}
+	if err := c.client.Create(ctx, workloadGroup, opts, result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// Update updates the workloadGroup and returns the server's representation of it.
+func (c *WorkloadGroupClient) Update(ctx context.Context, workloadGroup *networkingv1alpha3.WorkloadGroup, opts metav1.UpdateOptions) (*networkingv1alpha3.WorkloadGroup, error) {
+	result := &networkingv1alpha3.WorkloadGroup{}
+	if err := c.client.Update(ctx, workloadGroup, opts, result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// UpdateStatus updates the status of the workloadGroup and returns the server's representation of it.
+func (c *WorkloadGroupClient) UpdateStatus(ctx context.Context, workloadGroup *networkingv1alpha3.WorkloadGroup, opts metav1.UpdateOptions) (*networkingv1alpha3.WorkloadGroup, error) {
+	result := &networkingv1alpha3.WorkloadGroup{}
+	if err := c.client.UpdateStatus(ctx, workloadGroup, opts, result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// Delete deletes the workloadGroup with the given name.
+func (c *WorkloadGroupClient) Delete(ctx context.Context, name string, opts metav1.DeleteOptions) error {
+	return c.client.Delete(ctx, name, opts)
+}
+
+// Patch applies the patch to the workloadGroup with the given name and returns the patched workloadGroup.
+func (c *WorkloadGroupClient) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions, subresources ...string) (*networkingv1alpha3.WorkloadGroup, error) {
+	result := &networkingv1alpha3.WorkloadGroup{}
+	if err := c.client.Patch(ctx, name, pt, data, opts, result, subresources...); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
@@ -0,0 +1,113 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1beta1
+
+import (
+	"context"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/dynamic"
+
+	istiodynamic "github.com/banzaicloud/istio-client-go/client/dynamic"
+	networkingv1beta1 "github.com/banzaicloud/istio-client-go/pkg/networking/v1beta1"
+)
+
+// GatewayClient works with Gateways through a dynamic client.
+type GatewayClient struct {
+	client *istiodynamic.Client
+}
+
+// Gateways returns a client for the Gateways in the namespace.
+func Gateways(client dynamic.Interface, namespace string) *GatewayClient {
+	return &GatewayClient{
+		client: istiodynamic.New(client, networkingv1beta1.GatewayGroupVersionKind, networkingv1beta1.GatewayGroupVersionResource, namespace),
+	}
+}
+
+// Get returns the gateway with the given name.
+func (c *GatewayClient) Get(ctx context.Context, name string, opts metav1.GetOptions) (*networkingv1beta1.Gateway, error) {
+	result := &networkingv1beta1.Gateway{}
+	if err := c.client.Get(ctx, name, opts, result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// List returns the gateways matching the options.
+func (c *GatewayClient) List(ctx context.Context, opts metav1.ListOptions) (*networkingv1beta1.GatewayList, error) {
+	result := &networkingv1beta1.GatewayList{}
+	if err := c.client.List(ctx, opts, result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// Watch watches the gateways matching the options. The objects of the events are
+// *networkingv1beta1.Gateway.
+func (c *GatewayClient) Watch(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error) {
+	return c.client.Watch(ctx, opts, func() runtime.Object {
+		return &networkingv1beta1.Gateway{}
+	})
+}
+
+// Create creates the gateway and returns the server's representation of it.
+func (c *GatewayClient) Create(ctx context.Context, gateway *networkingv1beta1.Gateway, opts metav1.CreateOptions) (*networkingv1beta1.Gateway, error) {
+	result := &networkingv1beta1.Gateway{}
+	if err := c.client.Create(ctx, gateway, opts, result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// Update updates the gateway and returns the server's representation of it.
+func (c *GatewayClient) Update(ctx context.Context, gateway *networkingv1beta1.Gateway, opts metav1.UpdateOptions) (*networkingv1beta1.Gateway, error) {
+	result := &networkingv1beta1.Gateway{}
+	if err := c.client.Update(ctx, gateway, opts, result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// UpdateStatus updates the status of the gateway and returns the server's representation of it.
+func (c *GatewayClient) UpdateStatus(ctx context.Context, gateway *networkingv1beta1.Gateway, opts metav1.UpdateOptions) (*networkingv1beta1.Gateway, error) {
+	result := &networkingv1beta1.Gateway{}
+	if err := c.client.UpdateStatus(ctx, gateway, opts, result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// Delete deletes the gateway with the given name.
+func (c *GatewayClient) Delete(ctx context.Context, name string, opts metav1.DeleteOptions) error {
+	return c.client.Delete(ctx, name, opts)
+}
+
+// Patch applies the patch to the gateway with the given name and returns the patched gateway.
+func (c *GatewayClient) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions, subresources ...string) (*networkingv1beta1.Gateway, error) {
+	result := &networkingv1beta1.Gateway{}
+	if err := c.client.Patch(ctx, name, pt, data, opts, result, subresources...); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
@@ -0,0 +1,113 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1beta1
+
+import (
+	"context"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/dynamic"
+
+	istiodynamic "github.com/banzaicloud/istio-client-go/client/dynamic"
+	securityv1beta1 "github.com/banzaicloud/istio-client-go/pkg/security/v1beta1"
+)
+
+// AuthorizationPolicyClient works with AuthorizationPolicies through a dynamic client.
+type AuthorizationPolicyClient struct {
+	client *istiodynamic.Client
+}
+
+// AuthorizationPolicies returns a client for the AuthorizationPolicies in the namespace.
+func AuthorizationPolicies(client dynamic.Interface, namespace string) *AuthorizationPolicyClient {
+	return &AuthorizationPolicyClient{
+		client: istiodynamic.New(client, securityv1beta1.AuthorizationPolicyGroupVersionKind, securityv1beta1.AuthorizationPolicyGroupVersionResource, namespace),
+	}
+}
+
+// Get returns the authorizationPolicy with the given name.
+func (c *AuthorizationPolicyClient) Get(ctx context.Context, name string, opts metav1.GetOptions) (*securityv1beta1.AuthorizationPolicy, error) {
+	result := &securityv1beta1.AuthorizationPolicy{}
+	if err := c.client.Get(ctx, name, opts, result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// List returns the authorizationPolicies matching the options.
+func (c *AuthorizationPolicyClient) List(ctx context.Context, opts metav1.ListOptions) (*securityv1beta1.AuthorizationPolicyList, error) {
+	result := &securityv1beta1.AuthorizationPolicyList{}
+	if err := c.client.List(ctx, opts, result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// Watch watches the authorizationPolicies matching the options. The objects of the events are
+// *securityv1beta1.AuthorizationPolicy.
+func (c *AuthorizationPolicyClient) Watch(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error) {
+	return c.client.Watch(ctx, opts, func() runtime.Object {
+		return &securityv1beta1.AuthorizationPolicy{}
+	})
+}
+
+// Create creates the authorizationPolicy and returns the server's representation of it.
+func (c *AuthorizationPolicyClient) Create(ctx context.Context, authorizationPolicy *securityv1beta1.AuthorizationPolicy, opts metav1.CreateOptions) (*securityv1beta1.AuthorizationPolicy, error) {
+	result := &securityv1beta1.AuthorizationPolicy{}
+	if err := c.client.Create(ctx, authorizationPolicy, opts, result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// Update updates the authorizationPolicy and returns the server's representation of it.
+func (c *AuthorizationPolicyClient) Update(ctx context.Context, authorizationPolicy *securityv1beta1.AuthorizationPolicy, opts metav1.UpdateOptions) (*securityv1beta1.AuthorizationPolicy, error) {
+	result := &securityv1beta1.AuthorizationPolicy{}
+	if err := c.client.Update(ctx, authorizationPolicy, opts, result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// UpdateStatus updates the status of the authorizationPolicy and returns the server's representation of it.
+func (c *AuthorizationPolicyClient) UpdateStatus(ctx context.Context, authorizationPolicy *securityv1beta1.AuthorizationPolicy, opts metav1.UpdateOptions) (*securityv1beta1.AuthorizationPolicy, error) {
+	result := &securityv1beta1.AuthorizationPolicy{}
+	if err := c.client.UpdateStatus(ctx, authorizationPolicy, opts, result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// Delete deletes the authorizationPolicy with the given name.
+func (c *AuthorizationPolicyClient) Delete(ctx context.Context, name string, opts metav1.DeleteOptions) error {
+	return c.client.Delete(ctx, name, opts)
+}
+
+// Patch applies the patch to the authorizationPolicy with the given name and returns the patched authorizationPolicy.
+func (c *AuthorizationPolicyClient) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions, subresources ...string) (*securityv1beta1.AuthorizationPolicy, error) {
+	result := &securityv1beta1.AuthorizationPolicy{}
+	if err := c.client.Patch(ctx, name, pt, data, opts, result, subresources...); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
@@ -0,0 +1,113 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1beta1
+
+import (
+	"context"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/dynamic"
+
+	istiodynamic "github.com/banzaicloud/istio-client-go/client/dynamic"
+	securityv1beta1 "github.com/banzaicloud/istio-client-go/pkg/security/v1beta1"
+)
+
+// PeerAuthenticationClient works with PeerAuthentications through a dynamic client.
+type PeerAuthenticationClient struct {
+	client *istiodynamic.Client
+}
+
+// PeerAuthentications returns a client for the PeerAuthentications in the namespace.
+func PeerAuthentications(client dynamic.Interface, namespace string) *PeerAuthenticationClient {
+	return &PeerAuthenticationClient{
+		client: istiodynamic.New(client, securityv1beta1.PeerAuthenticationGroupVersionKind, securityv1beta1.PeerAuthenticationGroupVersionResource, namespace),
+	}
+}
+
+// Get returns the peerAuthentication with the given name.
+func (c *PeerAuthenticationClient) Get(ctx context.Context, name string, opts metav1.GetOptions) (*securityv1beta1.PeerAuthentication, error) {
+	result := &securityv1beta1.PeerAuthentication{}
+	if err := c.client.Get(ctx, name, opts, result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// List returns the peerAuthentications matching the options.
+func (c *PeerAuthenticationClient) List(ctx context.Context, opts metav1.ListOptions) (*securityv1beta1.PeerAuthenticationList, error) {
+	result := &securityv1beta1.PeerAuthenticationList{}
+	if err := c.client.List(ctx, opts, result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// Watch watches the peerAuthentications matching the options. The objects of the events are
+// *securityv1beta1.PeerAuthentication.
+func (c *PeerAuthenticationClient) Watch(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error) {
+	return c.client.Watch(ctx, opts, func() runtime.Object {
+		return &securityv1beta1.PeerAuthentication{}
+	})
+}
+
+// Create creates the peerAuthentication and returns the server's representation of it.
+func (c *PeerAuthenticationClient) Create(ctx context.Context, peerAuthentication *securityv1beta1.PeerAuthentication, opts metav1.CreateOptions) (*securityv1beta1.PeerAuthentication, error) {
+	result := &securityv1beta1.PeerAuthentication{}
+	if err := c.client.Create(ctx, peerAuthentication, opts, result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// Update updates the peerAuthentication and returns the server's representation of it.
+func (c *PeerAuthenticationClient) Update(ctx context.Context, peerAuthentication *securityv1beta1.PeerAuthentication, opts metav1.UpdateOptions) (*securityv1beta1.PeerAuthentication, error) {
+	result := &securityv1beta1.PeerAuthentication{}
+	if err := c.client.Update(ctx, peerAuthentication, opts, result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// UpdateStatus updates the status of the peerAuthentication and returns the server's representation of it.
+func (c *PeerAuthenticationClient) UpdateStatus(ctx context.Context, peerAuthentication *securityv1beta1.PeerAuthentication, opts metav1.UpdateOptions) (*securityv1beta1.PeerAuthentication, error) {
+	result := &securityv1beta1.PeerAuthentication{}
+	if err := c.client.UpdateStatus(ctx, peerAuthentication, opts, result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// Delete deletes the peerAuthentication with the given name.
+func (c *PeerAuthenticationClient) Delete(ctx context.Context, name string, opts metav1.DeleteOptions) error {
+	return c.client.Delete(ctx, name, opts)
+}
+
+// Patch applies the patch to the peerAuthentication with the given name and returns the patched peerAuthentication.
+func (c *PeerAuthenticationClient) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions, subresources ...string) (*securityv1beta1.PeerAuthentication, error) {
+	result := &securityv1beta1.PeerAuthentication{}
+	if err := c.client.Patch(ctx, name, pt, data, opts, result, subresources...); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
@@ -0,0 +1,18 @@
+module github.com/banzaicloud/istio-client-go/client
+
+go 1.16
+
+require (
+	github.com/banzaicloud/istio-client-go v0.0.0
+	github.com/evanphx/json-patch v4.11.0+incompatible
+	istio.io/client-go v1.11.4
+	k8s.io/api v0.22.2
+	k8s.io/apiextensions-apiserver v0.22.2
+	k8s.io/apimachinery v0.22.2
+	k8s.io/client-go v0.22.2
+	sigs.k8s.io/controller-runtime v0.10.3
+	sigs.k8s.io/gateway-api v0.4.0
+	sigs.k8s.io/yaml v1.2.0
+)
+
+replace github.com/banzaicloud/istio-client-go => ../
@@ -0,0 +1,368 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package indexers provides the index functions of the common reverse
+// lookups of Istio resources, to be registered on the informers before they
+// are started, e.g.
+//
+//	informer := factory.Networking().V1beta1().VirtualServices().Informer()
+//	err := informer.AddIndexers(indexers.Indexers())
+//	...
+//	virtualServices, err := indexers.VirtualServices(informer.GetIndexer(), indexers.ByGateway, "istio-system/ingressgateway")
+//
+// The index functions read the fields of the objects by their JSON names, so
+// they index the objects of both API versions, and the unstructured objects of
+// the dynamic informers as well. The objects of the kinds without the indexed
+// field are not indexed.
+package indexers
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/cache"
+
+	hostname "github.com/banzaicloud/istio-client-go/pkg/host"
+	networkingv1beta1 "github.com/banzaicloud/istio-client-go/pkg/networking/v1beta1"
+	securityv1beta1 "github.com/banzaicloud/istio-client-go/pkg/security/v1beta1"
+	"github.com/banzaicloud/istio-client-go/pkg/selector"
+)
+
+// Names of the indexes
+const (
+	// ByHost indexes the hosts of the virtual services, destination rules,
+	// service entries and the servers of the gateways. The short names are
+	// resolved in the namespace of the object, and the namespaces the
+	// servers of gateways expose their hosts to are dropped.
+	ByHost = "istio.host"
+	// ByGateway indexes the gateways of the virtual services in the
+	// namespace/name format, or mesh.
+	ByGateway = "istio.gateway"
+	// BySelectorLabel indexes the labels the workload selectors of the
+	// objects select in the key=value format, see SelectorLabel.
+	BySelectorLabel = "istio.selectorLabel"
+	// ByExportTo indexes the namespaces the objects are exported to, with .
+	// resolved to the namespace of the object, or * if they are exported to
+	// every namespace.
+	ByExportTo = "istio.exportTo"
+)
+
+// Indexers returns the index functions of every index of the package.
+func Indexers() cache.Indexers {
+	return cache.Indexers{
+		ByHost:          HostIndexFunc,
+		ByGateway:       GatewayIndexFunc,
+		BySelectorLabel: SelectorLabelIndexFunc,
+		ByExportTo:      ExportToIndexFunc,
+	}
+}
+
+// SelectorLabel returns the value of the label indexed by BySelectorLabel.
+func SelectorLabel(key, value string) string {
+	return key + "=" + value
+}
+
+// HostIndexFunc is the index function of ByHost.
+func HostIndexFunc(obj interface{}) ([]string, error) {
+	namespace, spec, err := content(obj)
+	if err != nil || spec == nil {
+		return nil, err
+	}
+
+	var hosts []string
+	if host, ok := spec["host"].(string); ok {
+		hosts = append(hosts, host)
+	}
+	hosts = append(hosts, stringSlice(spec, "hosts")...)
+	for i := range hosts {
+		hosts[i] = hostname.Resolve(hosts[i], namespace)
+	}
+	if servers, ok := spec["servers"].([]interface{}); ok {
+		for _, server := range servers {
+			server, _ := server.(map[string]interface{})
+			for _, host := range stringSlice(server, "hosts") {
+				if i := strings.Index(host, "/"); i >= 0 {
+					host = host[i+1:]
+				}
+				hosts = append(hosts, host)
+			}
+		}
+	}
+
+	return lower(hosts), nil
+}
+
+// GatewayIndexFunc is the index function of ByGateway.
+func GatewayIndexFunc(obj interface{}) ([]string, error) {
+	namespace, spec, err := content(obj)
+	if err != nil || spec == nil || kind(obj) != "VirtualService" {
+		return nil, err
+	}
+
+	gateways := stringSlice(spec, "gateways")
+	if len(gateways) == 0 {
+		return []string{networkingv1beta1.MeshGateway}, nil
+	}
+	for i := range gateways {
+		gateways[i] = networkingv1beta1.ResolveGateway(gateways[i], namespace)
+	}
+
+	return gateways, nil
+}
+
+// SelectorLabelIndexFunc is the index function of BySelectorLabel. The
+// workload selectors are the workloadSelector of the networking kinds, the
+// selector of the security kinds and the selector of the gateways.
+func SelectorLabelIndexFunc(obj interface{}) ([]string, error) {
+	if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+	o, ok := obj.(runtime.Object)
+	if !ok {
+		return nil, fmt.Errorf("unexpected object of type %T", obj)
+	}
+	labels, err := selector.ForObject(o)
+	if err != nil {
+		return nil, err
+	}
+
+	var values []string
+	for key, value := range labels {
+		values = append(values, SelectorLabel(key, value))
+	}
+
+	return values, nil
+}
+
+// ExportToIndexFunc is the index function of ByExportTo.
+func ExportToIndexFunc(obj interface{}) ([]string, error) {
+	namespace, spec, err := content(obj)
+	if err != nil || spec == nil {
+		return nil, err
+	}
+	switch kind(obj) {
+	case "VirtualService", "DestinationRule", "ServiceEntry":
+	default:
+		return nil, nil
+	}
+
+	exportTo := stringSlice(spec, "exportTo")
+	if len(exportTo) == 0 {
+		return []string{"*"}, nil
+	}
+	for i := range exportTo {
+		if exportTo[i] == "." {
+			exportTo[i] = namespace
+		}
+	}
+
+	return exportTo, nil
+}
+
+// VirtualServices returns the virtual services of the index value.
+func VirtualServices(indexer cache.Indexer, index, value string) ([]*networkingv1beta1.VirtualService, error) {
+	items, err := indexer.ByIndex(index, value)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]*networkingv1beta1.VirtualService, 0, len(items))
+	for _, item := range items {
+		if vs, ok := item.(*networkingv1beta1.VirtualService); ok {
+			out = append(out, vs)
+		}
+	}
+
+	return out, nil
+}
+
+// DestinationRules returns the destination rules of the index value.
+func DestinationRules(indexer cache.Indexer, index, value string) ([]*networkingv1beta1.DestinationRule, error) {
+	items, err := indexer.ByIndex(index, value)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]*networkingv1beta1.DestinationRule, 0, len(items))
+	for _, item := range items {
+		if dr, ok := item.(*networkingv1beta1.DestinationRule); ok {
+			out = append(out, dr)
+		}
+	}
+
+	return out, nil
+}
+
+// ServiceEntries returns the service entries of the index value.
+func ServiceEntries(indexer cache.Indexer, index, value string) ([]*networkingv1beta1.ServiceEntry, error) {
+	items, err := indexer.ByIndex(index, value)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]*networkingv1beta1.ServiceEntry, 0, len(items))
+	for _, item := range items {
+		if se, ok := item.(*networkingv1beta1.ServiceEntry); ok {
+			out = append(out, se)
+		}
+	}
+
+	return out, nil
+}
+
+// Gateways returns the gateways of the index value.
+func Gateways(indexer cache.Indexer, index, value string) ([]*networkingv1beta1.Gateway, error) {
+	items, err := indexer.ByIndex(index, value)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]*networkingv1beta1.Gateway, 0, len(items))
+	for _, item := range items {
+		if gateway, ok := item.(*networkingv1beta1.Gateway); ok {
+			out = append(out, gateway)
+		}
+	}
+
+	return out, nil
+}
+
+// Sidecars returns the sidecars of the index value.
+func Sidecars(indexer cache.Indexer, index, value string) ([]*networkingv1beta1.Sidecar, error) {
+	items, err := indexer.ByIndex(index, value)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]*networkingv1beta1.Sidecar, 0, len(items))
+	for _, item := range items {
+		if sidecar, ok := item.(*networkingv1beta1.Sidecar); ok {
+			out = append(out, sidecar)
+		}
+	}
+
+	return out, nil
+}
+
+// AuthorizationPolicies returns the authorization policies of the index value.
+func AuthorizationPolicies(indexer cache.Indexer, index, value string) ([]*securityv1beta1.AuthorizationPolicy, error) {
+	items, err := indexer.ByIndex(index, value)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]*securityv1beta1.AuthorizationPolicy, 0, len(items))
+	for _, item := range items {
+		if policy, ok := item.(*securityv1beta1.AuthorizationPolicy); ok {
+			out = append(out, policy)
+		}
+	}
+
+	return out, nil
+}
+
+// PeerAuthentications returns the peer authentications of the index value.
+func PeerAuthentications(indexer cache.Indexer, index, value string) ([]*securityv1beta1.PeerAuthentication, error) {
+	items, err := indexer.ByIndex(index, value)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]*securityv1beta1.PeerAuthentication, 0, len(items))
+	for _, item := range items {
+		if pa, ok := item.(*securityv1beta1.PeerAuthentication); ok {
+			out = append(out, pa)
+		}
+	}
+
+	return out, nil
+}
+
+// RequestAuthentications returns the request authentications of the index
+// value.
+func RequestAuthentications(indexer cache.Indexer, index, value string) ([]*securityv1beta1.RequestAuthentication, error) {
+	items, err := indexer.ByIndex(index, value)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]*securityv1beta1.RequestAuthentication, 0, len(items))
+	for _, item := range items {
+		if ra, ok := item.(*securityv1beta1.RequestAuthentication); ok {
+			out = append(out, ra)
+		}
+	}
+
+	return out, nil
+}
+
+// content returns the namespace and the JSON spec of the object.
+func content(obj interface{}) (string, map[string]interface{}, error) {
+	if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+	o, ok := obj.(runtime.Object)
+	if !ok {
+		return "", nil, fmt.Errorf("unexpected object of type %T", obj)
+	}
+	accessor, err := meta.Accessor(o)
+	if err != nil {
+		return "", nil, err
+	}
+
+	var u map[string]interface{}
+	if unstructuredObj, ok := o.(*unstructured.Unstructured); ok {
+		u = unstructuredObj.Object
+	} else if u, err = runtime.DefaultUnstructuredConverter.ToUnstructured(o); err != nil {
+		return "", nil, err
+	}
+	spec, _ := u["spec"].(map[string]interface{})
+
+	return accessor.GetNamespace(), spec, nil
+}
+
+// kind returns the kind of the object, from its type if its TypeMeta is
+// empty, as for the objects of the typed informers.
+func kind(obj interface{}) string {
+	if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+	o, ok := obj.(runtime.Object)
+	if !ok {
+		return ""
+	}
+	if kind := o.GetObjectKind().GroupVersionKind().Kind; kind != "" {
+		return kind
+	}
+
+	return reflect.Indirect(reflect.ValueOf(o)).Type().Name()
+}
+
+func stringSlice(m map[string]interface{}, field string) []string {
+	values, _, _ := unstructured.NestedStringSlice(m, field)
+
+	return values
+}
+
+func lower(values []string) []string {
+	for i := range values {
+		values[i] = strings.ToLower(values[i])
+	}
+
+	return values
+}
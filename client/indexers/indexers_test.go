@@ -0,0 +1,187 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexers
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/tools/cache"
+
+	networkingv1alpha3 "github.com/banzaicloud/istio-client-go/pkg/networking/v1alpha3"
+	networkingv1beta1 "github.com/banzaicloud/istio-client-go/pkg/networking/v1beta1"
+	securityv1beta1 "github.com/banzaicloud/istio-client-go/pkg/security/v1beta1"
+	selector "github.com/banzaicloud/istio-client-go/pkg/type/v1beta1"
+)
+
+func TestIndexFuncs(t *testing.T) {
+	tests := []struct {
+		name     string
+		obj      interface{}
+		index    cache.IndexFunc
+		expected []string
+	}{
+		{
+			name: "hosts of a virtual service",
+			obj: &networkingv1beta1.VirtualService{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "prod", Name: "reviews"},
+				Spec:       networkingv1beta1.VirtualServiceSpec{Hosts: []string{"reviews", "Bookinfo.com"}},
+			},
+			index:    HostIndexFunc,
+			expected: []string{"bookinfo.com", "reviews.prod.svc.cluster.local"},
+		},
+		{
+			name: "host of a v1alpha3 destination rule",
+			obj: &networkingv1alpha3.DestinationRule{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "prod", Name: "reviews"},
+				Spec:       networkingv1alpha3.DestinationRuleSpec{Host: "reviews"},
+			},
+			index:    HostIndexFunc,
+			expected: []string{"reviews.prod.svc.cluster.local"},
+		},
+		{
+			name: "hosts of a gateway",
+			obj: &networkingv1beta1.Gateway{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "istio-system", Name: "public"},
+				Spec: networkingv1beta1.GatewaySpec{Servers: []networkingv1beta1.Server{
+					{Hosts: []string{"prod/bookinfo.com"}},
+					{Hosts: []string{"*.example.com"}},
+				}},
+			},
+			index:    HostIndexFunc,
+			expected: []string{"*.example.com", "bookinfo.com"},
+		},
+		{
+			name: "gateways of a virtual service",
+			obj: &networkingv1beta1.VirtualService{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "prod", Name: "bookinfo"},
+				Spec:       networkingv1beta1.VirtualServiceSpec{Gateways: []string{"ingress", "istio-system/public", "mesh"}},
+			},
+			index:    GatewayIndexFunc,
+			expected: []string{"istio-system/public", "mesh", "prod/ingress"},
+		},
+		{
+			name: "default gateway of an unstructured virtual service",
+			obj: &unstructured.Unstructured{Object: map[string]interface{}{
+				"apiVersion": "networking.istio.io/v1beta1",
+				"kind":       "VirtualService",
+				"metadata":   map[string]interface{}{"namespace": "prod", "name": "reviews"},
+				"spec":       map[string]interface{}{"hosts": []interface{}{"reviews"}},
+			}},
+			index:    GatewayIndexFunc,
+			expected: []string{"mesh"},
+		},
+		{
+			name: "no gateways of a destination rule",
+			obj: &networkingv1beta1.DestinationRule{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "prod", Name: "reviews"},
+			},
+			index: GatewayIndexFunc,
+		},
+		{
+			name: "labels of a sidecar",
+			obj: &networkingv1beta1.Sidecar{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "prod", Name: "ratings"},
+				Spec: networkingv1beta1.SidecarSpec{
+					WorkloadSelector: &networkingv1beta1.WorkloadSelector{Labels: map[string]string{"app": "ratings", "version": "v1"}},
+				},
+			},
+			index:    SelectorLabelIndexFunc,
+			expected: []string{"app=ratings", "version=v1"},
+		},
+		{
+			name: "labels of an authorization policy",
+			obj: &securityv1beta1.AuthorizationPolicy{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "prod", Name: "httpbin"},
+				Spec: securityv1beta1.AuthorizationPolicySpec{
+					Selector: &selector.WorkloadSelector{MatchLabels: map[string]string{"app": "httpbin"}},
+				},
+			},
+			index:    SelectorLabelIndexFunc,
+			expected: []string{"app=httpbin"},
+		},
+		{
+			name: "labels of a gateway",
+			obj: &networkingv1beta1.Gateway{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "istio-system", Name: "public"},
+				Spec:       networkingv1beta1.GatewaySpec{Selector: map[string]string{"istio": "ingressgateway"}},
+			},
+			index:    SelectorLabelIndexFunc,
+			expected: []string{"istio=ingressgateway"},
+		},
+		{
+			name: "namespaces a service entry is exported to",
+			obj: &networkingv1beta1.ServiceEntry{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "prod", Name: "external"},
+				Spec:       networkingv1beta1.ServiceEntrySpec{ExportTo: []string{".", "test"}},
+			},
+			index:    ExportToIndexFunc,
+			expected: []string{"prod", "test"},
+		},
+		{
+			name: "virtual service exported to every namespace",
+			obj: &networkingv1beta1.VirtualService{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "prod", Name: "reviews"},
+			},
+			index:    ExportToIndexFunc,
+			expected: []string{"*"},
+		},
+	}
+
+	for _, test := range tests {
+		values, err := test.index(test.obj)
+		if err != nil {
+			t.Errorf("%s: unexpected error %v", test.name, err)
+			continue
+		}
+		sort.Strings(values)
+		if !reflect.DeepEqual(values, test.expected) {
+			t.Errorf("%s: unexpected values %q, expected %q", test.name, values, test.expected)
+		}
+	}
+}
+
+func TestVirtualServices(t *testing.T) {
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, Indexers())
+	for _, vs := range []*networkingv1beta1.VirtualService{
+		{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "prod", Name: "bookinfo"},
+			Spec:       networkingv1beta1.VirtualServiceSpec{Hosts: []string{"bookinfo.com"}, Gateways: []string{"istio-system/public"}},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "prod", Name: "reviews"},
+			Spec:       networkingv1beta1.VirtualServiceSpec{Hosts: []string{"reviews"}},
+		},
+	} {
+		if err := indexer.Add(vs); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	virtualServices, err := VirtualServices(indexer, ByGateway, "istio-system/public")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(virtualServices) != 1 || virtualServices[0].Name != "bookinfo" {
+		t.Errorf("unexpected virtual services %v", virtualServices)
+	}
+
+	if _, err := VirtualServices(indexer, "unknown", "mesh"); err == nil {
+		t.Error("expected an unknown index to be rejected")
+	}
+}
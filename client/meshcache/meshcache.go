@@ -0,0 +1,290 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package meshcache keeps the virtual services, destination rules and
+// gateways of a cluster in memory, indexed by host and gateway, so that
+// ingress controllers and analyzers answer questions like which virtual
+// services route a host through a gateway without scanning every object:
+//
+//	c := meshcache.New()
+//	c.Register(factory)
+//	factory.Start(stopCh)
+//	virtualServices := c.VirtualServices("reviews.prod.svc.cluster.local", "istio-system/ingressgateway")
+//
+// Short host names are resolved in the namespace of their object, assuming
+// the DefaultDomainSuffix of the host package, and the hosts are looked up as
+// they are written, so a wildcard host is only found by the same wildcard.
+// The objects the cache returns are shared with the informers and must not
+// be modified.
+package meshcache
+
+import (
+	"sort"
+	"strings"
+	"sync"
+
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/banzaicloud/istio-client-go/client/informers/externalversions"
+	hostname "github.com/banzaicloud/istio-client-go/pkg/host"
+	v1beta1 "github.com/banzaicloud/istio-client-go/pkg/networking/v1beta1"
+)
+
+// route is a host routed through a gateway.
+type route struct {
+	host    string
+	gateway string
+}
+
+// Cache is an index of the virtual services, destination rules and gateways,
+// safe for concurrent use.
+type Cache struct {
+	mu sync.RWMutex
+
+	virtualServices  map[string]*v1beta1.VirtualService
+	destinationRules map[string]*v1beta1.DestinationRule
+	gateways         map[string]*v1beta1.Gateway
+
+	virtualServicesByRoute index
+	destinationRulesByHost index
+	gatewaysByHost         index
+}
+
+// New returns an empty cache.
+func New() *Cache {
+	return &Cache{
+		virtualServices:        map[string]*v1beta1.VirtualService{},
+		destinationRules:       map[string]*v1beta1.DestinationRule{},
+		gateways:               map[string]*v1beta1.Gateway{},
+		virtualServicesByRoute: index{},
+		destinationRulesByHost: index{},
+		gatewaysByHost:         index{},
+	}
+}
+
+// Register adds the event handlers of the cache to the informers of the
+// virtual services, destination rules and gateways of the factory, which
+// must be started afterwards. The v1beta1 informers are used, which serve the
+// resources of both API versions.
+func (c *Cache) Register(factory externalversions.SharedInformerFactory) {
+	informers := factory.Networking().V1beta1()
+	informers.VirtualServices().Informer().AddEventHandler(c)
+	informers.DestinationRules().Informer().AddEventHandler(c)
+	informers.Gateways().Informer().AddEventHandler(c)
+}
+
+// OnAdd adds the object to the cache.
+func (c *Cache) OnAdd(obj interface{}) {
+	c.Upsert(obj)
+}
+
+// OnUpdate replaces the object in the cache.
+func (c *Cache) OnUpdate(_, newObj interface{}) {
+	c.Upsert(newObj)
+}
+
+// OnDelete removes the object from the cache.
+func (c *Cache) OnDelete(obj interface{}) {
+	if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+	c.Delete(obj)
+}
+
+// Upsert adds the virtual service, destination rule or gateway to the cache,
+// or replaces the one with the same namespace and name. Other objects are
+// ignored.
+func (c *Cache) Upsert(obj interface{}) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	switch obj := obj.(type) {
+	case *v1beta1.VirtualService:
+		key := obj.Namespace + "/" + obj.Name
+		c.deleteVirtualService(key)
+		c.virtualServices[key] = obj
+		for _, r := range virtualServiceRoutes(obj) {
+			c.virtualServicesByRoute.add(r, key)
+		}
+	case *v1beta1.DestinationRule:
+		key := obj.Namespace + "/" + obj.Name
+		c.deleteDestinationRule(key)
+		c.destinationRules[key] = obj
+		c.destinationRulesByHost.add(destinationRuleHost(obj), key)
+	case *v1beta1.Gateway:
+		key := obj.Namespace + "/" + obj.Name
+		c.deleteGateway(key)
+		c.gateways[key] = obj
+		for _, host := range gatewayHosts(obj) {
+			c.gatewaysByHost.add(host, key)
+		}
+	}
+}
+
+// Delete removes the virtual service, destination rule or gateway with the
+// namespace and name of the object from the cache. Other objects are ignored.
+func (c *Cache) Delete(obj interface{}) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	switch obj := obj.(type) {
+	case *v1beta1.VirtualService:
+		c.deleteVirtualService(obj.Namespace + "/" + obj.Name)
+	case *v1beta1.DestinationRule:
+		c.deleteDestinationRule(obj.Namespace + "/" + obj.Name)
+	case *v1beta1.Gateway:
+		c.deleteGateway(obj.Namespace + "/" + obj.Name)
+	}
+}
+
+// VirtualServices returns the virtual services routing the host through the
+// gateway, in the namespace/name format or mesh for the sidecars, ordered by
+// namespace and name.
+func (c *Cache) VirtualServices(host, gateway string) []*v1beta1.VirtualService {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	keys := c.virtualServicesByRoute.names(route{host: strings.ToLower(host), gateway: gateway})
+	out := make([]*v1beta1.VirtualService, 0, len(keys))
+	for _, key := range keys {
+		out = append(out, c.virtualServices[key])
+	}
+
+	return out
+}
+
+// DestinationRules returns the destination rules of the host, ordered by
+// namespace and name.
+func (c *Cache) DestinationRules(host string) []*v1beta1.DestinationRule {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	keys := c.destinationRulesByHost.names(strings.ToLower(host))
+	out := make([]*v1beta1.DestinationRule, 0, len(keys))
+	for _, key := range keys {
+		out = append(out, c.destinationRules[key])
+	}
+
+	return out
+}
+
+// Gateways returns the gateways with a server exposing the host, ordered by
+// namespace and name. The namespaces the servers expose the host to are not
+// taken into account.
+func (c *Cache) Gateways(host string) []*v1beta1.Gateway {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	keys := c.gatewaysByHost.names(strings.ToLower(host))
+	out := make([]*v1beta1.Gateway, 0, len(keys))
+	for _, key := range keys {
+		out = append(out, c.gateways[key])
+	}
+
+	return out
+}
+
+func (c *Cache) deleteVirtualService(key string) {
+	if old, ok := c.virtualServices[key]; ok {
+		for _, r := range virtualServiceRoutes(old) {
+			c.virtualServicesByRoute.remove(r, key)
+		}
+		delete(c.virtualServices, key)
+	}
+}
+
+func (c *Cache) deleteDestinationRule(key string) {
+	if old, ok := c.destinationRules[key]; ok {
+		c.destinationRulesByHost.remove(destinationRuleHost(old), key)
+		delete(c.destinationRules, key)
+	}
+}
+
+func (c *Cache) deleteGateway(key string) {
+	if old, ok := c.gateways[key]; ok {
+		for _, host := range gatewayHosts(old) {
+			c.gatewaysByHost.remove(host, key)
+		}
+		delete(c.gateways, key)
+	}
+}
+
+// virtualServiceRoutes returns the resolved hosts of the virtual service for
+// each of its resolved gateways, mesh if it has none.
+func virtualServiceRoutes(vs *v1beta1.VirtualService) []route {
+	gateways := vs.Spec.Gateways
+	if len(gateways) == 0 {
+		gateways = []string{v1beta1.MeshGateway}
+	}
+
+	var routes []route
+	for _, gateway := range gateways {
+		for _, host := range vs.Spec.Hosts {
+			routes = append(routes, route{
+				host:    strings.ToLower(hostname.Resolve(host, vs.Namespace)),
+				gateway: v1beta1.ResolveGateway(gateway, vs.Namespace),
+			})
+		}
+	}
+
+	return routes
+}
+
+func destinationRuleHost(dr *v1beta1.DestinationRule) string {
+	return strings.ToLower(hostname.Resolve(dr.Spec.Host, dr.Namespace))
+}
+
+// gatewayHosts returns the hosts of the servers of the gateway without the
+// namespaces they are exposed to.
+func gatewayHosts(gateway *v1beta1.Gateway) []string {
+	var hosts []string
+	for _, server := range gateway.Spec.Servers {
+		for _, host := range server.Hosts {
+			if i := strings.Index(host, "/"); i >= 0 {
+				host = host[i+1:]
+			}
+			hosts = append(hosts, strings.ToLower(host))
+		}
+	}
+
+	return hosts
+}
+
+// index maps the keys of an index to the namespace/name of the objects.
+type index map[interface{}]map[string]struct{}
+
+func (i index) add(key interface{}, name string) {
+	if i[key] == nil {
+		i[key] = map[string]struct{}{}
+	}
+	i[key][name] = struct{}{}
+}
+
+func (i index) remove(key interface{}, name string) {
+	delete(i[key], name)
+	if len(i[key]) == 0 {
+		delete(i, key)
+	}
+}
+
+// names returns the names of the objects of the key, sorted.
+func (i index) names(key interface{}) []string {
+	names := make([]string, 0, len(i[key]))
+	for name := range i[key] {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
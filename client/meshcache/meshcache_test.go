@@ -0,0 +1,133 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package meshcache
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/banzaicloud/istio-client-go/client/clientset/versioned/fake"
+	"github.com/banzaicloud/istio-client-go/client/informers/externalversions"
+	v1beta1 "github.com/banzaicloud/istio-client-go/pkg/networking/v1beta1"
+)
+
+func virtualService(namespace, name string, hosts, gateways []string) *v1beta1.VirtualService {
+	return &v1beta1.VirtualService{
+		ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+		Spec:       v1beta1.VirtualServiceSpec{Hosts: hosts, Gateways: gateways},
+	}
+}
+
+func names(virtualServices []*v1beta1.VirtualService) []string {
+	out := []string{}
+	for _, vs := range virtualServices {
+		out = append(out, vs.Namespace+"/"+vs.Name)
+	}
+
+	return out
+}
+
+func TestVirtualServices(t *testing.T) {
+	c := New()
+	c.Upsert(virtualService("prod", "reviews", []string{"reviews"}, nil))
+	c.Upsert(virtualService("prod", "bookinfo", []string{"bookinfo.com", "reviews"}, []string{"ingress", "istio-system/public", "mesh"}))
+	c.Upsert(virtualService("test", "reviews", []string{"reviews"}, nil))
+
+	tests := []struct {
+		host     string
+		gateway  string
+		expected []string
+	}{
+		{host: "reviews.prod.svc.cluster.local", gateway: "mesh", expected: []string{"prod/bookinfo", "prod/reviews"}},
+		{host: "reviews.test.svc.cluster.local", gateway: "mesh", expected: []string{"test/reviews"}},
+		{host: "Bookinfo.com", gateway: "prod/ingress", expected: []string{"prod/bookinfo"}},
+		{host: "bookinfo.com", gateway: "istio-system/public", expected: []string{"prod/bookinfo"}},
+		{host: "bookinfo.com", gateway: "istio-system/ingress", expected: []string{}},
+	}
+	for _, test := range tests {
+		if vs := names(c.VirtualServices(test.host, test.gateway)); !reflect.DeepEqual(vs, test.expected) {
+			t.Errorf("%s via %s: unexpected virtual services %v, expected %v", test.host, test.gateway, vs, test.expected)
+		}
+	}
+
+	// updates replace the routes of the previous version
+	c.Upsert(virtualService("prod", "bookinfo", []string{"bookinfo.com"}, []string{"ingress"}))
+	if vs := names(c.VirtualServices("reviews.prod.svc.cluster.local", "mesh")); !reflect.DeepEqual(vs, []string{"prod/reviews"}) {
+		t.Errorf("unexpected virtual services %v after the update", vs)
+	}
+
+	c.OnDelete(cache.DeletedFinalStateUnknown{Key: "prod/bookinfo", Obj: virtualService("prod", "bookinfo", nil, nil)})
+	if vs := names(c.VirtualServices("bookinfo.com", "prod/ingress")); len(vs) != 0 {
+		t.Errorf("unexpected virtual services %v after the delete", vs)
+	}
+	if len(c.virtualServicesByRoute) != 2 {
+		t.Errorf("unexpected routes left in the index: %v", c.virtualServicesByRoute)
+	}
+}
+
+func TestDestinationRulesAndGateways(t *testing.T) {
+	c := New()
+	c.Upsert(&v1beta1.DestinationRule{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "prod", Name: "reviews"},
+		Spec:       v1beta1.DestinationRuleSpec{Host: "reviews"},
+	})
+	c.Upsert(&v1beta1.Gateway{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "istio-system", Name: "public"},
+		Spec: v1beta1.GatewaySpec{Servers: []v1beta1.Server{
+			{Hosts: []string{"prod/bookinfo.com", "*.example.com"}},
+		}},
+	})
+
+	if drs := c.DestinationRules("reviews.prod.svc.cluster.local"); len(drs) != 1 || drs[0].Name != "reviews" {
+		t.Errorf("unexpected destination rules %v", drs)
+	}
+	for _, host := range []string{"bookinfo.com", "*.example.com"} {
+		if gateways := c.Gateways(host); len(gateways) != 1 || gateways[0].Name != "public" {
+			t.Errorf("%s: unexpected gateways %v", host, gateways)
+		}
+	}
+	if gateways := c.Gateways("api.example.com"); len(gateways) != 0 {
+		t.Errorf("unexpected gateways %v of a host matched by a wildcard", gateways)
+	}
+}
+
+func TestRegister(t *testing.T) {
+	client := fake.NewSimpleClientset(virtualService("prod", "reviews", []string{"reviews"}, nil))
+	factory := externalversions.NewSharedInformerFactory(client, time.Minute)
+	c := New()
+	c.Register(factory)
+
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	factory.Start(stopCh)
+	for informer, synced := range factory.WaitForCacheSync(stopCh) {
+		if !synced {
+			t.Fatalf("informer of %v not synced", informer)
+		}
+	}
+
+	// the handlers are notified asynchronously after the sync
+	err := wait.PollImmediate(10*time.Millisecond, wait.ForeverTestTimeout, func() (bool, error) {
+		return len(c.VirtualServices("reviews.prod.svc.cluster.local", "mesh")) == 1, nil
+	})
+	if err != nil {
+		t.Errorf("virtual service not cached: %v", err)
+	}
+}
@@ -0,0 +1,104 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package metadata helps to work with Istio kinds through metadata-only
+// clients and informers, which only transfer and cache the object metadata.
+package metadata
+
+import (
+	"fmt"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/metadata"
+	"k8s.io/client-go/metadata/metadatainformer"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/banzaicloud/istio-client-go/pkg/registry"
+)
+
+// PartialObjectMetadata returns an empty metadata-only object of the Istio kind
+// with its TypeMeta set, e.g. to set up metadata-only watches in controller-runtime.
+func PartialObjectMetadata(gvk schema.GroupVersionKind) (*metav1.PartialObjectMetadata, error) {
+	kind, err := lookup(gvk)
+	if err != nil {
+		return nil, err
+	}
+
+	obj := &metav1.PartialObjectMetadata{}
+	obj.SetGroupVersionKind(kind.GroupVersionKind)
+
+	return obj, nil
+}
+
+// PartialObjectMetadataList returns an empty metadata-only list of the Istio kind
+// with its TypeMeta set.
+func PartialObjectMetadataList(gvk schema.GroupVersionKind) (*metav1.PartialObjectMetadataList, error) {
+	kind, err := lookup(gvk)
+	if err != nil {
+		return nil, err
+	}
+
+	list := &metav1.PartialObjectMetadataList{}
+	list.SetGroupVersionKind(kind.ListGroupVersionKind())
+
+	return list, nil
+}
+
+// Resource returns the metadata client of the Istio kind in the namespace. An
+// empty namespace selects all namespaces.
+func Resource(client metadata.Interface, gvk schema.GroupVersionKind, namespace string) (metadata.ResourceInterface, error) {
+	kind, err := lookup(gvk)
+	if err != nil {
+		return nil, err
+	}
+
+	if namespace == "" || !kind.Namespaced {
+		return client.Resource(kind.GroupVersionResource), nil
+	}
+
+	return client.Resource(kind.GroupVersionResource).Namespace(namespace), nil
+}
+
+// ForKind returns the shared metadata-only informer of the Istio kind from the factory.
+func ForKind(factory metadatainformer.SharedInformerFactory, gvk schema.GroupVersionKind) (informers.GenericInformer, error) {
+	kind, err := lookup(gvk)
+	if err != nil {
+		return nil, err
+	}
+
+	return factory.ForResource(kind.GroupVersionResource), nil
+}
+
+// NewFilteredInformer constructs a new metadata-only informer of the Istio kind.
+// Always prefer using a shared informer factory, see ForKind.
+func NewFilteredInformer(client metadata.Interface, gvk schema.GroupVersionKind, namespace string, resyncPeriod time.Duration, indexers cache.Indexers, tweakListOptions metadatainformer.TweakListOptionsFunc) (informers.GenericInformer, error) {
+	kind, err := lookup(gvk)
+	if err != nil {
+		return nil, err
+	}
+
+	return metadatainformer.NewFilteredMetadataInformer(client, kind.GroupVersionResource, namespace, resyncPeriod, indexers, tweakListOptions), nil
+}
+
+func lookup(gvk schema.GroupVersionKind) (registry.Kind, error) {
+	kind, ok := registry.ForKind(gvk)
+	if !ok {
+		return registry.Kind{}, fmt.Errorf("%s is not an Istio kind modeled by this module", gvk)
+	}
+
+	return kind, nil
+}
@@ -0,0 +1,336 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package multicluster manages the clients and the informers of the Istio
+// kinds of the clusters of a multi-cluster mesh, keyed by cluster name, and
+// lists and watches the resources of every cluster at once, e.g.
+//
+//	m := multicluster.NewManager(10 * time.Minute)
+//	err := m.AddClustersFromSecret(remoteSecret)
+//	lists, err := m.List(ctx, v1beta1.VirtualServiceGroupVersionKind, "prod", metav1.ListOptions{})
+//
+// The clusters are usually added from the remote secrets of Istio, which
+// hold the kubeconfigs of the remote clusters under the cluster names.
+package multicluster
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+
+	"github.com/banzaicloud/istio-client-go/client/clientset/versioned"
+	istiodynamic "github.com/banzaicloud/istio-client-go/client/dynamic"
+	"github.com/banzaicloud/istio-client-go/client/informers/externalversions"
+	"github.com/banzaicloud/istio-client-go/pkg/registry"
+)
+
+// SecretLabel is the label of the remote secrets of Istio, which hold the
+// kubeconfigs of the remote clusters keyed by cluster name.
+const SecretLabel = "istio/multiCluster"
+
+// Cluster holds the clients and the informers of a cluster.
+type Cluster struct {
+	// Name is the name of the cluster
+	Name string
+	// Client is the Istio clientset of the cluster
+	Client versioned.Interface
+	// Dynamic is the dynamic client of the cluster
+	Dynamic dynamic.Interface
+	// Informers is the informer factory of the cluster, started by the Start
+	// method of the manager
+	Informers externalversions.SharedInformerFactory
+
+	stopCh chan struct{}
+}
+
+// Manager holds the clusters, safe for concurrent use.
+type Manager struct {
+	resync time.Duration
+
+	mu       sync.RWMutex
+	clusters map[string]*Cluster
+	started  bool
+}
+
+// NewManager returns a manager without clusters, whose informers resync with
+// the given period.
+func NewManager(resync time.Duration) *Manager {
+	return &Manager{
+		resync:   resync,
+		clusters: map[string]*Cluster{},
+	}
+}
+
+// AddCluster adds the cluster with the clients, or replaces the one with the
+// same name and stops its informers. The informers of the cluster are started
+// if the manager was started.
+func (m *Manager) AddCluster(name string, client versioned.Interface, dynamicClient dynamic.Interface) *Cluster {
+	cluster := &Cluster{
+		Name:      name,
+		Client:    client,
+		Dynamic:   dynamicClient,
+		Informers: externalversions.NewSharedInformerFactory(client, m.resync),
+		stopCh:    make(chan struct{}),
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if old, ok := m.clusters[name]; ok {
+		close(old.stopCh)
+	}
+	m.clusters[name] = cluster
+	if m.started {
+		cluster.Informers.Start(cluster.stopCh)
+	}
+
+	return cluster
+}
+
+// AddClusterFromConfig adds the cluster with the clients of the REST config.
+func (m *Manager) AddClusterFromConfig(name string, config *rest.Config) (*Cluster, error) {
+	client, err := versioned.NewForConfig(config)
+	if err != nil {
+		return nil, fmt.Errorf("could not create the client of cluster %s: %w", name, err)
+	}
+	dynamicClient, err := dynamic.NewForConfig(config)
+	if err != nil {
+		return nil, fmt.Errorf("could not create the dynamic client of cluster %s: %w", name, err)
+	}
+
+	return m.AddCluster(name, client, dynamicClient), nil
+}
+
+// AddClustersFromSecret adds the clusters of the kubeconfigs of the secret,
+// named after their keys, as in the remote secrets of Istio. The clusters
+// with a valid kubeconfig are added even if others are not.
+func (m *Manager) AddClustersFromSecret(secret *corev1.Secret) error {
+	var errs []error
+	for _, name := range secretClusters(secret) {
+		config, err := clientcmd.RESTConfigFromKubeConfig(secret.Data[name])
+		if err != nil {
+			errs = append(errs, fmt.Errorf("invalid kubeconfig of cluster %s in secret %s/%s: %w", name, secret.Namespace, secret.Name, err))
+			continue
+		}
+		if _, err := m.AddClusterFromConfig(name, config); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return utilerrors.NewAggregate(errs)
+}
+
+// RemoveCluster removes the cluster and stops its informers.
+func (m *Manager) RemoveCluster(name string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if cluster, ok := m.clusters[name]; ok {
+		close(cluster.stopCh)
+		delete(m.clusters, name)
+	}
+}
+
+// RemoveClustersOfSecret removes the clusters of the kubeconfigs of the
+// secret, e.g. when the secret is deleted.
+func (m *Manager) RemoveClustersOfSecret(secret *corev1.Secret) {
+	for _, name := range secretClusters(secret) {
+		m.RemoveCluster(name)
+	}
+}
+
+// Cluster returns the cluster with the given name.
+func (m *Manager) Cluster(name string) (*Cluster, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	cluster, ok := m.clusters[name]
+	return cluster, ok
+}
+
+// Clusters returns the clusters ordered by name.
+func (m *Manager) Clusters() []*Cluster {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	clusters := make([]*Cluster, 0, len(m.clusters))
+	for _, cluster := range m.clusters {
+		clusters = append(clusters, cluster)
+	}
+	sort.Slice(clusters, func(i, j int) bool {
+		return clusters[i].Name < clusters[j].Name
+	})
+
+	return clusters
+}
+
+// Start starts the informers requested from the informer factories of the
+// clusters, and the ones of the clusters added later on. It can be called
+// again to start the informers requested since.
+func (m *Manager) Start() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.started = true
+	for _, cluster := range m.clusters {
+		cluster.Informers.Start(cluster.stopCh)
+	}
+}
+
+// Stop stops the informers of every cluster and removes the clusters.
+func (m *Manager) Stop() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for name, cluster := range m.clusters {
+		close(cluster.stopCh)
+		delete(m.clusters, name)
+	}
+	m.started = false
+}
+
+// List lists the objects of the Istio kind in the namespace of every cluster,
+// all namespaces if empty, and returns the typed lists by cluster name. The
+// lists of the clusters listed successfully are returned even if others
+// failed.
+func (m *Manager) List(ctx context.Context, gvk schema.GroupVersionKind, namespace string, opts metav1.ListOptions) (map[string]runtime.Object, error) {
+	kind, ok := registry.ForKind(gvk)
+	if !ok {
+		return nil, fmt.Errorf("%s is not an Istio kind modeled by this module", gvk)
+	}
+
+	lists := map[string]runtime.Object{}
+	var errs []error
+	for _, cluster := range m.Clusters() {
+		list := kind.NewList()
+		err := istiodynamic.New(cluster.Dynamic, kind.GroupVersionKind, kind.GroupVersionResource, namespace).List(ctx, opts, list)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("could not list %s of cluster %s: %w", kind.GroupVersionResource.Resource, cluster.Name, err))
+			continue
+		}
+		lists[cluster.Name] = list
+	}
+
+	return lists, utilerrors.NewAggregate(errs)
+}
+
+// Event is a watch event of a cluster.
+type Event struct {
+	watch.Event
+	// Cluster is the name of the cluster of the event
+	Cluster string
+}
+
+// Watcher merges the watches of the clusters.
+type Watcher struct {
+	result   chan Event
+	done     chan struct{}
+	watches  []watch.Interface
+	stopOnce sync.Once
+	wg       sync.WaitGroup
+}
+
+// ResultChan returns the channel of the events of every cluster, which is
+// closed once the watcher is stopped or every watch ended.
+func (w *Watcher) ResultChan() <-chan Event {
+	return w.result
+}
+
+// Stop stops the watches of the clusters.
+func (w *Watcher) Stop() {
+	w.stopOnce.Do(func() {
+		close(w.done)
+		for _, wi := range w.watches {
+			wi.Stop()
+		}
+	})
+}
+
+// Watch watches the objects of the Istio kind in the namespace of every
+// cluster, all namespaces if empty. The objects of the events are typed, and
+// the watch fails unless it is started on every cluster.
+func (m *Manager) Watch(ctx context.Context, gvk schema.GroupVersionKind, namespace string, opts metav1.ListOptions) (*Watcher, error) {
+	kind, ok := registry.ForKind(gvk)
+	if !ok {
+		return nil, fmt.Errorf("%s is not an Istio kind modeled by this module", gvk)
+	}
+
+	w := &Watcher{
+		result: make(chan Event),
+		done:   make(chan struct{}),
+	}
+	clusters := m.Clusters()
+	for _, cluster := range clusters {
+		wi, err := istiodynamic.New(cluster.Dynamic, kind.GroupVersionKind, kind.GroupVersionResource, namespace).Watch(ctx, opts, kind.New)
+		if err != nil {
+			w.Stop()
+			return nil, fmt.Errorf("could not watch %s of cluster %s: %w", kind.GroupVersionResource.Resource, cluster.Name, err)
+		}
+		w.watches = append(w.watches, wi)
+	}
+
+	for i, wi := range w.watches {
+		w.wg.Add(1)
+		go w.forward(clusters[i].Name, wi)
+	}
+	go func() {
+		w.wg.Wait()
+		close(w.result)
+	}()
+
+	return w, nil
+}
+
+func (w *Watcher) forward(cluster string, wi watch.Interface) {
+	defer w.wg.Done()
+
+	for {
+		select {
+		case <-w.done:
+			return
+		case event, ok := <-wi.ResultChan():
+			if !ok {
+				return
+			}
+			select {
+			case w.result <- Event{Event: event, Cluster: cluster}:
+			case <-w.done:
+				return
+			}
+		}
+	}
+}
+
+// secretClusters returns the names of the clusters of the secret, sorted.
+func secretClusters(secret *corev1.Secret) []string {
+	names := make([]string, 0, len(secret.Data))
+	for name := range secret.Data {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
@@ -0,0 +1,153 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multicluster
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/watch"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+
+	"github.com/banzaicloud/istio-client-go/client/clientset/versioned/fake"
+	v1beta1 "github.com/banzaicloud/istio-client-go/pkg/networking/v1beta1"
+)
+
+func virtualService(name string) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "networking.istio.io/v1beta1",
+		"kind":       "VirtualService",
+		"metadata":   map[string]interface{}{"namespace": "prod", "name": name},
+		"spec":       map[string]interface{}{"hosts": []interface{}{name}},
+	}}
+}
+
+func dynamicClient(objects ...runtime.Object) *dynamicfake.FakeDynamicClient {
+	listKinds := map[schema.GroupVersionResource]string{v1beta1.VirtualServiceGroupVersionResource: "VirtualServiceList"}
+	return dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), listKinds, objects...)
+}
+
+func TestList(t *testing.T) {
+	m := NewManager(time.Minute)
+	m.AddCluster("primary", fake.NewSimpleClientset(), dynamicClient(virtualService("reviews")))
+	m.AddCluster("remote", fake.NewSimpleClientset(), dynamicClient(virtualService("ratings"), virtualService("details")))
+
+	lists, err := m.List(context.Background(), v1beta1.VirtualServiceGroupVersionKind, "prod", metav1.ListOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := map[string]int{"primary": 1, "remote": 2}
+	for cluster, items := range expected {
+		list, ok := lists[cluster].(*v1beta1.VirtualServiceList)
+		if !ok {
+			t.Errorf("%s: unexpected list %T", cluster, lists[cluster])
+			continue
+		}
+		if len(list.Items) != items {
+			t.Errorf("%s: unexpected virtual services %v", cluster, list.Items)
+		}
+	}
+
+	m.RemoveCluster("remote")
+	if clusters := m.Clusters(); len(clusters) != 1 || clusters[0].Name != "primary" {
+		t.Errorf("unexpected clusters %v after the removal", clusters)
+	}
+}
+
+func TestWatch(t *testing.T) {
+	remote := dynamicClient()
+	m := NewManager(time.Minute)
+	m.AddCluster("primary", fake.NewSimpleClientset(), dynamicClient())
+	m.AddCluster("remote", fake.NewSimpleClientset(), remote)
+
+	w, err := m.Watch(context.Background(), v1beta1.VirtualServiceGroupVersionKind, "prod", metav1.ListOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer w.Stop()
+
+	_, err = remote.Resource(v1beta1.VirtualServiceGroupVersionResource).Namespace("prod").Create(context.Background(), virtualService("reviews"), metav1.CreateOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	select {
+	case event := <-w.ResultChan():
+		vs, ok := event.Object.(*v1beta1.VirtualService)
+		if event.Cluster != "remote" || event.Type != watch.Added || !ok || vs.Name != "reviews" {
+			t.Errorf("unexpected event %v of cluster %s", event.Event, event.Cluster)
+		}
+	case <-time.After(wait):
+		t.Fatal("no event received")
+	}
+
+	w.Stop()
+	select {
+	case _, ok := <-w.ResultChan():
+		if ok {
+			t.Error("unexpected event after the watcher was stopped")
+		}
+	case <-time.After(wait):
+		t.Fatal("result channel not closed")
+	}
+}
+
+const wait = 10 * time.Second
+
+func TestAddClustersFromSecret(t *testing.T) {
+	kubeconfig := `apiVersion: v1
+kind: Config
+clusters:
+- name: remote
+  cluster:
+    server: https://remote.example.com
+contexts:
+- name: remote
+  context:
+    cluster: remote
+    user: remote
+current-context: remote
+users:
+- name: remote
+  user:
+    token: token
+`
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "istio-system", Name: "istio-remote-secret-remote", Labels: map[string]string{SecretLabel: "true"}},
+		Data: map[string][]byte{
+			"remote":  []byte(kubeconfig),
+			"invalid": []byte("invalid"),
+		},
+	}
+
+	m := NewManager(time.Minute)
+	if err := m.AddClustersFromSecret(secret); err == nil {
+		t.Error("expected the invalid kubeconfig to be reported")
+	}
+	if _, ok := m.Cluster("remote"); !ok {
+		t.Error("cluster of the valid kubeconfig not added")
+	}
+
+	m.RemoveClustersOfSecret(secret)
+	if clusters := m.Clusters(); len(clusters) != 0 {
+		t.Errorf("unexpected clusters %v after the removal", clusters)
+	}
+}
@@ -0,0 +1,174 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package ownership helps the operators generating Istio resources for their
+// own resources to own them, so that the garbage collector deletes them with
+// their owner, and to delete the ones they no longer generate, e.g.
+//
+//	err := ownership.SetControllerReference(app, appGVK, vs)
+//	...
+//	deleted, err := ownership.DeleteOrphans(ctx, metadataClient, app.Namespace, app, func(child metav1.PartialObjectMetadata) bool {
+//		return generated[child.Name]
+//	})
+//
+// The children are listed through a metadata client, which only transfers
+// their metadata.
+package ownership
+
+import (
+	"context"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/metadata"
+
+	"github.com/banzaicloud/istio-client-go/pkg/registry"
+)
+
+// AlreadyOwnedError is returned by SetControllerReference when the object is
+// controlled by another owner.
+type AlreadyOwnedError struct {
+	Object metav1.Object
+	Owner  metav1.OwnerReference
+}
+
+func (e *AlreadyOwnedError) Error() string {
+	return fmt.Sprintf("object %s/%s is already owned by another %s controller %s", e.Object.GetNamespace(), e.Object.GetName(), e.Owner.Kind, e.Owner.Name)
+}
+
+// SetControllerReference sets the owner, of the given kind, as the controller
+// of the object, with the deletion of the owner blocked until the object is
+// deleted. The owner reference of the owner is replaced if the object has it
+// already. Since owner references cannot cross namespaces, the object must be
+// in the namespace of a namespaced owner.
+func SetControllerReference(owner metav1.Object, ownerGVK schema.GroupVersionKind, obj metav1.Object) error {
+	if owner.GetNamespace() != "" && owner.GetNamespace() != obj.GetNamespace() {
+		return fmt.Errorf("cross-namespace owner references are not allowed: %s/%s cannot own %s/%s", owner.GetNamespace(), owner.GetName(), obj.GetNamespace(), obj.GetName())
+	}
+
+	ref := *metav1.NewControllerRef(owner, ownerGVK)
+	refs := obj.GetOwnerReferences()
+	for i, existing := range refs {
+		if existing.UID == ref.UID {
+			refs[i] = ref
+			obj.SetOwnerReferences(refs)
+			return nil
+		}
+		if existing.Controller != nil && *existing.Controller {
+			return &AlreadyOwnedError{Object: obj, Owner: existing}
+		}
+	}
+	obj.SetOwnerReferences(append(refs, ref))
+
+	return nil
+}
+
+// IsOwnedBy returns true if the object has an owner reference of the owner.
+func IsOwnedBy(obj, owner metav1.Object) bool {
+	for _, ref := range obj.GetOwnerReferences() {
+		if ref.UID == owner.GetUID() {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Kinds returns the kinds the children are listed of by default: the
+// namespaced Istio kinds, each in its latest API version.
+func Kinds() []schema.GroupVersionKind {
+	latest := map[schema.GroupKind]int{}
+	var kinds []schema.GroupVersionKind
+	for _, kind := range registry.Kinds() {
+		if !kind.Namespaced {
+			continue
+		}
+		gk := kind.GroupVersionKind.GroupKind()
+		if i, ok := latest[gk]; ok {
+			// the registry lists the versions of a kind from the oldest
+			kinds[i] = kind.GroupVersionKind
+			continue
+		}
+		latest[gk] = len(kinds)
+		kinds = append(kinds, kind.GroupVersionKind)
+	}
+
+	return kinds
+}
+
+// Children returns the objects of the kinds in the namespace with an owner
+// reference of the owner, with their TypeMeta set. The children are listed of
+// the kinds returned by Kinds if none are given, and the kinds the API server
+// does not serve are skipped.
+func Children(ctx context.Context, client metadata.Interface, namespace string, owner metav1.Object, kinds ...schema.GroupVersionKind) ([]metav1.PartialObjectMetadata, error) {
+	if len(kinds) == 0 {
+		kinds = Kinds()
+	}
+
+	var children []metav1.PartialObjectMetadata
+	for _, gvk := range kinds {
+		kind, ok := registry.ForKind(gvk)
+		if !ok {
+			return nil, fmt.Errorf("%s is not an Istio kind modeled by this module", gvk)
+		}
+		list, err := client.Resource(kind.GroupVersionResource).Namespace(namespace).List(ctx, metav1.ListOptions{})
+		if apierrors.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range list.Items {
+			if IsOwnedBy(&item, owner) {
+				item.SetGroupVersionKind(gvk)
+				children = append(children, item)
+			}
+		}
+	}
+
+	return children, nil
+}
+
+// DeleteOrphans deletes the children of the owner of the kinds, listed as by
+// Children, which are not kept, and returns the deleted ones. The children are
+// deleted only if they were not replaced since they were listed.
+func DeleteOrphans(ctx context.Context, client metadata.Interface, namespace string, owner metav1.Object, keep func(metav1.PartialObjectMetadata) bool, kinds ...schema.GroupVersionKind) ([]metav1.PartialObjectMetadata, error) {
+	children, err := Children(ctx, client, namespace, owner, kinds...)
+	if err != nil {
+		return nil, err
+	}
+
+	var deleted []metav1.PartialObjectMetadata
+	for _, child := range children {
+		if keep(child) {
+			continue
+		}
+		kind, _ := registry.ForKind(child.GroupVersionKind())
+		uid := child.UID
+		err := client.Resource(kind.GroupVersionResource).Namespace(child.Namespace).Delete(ctx, child.Name, metav1.DeleteOptions{
+			Preconditions: &metav1.Preconditions{UID: &uid},
+		})
+		if apierrors.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return deleted, err
+		}
+		deleted = append(deleted, child)
+	}
+
+	return deleted, nil
+}
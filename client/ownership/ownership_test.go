@@ -0,0 +1,132 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ownership
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"sort"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	metadatafake "k8s.io/client-go/metadata/fake"
+
+	networkingv1beta1 "github.com/banzaicloud/istio-client-go/pkg/networking/v1beta1"
+	securityv1beta1 "github.com/banzaicloud/istio-client-go/pkg/security/v1beta1"
+)
+
+var appGVK = schema.GroupVersionKind{Group: "apps.example.com", Version: "v1", Kind: "App"}
+
+func owner(name string) *metav1.PartialObjectMetadata {
+	return &metav1.PartialObjectMetadata{ObjectMeta: metav1.ObjectMeta{Namespace: "prod", Name: name, UID: "uid-" + name}}
+}
+
+func TestSetControllerReference(t *testing.T) {
+	vs := &networkingv1beta1.VirtualService{ObjectMeta: metav1.ObjectMeta{Namespace: "prod", Name: "reviews"}}
+	if err := SetControllerReference(owner("reviews"), appGVK, vs); err != nil {
+		t.Fatal(err)
+	}
+	// setting it again replaces the reference
+	if err := SetControllerReference(owner("reviews"), appGVK, vs); err != nil {
+		t.Fatal(err)
+	}
+	if refs := vs.GetOwnerReferences(); len(refs) != 1 || refs[0].Kind != "App" || refs[0].UID != "uid-reviews" || !*refs[0].Controller {
+		t.Errorf("unexpected owner references %v", refs)
+	}
+
+	var alreadyOwned *AlreadyOwnedError
+	if err := SetControllerReference(owner("ratings"), appGVK, vs); !errors.As(err, &alreadyOwned) {
+		t.Errorf("unexpected error %v, expected the object to be already owned", err)
+	}
+
+	other := &metav1.PartialObjectMetadata{ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "reviews", UID: "uid-test"}}
+	if err := SetControllerReference(other, appGVK, &networkingv1beta1.VirtualService{ObjectMeta: metav1.ObjectMeta{Namespace: "prod"}}); err == nil {
+		t.Error("expected a cross-namespace owner to be rejected")
+	}
+}
+
+func child(gvk schema.GroupVersionKind, name string, owners ...string) *metav1.PartialObjectMetadata {
+	obj := &metav1.PartialObjectMetadata{ObjectMeta: metav1.ObjectMeta{Namespace: "prod", Name: name, UID: "uid-child-" + name}}
+	obj.SetGroupVersionKind(gvk)
+	for _, o := range owners {
+		obj.OwnerReferences = append(obj.OwnerReferences, metav1.OwnerReference{APIVersion: appGVK.GroupVersion().String(), Kind: appGVK.Kind, Name: o, UID: owner(o).UID})
+	}
+
+	return obj
+}
+
+func names(objs []metav1.PartialObjectMetadata) []string {
+	out := []string{}
+	for _, obj := range objs {
+		out = append(out, obj.Kind+"/"+obj.Name)
+	}
+	sort.Strings(out)
+
+	return out
+}
+
+func TestDeleteOrphans(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := metav1.AddMetaToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	client := metadatafake.NewSimpleMetadataClient(scheme,
+		child(networkingv1beta1.VirtualServiceGroupVersionKind, "reviews", "reviews"),
+		child(networkingv1beta1.VirtualServiceGroupVersionKind, "reviews-canary", "reviews"),
+		child(networkingv1beta1.DestinationRuleGroupVersionKind, "reviews", "reviews"),
+		child(securityv1beta1.AuthorizationPolicyGroupVersionKind, "reviews-canary", "reviews"),
+		child(networkingv1beta1.VirtualServiceGroupVersionKind, "ratings", "ratings"),
+	)
+
+	children, err := Children(context.Background(), client, "prod", owner("reviews"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []string{"AuthorizationPolicy/reviews-canary", "DestinationRule/reviews", "VirtualService/reviews", "VirtualService/reviews-canary"}
+	if !reflect.DeepEqual(names(children), expected) {
+		t.Errorf("unexpected children %v, expected %v", names(children), expected)
+	}
+
+	deleted, err := DeleteOrphans(context.Background(), client, "prod", owner("reviews"), func(child metav1.PartialObjectMetadata) bool {
+		return child.Name == "reviews"
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected = []string{"AuthorizationPolicy/reviews-canary", "VirtualService/reviews-canary"}
+	if !reflect.DeepEqual(names(deleted), expected) {
+		t.Errorf("unexpected deleted children %v, expected %v", names(deleted), expected)
+	}
+
+	children, err = Children(context.Background(), client, "prod", owner("reviews"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected = []string{"DestinationRule/reviews", "VirtualService/reviews"}
+	if !reflect.DeepEqual(names(children), expected) {
+		t.Errorf("unexpected children %v after the deletion, expected %v", names(children), expected)
+	}
+}
+
+func TestKinds(t *testing.T) {
+	for _, gvk := range Kinds() {
+		if gvk.Group == "networking.istio.io" && gvk.Version != "v1beta1" && gvk.Kind != "EnvoyFilter" && gvk.Kind != "WorkloadGroup" {
+			t.Errorf("unexpected version of %s", gvk)
+		}
+	}
+}
@@ -0,0 +1,38 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha3
+
+import (
+	"context"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	v1alpha3 "github.com/banzaicloud/istio-client-go/client/clientset/versioned/typed/networking/v1alpha3"
+	"github.com/banzaicloud/istio-client-go/client/pagination"
+	networkingv1alpha3 "github.com/banzaicloud/istio-client-go/pkg/networking/v1alpha3"
+)
+
+// ListAllEnvoyFilters lists the envoyFilters page by page, calling fn with each of them.
+// See pagination.ListFunc.ListAllPages for details.
+func ListAllEnvoyFilters(ctx context.Context, client v1alpha3.EnvoyFilterInterface, opts metav1.ListOptions, fn func(obj *networkingv1alpha3.EnvoyFilter) error) error {
+	list := func(ctx context.Context, opts metav1.ListOptions) (runtime.Object, error) {
+		return client.List(ctx, opts)
+	}
+
+	return pagination.ListFunc(list).ListAllPages(ctx, opts, func(obj runtime.Object) error {
+		return fn(obj.(*networkingv1alpha3.EnvoyFilter))
+	})
+}
@@ -0,0 +1,63 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1beta1
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clienttesting "k8s.io/client-go/testing"
+
+	"github.com/banzaicloud/istio-client-go/client/clientset/versioned/fake"
+	networkingv1beta1 "github.com/banzaicloud/istio-client-go/pkg/networking/v1beta1"
+)
+
+func TestListAllVirtualServices(t *testing.T) {
+	page := func(continueToken string, names ...string) *networkingv1beta1.VirtualServiceList {
+		list := &networkingv1beta1.VirtualServiceList{ListMeta: metav1.ListMeta{Continue: continueToken}}
+		for _, name := range names {
+			list.Items = append(list.Items, networkingv1beta1.VirtualService{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"}})
+		}
+		return list
+	}
+	// the fake clientset ignores the limit and the continue token, the pages
+	// are served in order instead
+	pages := []*networkingv1beta1.VirtualServiceList{page("2", "a", "b"), page("", "c")}
+
+	clientset := fake.NewSimpleClientset()
+	clientset.PrependReactor("list", "virtualservices", func(clienttesting.Action) (bool, runtime.Object, error) {
+		list := pages[0]
+		pages = pages[1:]
+		return true, list, nil
+	})
+
+	var names []string
+	err := ListAllVirtualServices(context.Background(), clientset.NetworkingV1beta1().VirtualServices("default"), metav1.ListOptions{Limit: 2}, func(obj *networkingv1beta1.VirtualService) error {
+		names = append(names, obj.Name)
+		return nil
+	})
+	if err != nil {
+		t.Errorf("unexpected error %v", err)
+	}
+	if expected := []string{"a", "b", "c"}; !reflect.DeepEqual(names, expected) {
+		t.Errorf("unexpected virtual services %q, expected %q", names, expected)
+	}
+	if len(clientset.Actions()) != 2 {
+		t.Errorf("unexpected actions %v", clientset.Actions())
+	}
+}
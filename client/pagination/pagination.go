@@ -0,0 +1,98 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package pagination lists every object of a kind page by page, following
+// the continue tokens of the API server, so that huge resource sets are not
+// fetched with a single request.
+//
+// The functions of this package work with controller-runtime clients, the
+// functions of the group version subpackages with the generated clientset.
+package pagination
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// DefaultPageSize is the number of objects requested per page if the list
+// options set no limit.
+const DefaultPageSize = 500
+
+// ListAllPages lists the objects into list page by page, calling fn with
+// each of them. The list only holds the last page in the end. The limit of
+// the options is the size of the pages, DefaultPageSize if it is not set.
+// Listing stops at the first error returned by fn or the client, e.g. the
+// expiry of a continue token after a compaction, in which case listing has to
+// start over.
+//
+// Note that the cached client of a manager ignores the continue tokens, so
+// an uncached client, e.g. the APIReader of the manager, should be used.
+func ListAllPages(ctx context.Context, c client.Reader, list client.ObjectList, fn func(obj client.Object) error, opts ...client.ListOption) error {
+	limit := (&client.ListOptions{}).ApplyOptions(opts).Limit
+	if limit == 0 {
+		limit = DefaultPageSize
+	}
+
+	token := ""
+	for {
+		pageOpts := append(opts[:len(opts):len(opts)], client.Limit(limit), client.Continue(token))
+		if err := c.List(ctx, list, pageOpts...); err != nil {
+			return err
+		}
+		if err := meta.EachListItem(list, func(item runtime.Object) error {
+			return fn(item.(client.Object))
+		}); err != nil {
+			return err
+		}
+
+		if token = list.GetContinue(); token == "" {
+			return nil
+		}
+	}
+}
+
+// ListFunc adapts the List function of the typed client of a kind from the
+// generated clientset.
+type ListFunc func(ctx context.Context, opts metav1.ListOptions) (runtime.Object, error)
+
+// ListAllPages lists the objects page by page, calling fn with each of them.
+// The limit of the options is the size of the pages, DefaultPageSize if it
+// is not set. Listing stops at the first error returned by fn or the client.
+func (f ListFunc) ListAllPages(ctx context.Context, opts metav1.ListOptions, fn func(obj runtime.Object) error) error {
+	if opts.Limit == 0 {
+		opts.Limit = DefaultPageSize
+	}
+
+	for {
+		list, err := f(ctx, opts)
+		if err != nil {
+			return err
+		}
+		if err := meta.EachListItem(list, fn); err != nil {
+			return err
+		}
+
+		listMeta, err := meta.ListAccessor(list)
+		if err != nil {
+			return err
+		}
+		if opts.Continue = listMeta.GetContinue(); opts.Continue == "" {
+			return nil
+		}
+	}
+}
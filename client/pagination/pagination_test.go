@@ -0,0 +1,189 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pagination
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	networkingv1beta1 "github.com/banzaicloud/istio-client-go/pkg/networking/v1beta1"
+)
+
+// pages serves the named virtual services in pages of the requested size,
+// the continue token being the index of the first object of the next page.
+type pages struct {
+	names    []string
+	requests []metav1.ListOptions
+}
+
+func (p *pages) list(opts metav1.ListOptions) (*networkingv1beta1.VirtualServiceList, error) {
+	p.requests = append(p.requests, opts)
+
+	start := 0
+	if opts.Continue != "" {
+		var err error
+		if start, err = strconv.Atoi(opts.Continue); err != nil {
+			return nil, fmt.Errorf("invalid continue token %q", opts.Continue)
+		}
+	}
+	end := start + int(opts.Limit)
+	if opts.Limit == 0 || end > len(p.names) {
+		end = len(p.names)
+	}
+
+	list := &networkingv1beta1.VirtualServiceList{}
+	for _, name := range p.names[start:end] {
+		list.Items = append(list.Items, networkingv1beta1.VirtualService{ObjectMeta: metav1.ObjectMeta{Name: name}})
+	}
+	if end < len(p.names) {
+		list.Continue = strconv.Itoa(end)
+	}
+
+	return list, nil
+}
+
+func (p *pages) Get(ctx context.Context, key client.ObjectKey, obj client.Object) error {
+	return errors.New("not implemented")
+}
+
+func (p *pages) List(ctx context.Context, list client.ObjectList, opts ...client.ListOption) error {
+	page, err := p.list(*(&client.ListOptions{}).ApplyOptions(opts).AsListOptions())
+	if err != nil {
+		return err
+	}
+	page.DeepCopyInto(list.(*networkingv1beta1.VirtualServiceList))
+
+	return nil
+}
+
+func TestListAllPages(t *testing.T) {
+	errStop := errors.New("stop")
+	tests := []struct {
+		name              string
+		objects           []string
+		opts              []client.ListOption
+		stopAt            string
+		expectedNames     []string
+		expectedContinues []string
+		expectedLimit     int64
+		expectedErr       error
+	}{
+		{
+			name:              "default page size",
+			objects:           []string{"a", "b", "c"},
+			expectedNames:     []string{"a", "b", "c"},
+			expectedContinues: []string{""},
+			expectedLimit:     DefaultPageSize,
+		},
+		{
+			name:              "pages",
+			objects:           []string{"a", "b", "c", "d", "e"},
+			opts:              []client.ListOption{client.InNamespace("default"), client.Limit(2)},
+			expectedNames:     []string{"a", "b", "c", "d", "e"},
+			expectedContinues: []string{"", "2", "4"},
+			expectedLimit:     2,
+		},
+		{
+			name:              "no objects",
+			opts:              []client.ListOption{client.Limit(2)},
+			expectedContinues: []string{""},
+			expectedLimit:     2,
+		},
+		{
+			name:              "stopped",
+			objects:           []string{"a", "b", "c", "d", "e"},
+			opts:              []client.ListOption{client.Limit(2)},
+			stopAt:            "c",
+			expectedNames:     []string{"a", "b", "c"},
+			expectedContinues: []string{"", "2"},
+			expectedLimit:     2,
+			expectedErr:       errStop,
+		},
+	}
+
+	for _, test := range tests {
+		reader := &pages{names: test.objects}
+		var names []string
+		err := ListAllPages(context.Background(), reader, &networkingv1beta1.VirtualServiceList{}, func(obj client.Object) error {
+			names = append(names, obj.GetName())
+			if obj.GetName() == test.stopAt {
+				return errStop
+			}
+			return nil
+		}, test.opts...)
+		if err != test.expectedErr {
+			t.Errorf("%s: unexpected error %v, expected %v", test.name, err, test.expectedErr)
+		}
+		if !reflect.DeepEqual(names, test.expectedNames) {
+			t.Errorf("%s: unexpected objects %q, expected %q", test.name, names, test.expectedNames)
+		}
+
+		var continues []string
+		for _, request := range reader.requests {
+			continues = append(continues, request.Continue)
+			if request.Limit != test.expectedLimit {
+				t.Errorf("%s: unexpected limit %d, expected %d", test.name, request.Limit, test.expectedLimit)
+			}
+		}
+		if !reflect.DeepEqual(continues, test.expectedContinues) {
+			t.Errorf("%s: unexpected continue tokens %q, expected %q", test.name, continues, test.expectedContinues)
+		}
+	}
+}
+
+func TestListFuncListAllPages(t *testing.T) {
+	reader := &pages{names: []string{"a", "b", "c"}}
+	list := ListFunc(func(ctx context.Context, opts metav1.ListOptions) (runtime.Object, error) {
+		return reader.list(opts)
+	})
+
+	var names []string
+	if err := list.ListAllPages(context.Background(), metav1.ListOptions{Limit: 2}, func(obj runtime.Object) error {
+		names = append(names, obj.(*networkingv1beta1.VirtualService).Name)
+		return nil
+	}); err != nil {
+		t.Errorf("unexpected error %v", err)
+	}
+	if expected := []string{"a", "b", "c"}; !reflect.DeepEqual(names, expected) {
+		t.Errorf("unexpected objects %q, expected %q", names, expected)
+	}
+	if len(reader.requests) != 2 || reader.requests[1].Continue != "2" {
+		t.Errorf("unexpected requests %v", reader.requests)
+	}
+
+	reader = &pages{names: []string{"a"}}
+	if err := list.ListAllPages(context.Background(), metav1.ListOptions{}, func(obj runtime.Object) error {
+		return nil
+	}); err != nil || len(reader.requests) != 1 || reader.requests[0].Limit != DefaultPageSize {
+		t.Errorf("unexpected requests %v, %v", reader.requests, err)
+	}
+
+	failing := ListFunc(func(ctx context.Context, opts metav1.ListOptions) (runtime.Object, error) {
+		return nil, errors.New("expired")
+	})
+	if err := failing.ListAllPages(context.Background(), metav1.ListOptions{}, func(obj runtime.Object) error {
+		return nil
+	}); err == nil {
+		t.Error("expected the error of the client to be returned")
+	}
+}
@@ -0,0 +1,115 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package predicates provides the controller-runtime predicates of the watches
+// of Istio resources, so that the reconcilers are not triggered by the status
+// and the annotations istiod writes, e.g.
+//
+//	ctrl.NewControllerManagedBy(mgr).
+//		For(&v1beta1.VirtualService{}, builder.WithPredicates(predicates.SpecChangedPredicate{})).
+//		Complete(r)
+package predicates
+
+import (
+	"reflect"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+)
+
+// RevisionLabel is the label of the resources of an Istio control plane
+// revision.
+const RevisionLabel = "istio.io/rev"
+
+// DefaultRevision is the revision of the resources without the RevisionLabel.
+const DefaultRevision = "default"
+
+// SpecChangedPredicate passes the updates changing the spec of the objects,
+// or starting their deletion, and ignores the ones of their status and
+// metadata. The updates of the objects without a spec are passed. Unlike the
+// GenerationChangedPredicate, it does not depend on the status subresource
+// being enabled.
+type SpecChangedPredicate struct {
+	predicate.Funcs
+}
+
+// Update implements predicate.Predicate.
+func (SpecChangedPredicate) Update(e event.UpdateEvent) bool {
+	if e.ObjectOld == nil || e.ObjectNew == nil {
+		return false
+	}
+	if (e.ObjectOld.GetDeletionTimestamp() == nil) != (e.ObjectNew.GetDeletionTimestamp() == nil) {
+		return true
+	}
+
+	oldSpec, ok := spec(e.ObjectOld)
+	if !ok {
+		return true
+	}
+	newSpec, ok := spec(e.ObjectNew)
+	if !ok {
+		return true
+	}
+
+	return !equality.Semantic.DeepEqual(oldSpec, newSpec)
+}
+
+// GenerationOrLabelChanged returns the predicate passing the updates changing
+// the generation or the labels of the objects.
+func GenerationOrLabelChanged() predicate.Predicate {
+	return predicate.Or(predicate.GenerationChangedPredicate{}, predicate.LabelChangedPredicate{})
+}
+
+// IstioRevisionPredicate returns the predicate passing the events of the
+// objects of the Istio revision, the ones with the revision in their
+// RevisionLabel, or without the label for the DefaultRevision or an empty
+// revision.
+func IstioRevisionPredicate(revision string) predicate.Predicate {
+	return predicate.NewPredicateFuncs(func(obj client.Object) bool {
+		return Revision(obj) == revision || (revision == "" && Revision(obj) == DefaultRevision)
+	})
+}
+
+// Revision returns the Istio revision of the object, DefaultRevision if it
+// has no RevisionLabel.
+func Revision(obj client.Object) string {
+	if revision, ok := obj.GetLabels()[RevisionLabel]; ok && revision != "" {
+		return revision
+	}
+
+	return DefaultRevision
+}
+
+// spec returns the spec of the object, the Spec field of the typed objects.
+func spec(obj runtime.Object) (interface{}, bool) {
+	if u, ok := obj.(*unstructured.Unstructured); ok {
+		s, found := u.Object["spec"]
+		return s, found
+	}
+
+	v := reflect.Indirect(reflect.ValueOf(obj))
+	if v.Kind() != reflect.Struct {
+		return nil, false
+	}
+	s := v.FieldByName("Spec")
+	if !s.IsValid() {
+		return nil, false
+	}
+
+	return s.Interface(), true
+}
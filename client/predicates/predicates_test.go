@@ -0,0 +1,89 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package predicates
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+
+	metav1alpha1 "github.com/banzaicloud/istio-client-go/pkg/meta/v1alpha1"
+	v1beta1 "github.com/banzaicloud/istio-client-go/pkg/networking/v1beta1"
+)
+
+func TestSpecChangedPredicate(t *testing.T) {
+	old := &v1beta1.VirtualService{
+		ObjectMeta: metav1.ObjectMeta{Name: "reviews", Generation: 1},
+		Spec:       v1beta1.VirtualServiceSpec{Hosts: []string{"reviews"}},
+	}
+
+	status := old.DeepCopy()
+	status.Status = &metav1alpha1.IstioStatus{ObservedGeneration: 1}
+	status.Annotations = map[string]string{"istio.io/status": "applied"}
+
+	spec := old.DeepCopy()
+	spec.Spec.Hosts = append(spec.Spec.Hosts, "ratings")
+
+	deleted := old.DeepCopy()
+	now := metav1.Now()
+	deleted.DeletionTimestamp = &now
+
+	unstructuredOld := &unstructured.Unstructured{Object: map[string]interface{}{"spec": map[string]interface{}{"hosts": []interface{}{"reviews"}}}}
+	unstructuredStatus := unstructuredOld.DeepCopy()
+	unstructuredStatus.Object["status"] = map[string]interface{}{"observedGeneration": int64(1)}
+
+	tests := []struct {
+		name     string
+		old, new client.Object
+		expected bool
+	}{
+		{name: "status and annotations", old: old, new: status, expected: false},
+		{name: "spec", old: old, new: spec, expected: true},
+		{name: "deletion", old: old, new: deleted, expected: true},
+		{name: "unstructured status", old: unstructuredOld, new: unstructuredStatus, expected: false},
+	}
+
+	for _, test := range tests {
+		if passed := (SpecChangedPredicate{}).Update(event.UpdateEvent{ObjectOld: test.old, ObjectNew: test.new}); passed != test.expected {
+			t.Errorf("%s: predicate returned %v, expected %v", test.name, passed, test.expected)
+		}
+	}
+}
+
+func TestIstioRevisionPredicate(t *testing.T) {
+	canary := &v1beta1.VirtualService{ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{RevisionLabel: "canary"}}}
+	unlabeled := &v1beta1.VirtualService{}
+
+	tests := []struct {
+		revision string
+		obj      client.Object
+		expected bool
+	}{
+		{revision: "canary", obj: canary, expected: true},
+		{revision: "canary", obj: unlabeled, expected: false},
+		{revision: "", obj: unlabeled, expected: true},
+		{revision: "default", obj: unlabeled, expected: true},
+		{revision: "default", obj: canary, expected: false},
+	}
+
+	for _, test := range tests {
+		if passed := IstioRevisionPredicate(test.revision).Create(event.CreateEvent{Object: test.obj}); passed != test.expected {
+			t.Errorf("revision %q of %v: predicate returned %v, expected %v", test.revision, test.obj.GetLabels(), passed, test.expected)
+		}
+	}
+}
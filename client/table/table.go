@@ -0,0 +1,267 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package table converts Istio resources to the tables kubectl prints, with
+// the columns of the additional printer columns of the CustomResourceDefinitions
+// of the crd package, so that aggregated API servers and terminal UIs render
+// them the way the API server does, e.g.
+//
+//	t, err := table.ToTable(virtualServiceList)
+//
+// The Convertor of a kind implements the ConvertToTable method of the
+// TableConvertor interface of the API server.
+package table
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"reflect"
+	"time"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/duration"
+	"k8s.io/client-go/util/jsonpath"
+
+	"github.com/banzaicloud/istio-client-go/client/crd"
+	"github.com/banzaicloud/istio-client-go/pkg/registry"
+)
+
+// column is an additional printer column.
+type column struct {
+	definition metav1.TableColumnDefinition
+	path       *jsonpath.JSONPath
+}
+
+// Convertor converts the objects of a kind to tables.
+type Convertor struct {
+	columns []column
+}
+
+// ForKind returns the convertor of the Istio kind, with the printer columns
+// of its version in the definition of the crd package, or the age of the
+// objects if it has none.
+func ForKind(gvk schema.GroupVersionKind) (*Convertor, error) {
+	crds, err := crd.CustomResourceDefinitions()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, definition := range crds {
+		if definition.Spec.Group != gvk.Group || definition.Spec.Names.Kind != gvk.Kind {
+			continue
+		}
+		for _, version := range definition.Spec.Versions {
+			if version.Name == gvk.Version {
+				return newConvertor(version.AdditionalPrinterColumns)
+			}
+		}
+	}
+
+	return nil, fmt.Errorf("no definition of %s", gvk)
+}
+
+func newConvertor(definitions []apiextensionsv1.CustomResourceColumnDefinition) (*Convertor, error) {
+	if len(definitions) == 0 {
+		definitions = []apiextensionsv1.CustomResourceColumnDefinition{{
+			Name:        "Age",
+			Type:        "date",
+			Description: metav1.ObjectMeta{}.SwaggerDoc()["creationTimestamp"],
+			JSONPath:    ".metadata.creationTimestamp",
+		}}
+	}
+
+	c := &Convertor{
+		columns: []column{{
+			definition: metav1.TableColumnDefinition{
+				Name:        "Name",
+				Type:        "string",
+				Format:      "name",
+				Description: metav1.ObjectMeta{}.SwaggerDoc()["name"],
+			},
+		}},
+	}
+	for _, definition := range definitions {
+		path := jsonpath.New(definition.Name)
+		path.AllowMissingKeys(true)
+		if err := path.Parse(fmt.Sprintf("{%s}", definition.JSONPath)); err != nil {
+			return nil, fmt.Errorf("invalid JSON path %s of column %s: %w", definition.JSONPath, definition.Name, err)
+		}
+		c.columns = append(c.columns, column{
+			definition: metav1.TableColumnDefinition{
+				Name:        definition.Name,
+				Type:        definition.Type,
+				Format:      definition.Format,
+				Description: definition.Description,
+				Priority:    definition.Priority,
+			},
+			path: path,
+		})
+	}
+
+	return c, nil
+}
+
+// ColumnDefinitions returns the columns of the tables, starting with the name.
+func (c *Convertor) ColumnDefinitions() []metav1.TableColumnDefinition {
+	definitions := make([]metav1.TableColumnDefinition, 0, len(c.columns))
+	for _, column := range c.columns {
+		definitions = append(definitions, column.definition)
+	}
+
+	return definitions
+}
+
+// ConvertToTable converts the object, or the items of the list, to the rows
+// of the table. The table options are ignored.
+func (c *Convertor) ConvertToTable(_ context.Context, obj runtime.Object, _ runtime.Object) (*metav1.Table, error) {
+	table := &metav1.Table{ColumnDefinitions: c.ColumnDefinitions()}
+
+	items := []runtime.Object{obj}
+	if meta.IsListType(obj) {
+		var err error
+		if items, err = meta.ExtractList(obj); err != nil {
+			return nil, err
+		}
+		if list, err := meta.ListAccessor(obj); err == nil {
+			table.ResourceVersion = list.GetResourceVersion()
+			table.Continue = list.GetContinue()
+			table.RemainingItemCount = list.GetRemainingItemCount()
+		}
+	} else if accessor, err := meta.Accessor(obj); err == nil {
+		table.ResourceVersion = accessor.GetResourceVersion()
+	}
+
+	for _, item := range items {
+		row, err := c.row(item)
+		if err != nil {
+			return nil, err
+		}
+		table.Rows = append(table.Rows, row)
+	}
+
+	return table, nil
+}
+
+func (c *Convertor) row(obj runtime.Object) (metav1.TableRow, error) {
+	content, err := toUnstructured(obj)
+	if err != nil {
+		return metav1.TableRow{}, err
+	}
+
+	name, _, _ := unstructured.NestedString(content, "metadata", "name")
+	cells := []interface{}{name}
+	for _, column := range c.columns[1:] {
+		cells = append(cells, cell(column, content))
+	}
+
+	return metav1.TableRow{Cells: cells, Object: runtime.RawExtension{Object: obj}}, nil
+}
+
+// cell returns the value of the column the way the API server prints the
+// additional printer columns: strings as they are printed by the JSON path,
+// dates as their age, and nil if the value is missing.
+func cell(column column, content map[string]interface{}) interface{} {
+	results, err := column.path.FindResults(content)
+	if err != nil || len(results) == 0 || len(results[0]) == 0 {
+		return nil
+	}
+	value := results[0][0].Interface()
+
+	switch column.definition.Type {
+	case "string":
+		var buf bytes.Buffer
+		if err := column.path.PrintResults(&buf, []reflect.Value{reflect.ValueOf(value)}); err != nil {
+			return nil
+		}
+		return buf.String()
+	case "date":
+		s, ok := value.(string)
+		if !ok {
+			return nil
+		}
+		t, err := time.Parse(time.RFC3339, s)
+		if err != nil {
+			return nil
+		}
+		return duration.HumanDuration(time.Since(t))
+	case "integer":
+		switch v := value.(type) {
+		case int64:
+			return v
+		case float64:
+			return int64(v)
+		}
+		return nil
+	case "number":
+		switch v := value.(type) {
+		case int64:
+			return float64(v)
+		case float64:
+			return v
+		}
+		return nil
+	case "boolean":
+		if b, ok := value.(bool); ok {
+			return b
+		}
+		return nil
+	}
+
+	return value
+}
+
+// ToTable converts the Istio object, or the items of the list, to a table.
+// Typed objects without their TypeMeta are converted as the kind registered
+// for their type.
+func ToTable(obj runtime.Object) (*metav1.Table, error) {
+	gvk, err := kindOf(obj)
+	if err != nil {
+		return nil, err
+	}
+	c, err := ForKind(gvk)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.ConvertToTable(context.Background(), obj, nil)
+}
+
+// kindOf returns the kind of the object, or of the items of the list.
+func kindOf(obj runtime.Object) (schema.GroupVersionKind, error) {
+	gvk := obj.GetObjectKind().GroupVersionKind()
+	for _, kind := range registry.Kinds() {
+		switch {
+		case !gvk.Empty() && (gvk == kind.GroupVersionKind || gvk == kind.ListGroupVersionKind()):
+			return kind.GroupVersionKind, nil
+		case gvk.Empty() && (reflect.TypeOf(obj) == reflect.TypeOf(kind.New()) || reflect.TypeOf(obj) == reflect.TypeOf(kind.NewList())):
+			return kind.GroupVersionKind, nil
+		}
+	}
+
+	return schema.GroupVersionKind{}, fmt.Errorf("unknown kind of %T", obj)
+}
+
+func toUnstructured(obj runtime.Object) (map[string]interface{}, error) {
+	if u, ok := obj.(runtime.Unstructured); ok {
+		return u.UnstructuredContent(), nil
+	}
+
+	return runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+}
@@ -0,0 +1,82 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package table
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	networkingv1beta1 "github.com/banzaicloud/istio-client-go/pkg/networking/v1beta1"
+	"github.com/banzaicloud/istio-client-go/pkg/registry"
+	securityv1beta1 "github.com/banzaicloud/istio-client-go/pkg/security/v1beta1"
+)
+
+func columnNames(table *metav1.Table) []string {
+	var names []string
+	for _, column := range table.ColumnDefinitions {
+		names = append(names, column.Name)
+	}
+
+	return names
+}
+
+func TestToTable(t *testing.T) {
+	created := metav1.NewTime(time.Now().Add(-72 * time.Hour))
+	list := &networkingv1beta1.VirtualServiceList{
+		ListMeta: metav1.ListMeta{ResourceVersion: "42"},
+		Items: []networkingv1beta1.VirtualService{{
+			ObjectMeta: metav1.ObjectMeta{Name: "reviews", CreationTimestamp: created},
+			Spec:       networkingv1beta1.VirtualServiceSpec{Hosts: []string{"reviews"}},
+		}},
+	}
+
+	table, err := ToTable(list)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if names := columnNames(table); !reflect.DeepEqual(names, []string{"Name", "Gateways", "Hosts", "Age"}) {
+		t.Errorf("unexpected columns %v", names)
+	}
+	if table.ResourceVersion != "42" || len(table.Rows) != 1 {
+		t.Fatalf("unexpected table %v", table)
+	}
+	if cells := table.Rows[0].Cells; !reflect.DeepEqual(cells, []interface{}{"reviews", nil, `["reviews"]`, "3d"}) {
+		t.Errorf("unexpected cells %#v", cells)
+	}
+}
+
+func TestToTableWithoutPrinterColumns(t *testing.T) {
+	table, err := ToTable(&securityv1beta1.RequestAuthentication{ObjectMeta: metav1.ObjectMeta{Name: "jwt"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if names := columnNames(table); !reflect.DeepEqual(names, []string{"Name", "Age"}) {
+		t.Errorf("unexpected columns %v", names)
+	}
+	if cells := table.Rows[0].Cells; !reflect.DeepEqual(cells, []interface{}{"jwt", nil}) {
+		t.Errorf("unexpected cells %#v", cells)
+	}
+}
+
+func TestForKind(t *testing.T) {
+	for _, kind := range registry.Kinds() {
+		if _, err := ForKind(kind.GroupVersionKind); err != nil {
+			t.Errorf("%s: %v", kind.GroupVersionKind, err)
+		}
+	}
+}
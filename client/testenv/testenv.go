@@ -0,0 +1,107 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package testenv starts envtest control planes for the integration tests of
+// controllers of Istio resources, with the Istio CRDs of the crd package
+// installed, e.g.
+//
+//	func TestReconcile(t *testing.T) {
+//		c := testenv.Setup(t)
+//		...
+//	}
+//
+// envtest runs the kube-apiserver and etcd binaries of the directory in the
+// KUBEBUILDER_ASSETS environment variable, see the envtest package of
+// controller-runtime.
+package testenv
+
+import (
+	"fmt"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/envtest"
+
+	istioscheme "github.com/banzaicloud/istio-client-go/client/clientset/versioned/scheme"
+	"github.com/banzaicloud/istio-client-go/client/crd"
+)
+
+// NewScheme returns a scheme of the built-in Kubernetes kinds and of the Istio
+// kinds.
+func NewScheme() (*runtime.Scheme, error) {
+	scheme := runtime.NewScheme()
+	if err := clientgoscheme.AddToScheme(scheme); err != nil {
+		return nil, err
+	}
+	if err := istioscheme.AddToScheme(scheme); err != nil {
+		return nil, err
+	}
+
+	return scheme, nil
+}
+
+// Start adds the Istio CRDs to the ones env installs, starts it, and returns a
+// client of its API server. The Istio kinds are added to the scheme of env,
+// which is set to NewScheme if it is nil. envtest waits for the CRDs to be
+// established before Start returns. The caller stops env when done.
+func Start(env *envtest.Environment) (client.Client, error) {
+	crds, err := crd.CustomResourceDefinitions()
+	if err != nil {
+		return nil, err
+	}
+	env.CRDs = append(env.CRDs, crds...)
+
+	if env.Scheme == nil {
+		if env.Scheme, err = NewScheme(); err != nil {
+			return nil, err
+		}
+	} else if err := istioscheme.AddToScheme(env.Scheme); err != nil {
+		return nil, err
+	}
+
+	config, err := env.Start()
+	if err != nil {
+		return nil, fmt.Errorf("could not start the test environment: %w", err)
+	}
+
+	c, err := client.New(config, client.Options{Scheme: env.Scheme})
+	if err != nil {
+		_ = env.Stop()
+		return nil, err
+	}
+
+	return c, nil
+}
+
+// Setup starts a new environment for the test, see Start, and stops it when
+// the test and its subtests complete. It fails the test if the environment
+// cannot be started.
+func Setup(t testing.TB) client.Client {
+	t.Helper()
+
+	env := &envtest.Environment{}
+	c, err := Start(env)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		if err := env.Stop(); err != nil {
+			t.Errorf("could not stop the test environment: %v", err)
+		}
+	})
+
+	return c
+}
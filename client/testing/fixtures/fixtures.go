@@ -0,0 +1,184 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package fixtures ships a corpus of real world Istio manifests, taken from
+// the examples of the Istio documentation and releases, and a harness
+// checking that the types decode and encode them without losing fields.
+//
+// Forks of this client, or projects with their own Istio types, can run the
+// harness from a test of theirs to find the fields their types do not model:
+//
+//	func TestRoundTrips(t *testing.T) {
+//		fixtures.RunRoundTrips(t, scheme.Scheme)
+//	}
+//
+// The manifests are in the corpus directory, one object per file, under the
+// API group and version of the object. The doc-*.yaml manifests are the YAML
+// examples of the doc comments of the types, extracted by make examples, so
+// that the examples the types document are checked to decode as well.
+package fixtures
+
+import (
+	"embed"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"path"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/yaml"
+
+	"github.com/banzaicloud/istio-client-go/pkg/common/v1alpha1"
+	"github.com/banzaicloud/istio-client-go/pkg/diff"
+)
+
+//go:embed corpus
+var corpus embed.FS
+
+// Fixture is a manifest of the corpus.
+type Fixture struct {
+	// Name is the path of the manifest in the corpus, e.g.
+	// networking.istio.io/v1beta1/virtualservice-bookinfo.yaml
+	Name string
+	// Data is the YAML manifest.
+	Data []byte
+}
+
+// Fixtures returns the manifests of the corpus ordered by name.
+func Fixtures() ([]Fixture, error) {
+	var fixtures []Fixture
+	err := fs.WalkDir(corpus, "corpus", func(name string, entry fs.DirEntry, err error) error {
+		if err != nil || entry.IsDir() || path.Ext(name) != ".yaml" {
+			return err
+		}
+
+		data, err := corpus.ReadFile(name)
+		if err != nil {
+			return err
+		}
+		fixtures = append(fixtures, Fixture{Name: name[len("corpus/"):], Data: data})
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return fixtures, nil
+}
+
+// RoundTrip decodes the fixture into the type the scheme registers for its
+// kind, encodes it again and returns the fields of the manifest that were lost
+// or changed on the way. Fields the encoding adds, such as an empty status,
+// are not reported, and neither are the ones only formatted differently, such
+// as a duration of 5m encoded as 5m0s, or unset because of a zero value.
+//
+// The error is a runtime.IsNotRegisteredError one for the kinds the scheme
+// does not know.
+func RoundTrip(scheme *runtime.Scheme, fixture Fixture) (diff.Changes, error) {
+	data, err := yaml.YAMLToJSON(fixture.Data)
+	if err != nil {
+		return nil, fmt.Errorf("could not convert %s to JSON: %w", fixture.Name, err)
+	}
+
+	typeMeta := metav1.TypeMeta{}
+	if err := json.Unmarshal(data, &typeMeta); err != nil {
+		return nil, fmt.Errorf("could not decode the type of %s: %w", fixture.Name, err)
+	}
+	obj, err := scheme.New(typeMeta.GroupVersionKind())
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, obj); err != nil {
+		return nil, fmt.Errorf("could not decode %s: %w", fixture.Name, err)
+	}
+	encoded, err := json.Marshal(obj)
+	if err != nil {
+		return nil, fmt.Errorf("could not encode %s: %w", fixture.Name, err)
+	}
+
+	changes, err := diff.Diff(json.RawMessage(data), json.RawMessage(encoded))
+	if err != nil {
+		return nil, err
+	}
+	var lost diff.Changes
+	for _, change := range changes {
+		if !equivalent(change.Old, change.New) {
+			lost = append(lost, change)
+		}
+	}
+
+	return lost, nil
+}
+
+// RunRoundTrips runs RoundTrip on every fixture of the corpus in a subtest of
+// t named after the fixture, which fails with the fields lost. The fixtures of
+// the kinds the scheme does not know are skipped.
+func RunRoundTrips(t *testing.T, scheme *runtime.Scheme) {
+	fixtures, err := Fixtures()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, fixture := range fixtures {
+		fixture := fixture
+		t.Run(fixture.Name, func(t *testing.T) {
+			lost, err := RoundTrip(scheme, fixture)
+			if runtime.IsNotRegisteredError(err) {
+				t.Skip(err)
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(lost) > 0 {
+				t.Errorf("fields lost in the round-trip:\n%s", lost)
+			}
+		})
+	}
+}
+
+// equivalent reports whether a value of the manifest, old, survived the
+// round-trip as new.
+func equivalent(old, new interface{}) bool {
+	switch old := old.(type) {
+	case nil:
+		return true
+	case bool:
+		return !old && new == nil
+	case float64:
+		return old == 0 && new == nil
+	case string:
+		if old == "" && new == nil {
+			return true
+		}
+		newString, ok := new.(string)
+		if !ok {
+			return false
+		}
+		oldDuration, err := v1alpha1.ParseDuration(old)
+		if err != nil {
+			return false
+		}
+		newDuration, err := v1alpha1.ParseDuration(newString)
+		return err == nil && oldDuration == newDuration
+	case map[string]interface{}:
+		return len(old) == 0 && new == nil
+	case []interface{}:
+		return len(old) == 0 && new == nil
+	}
+
+	return false
+}
@@ -0,0 +1,42 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fixtures
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/banzaicloud/istio-client-go/client/clientset/versioned/scheme"
+)
+
+func TestRoundTrips(t *testing.T) {
+	RunRoundTrips(t, scheme.Scheme)
+}
+
+func TestDocExamples(t *testing.T) {
+	fixtures, err := Fixtures()
+	if err != nil {
+		t.Fatal(err)
+	}
+	examples := 0
+	for _, fixture := range fixtures {
+		if strings.HasPrefix(fixture.Name[strings.LastIndex(fixture.Name, "/")+1:], "doc-") {
+			examples++
+		}
+	}
+	if examples == 0 {
+		t.Error("no examples of the doc comments in the corpus, run make examples")
+	}
+}
@@ -0,0 +1,67 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha3
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/watch"
+
+	"github.com/banzaicloud/istio-client-go/client/typedwatch"
+	networkingv1alpha3 "github.com/banzaicloud/istio-client-go/pkg/networking/v1alpha3"
+)
+
+// DestinationRuleEvent is a typed watch event of a DestinationRule.
+type DestinationRuleEvent struct {
+	// Type is the type of the event, Bookmark events are passed on as well.
+	Type watch.EventType
+	// Object is set unless the event is an error. The object of a bookmark
+	// event only carries the resource version.
+	Object *networkingv1alpha3.DestinationRule
+	// Err is set for error events and for events with an unexpected object.
+	Err error
+}
+
+// DestinationRules delivers the events of the watch as typed events. The returned
+// channel is closed and the watch is stopped when the watch ends or the
+// context is done.
+func DestinationRules(ctx context.Context, w watch.Interface) <-chan DestinationRuleEvent {
+	events := make(chan DestinationRuleEvent)
+
+	go func() {
+		defer close(events)
+
+		typedwatch.Forward(ctx, w, func(e typedwatch.Event) bool {
+			event := DestinationRuleEvent{Type: e.Type, Err: e.Err}
+			if e.Err == nil {
+				obj, ok := e.Object.(*networkingv1alpha3.DestinationRule)
+				if !ok {
+					event.Type = watch.Error
+					event.Err = typedwatch.UnexpectedObjectError(e.Object)
+				}
+				event.Object = obj
+			}
+
+			select {
+			case events <- event:
+				return true
+			case <-ctx.Done():
+				return false
+			}
+		})
+	}()
+
+	return events
+}
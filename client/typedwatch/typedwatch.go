@@ -0,0 +1,68 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package typedwatch turns the events of a watch into typed events delivered on
+// a channel. The typed events of each kind live in the group version subpackages.
+package typedwatch
+
+import (
+	"context"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+)
+
+// Event is a watch event with the status of error events decoded to an error.
+type Event struct {
+	Type   watch.EventType
+	Object runtime.Object
+	Err    error
+}
+
+// Forward reads the events of the watch and passes them to send until the watch
+// is closed, the context is done or send returns false. The watch is stopped
+// before Forward returns.
+func Forward(ctx context.Context, w watch.Interface, send func(Event) bool) {
+	defer w.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case event, ok := <-w.ResultChan():
+			if !ok {
+				return
+			}
+			if !send(convert(event)) {
+				return
+			}
+		}
+	}
+}
+
+// UnexpectedObjectError returns the error reported for events with an object of
+// an unexpected type.
+func UnexpectedObjectError(obj runtime.Object) error {
+	return fmt.Errorf("unexpected object of type %T in watch event", obj)
+}
+
+func convert(event watch.Event) Event {
+	if event.Type == watch.Error {
+		return Event{Type: event.Type, Err: apierrors.FromObject(event.Object)}
+	}
+
+	return Event{Type: event.Type, Object: event.Object}
+}
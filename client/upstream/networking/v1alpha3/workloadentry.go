@@ -0,0 +1,51 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha3
+
+import (
+	istionetworkingv1alpha3 "istio.io/client-go/pkg/apis/networking/v1alpha3"
+
+	"github.com/banzaicloud/istio-client-go/client/upstream"
+	networkingv1alpha3 "github.com/banzaicloud/istio-client-go/pkg/networking/v1alpha3"
+)
+
+// WorkloadEntryToUpstream converts a WorkloadEntry to the type of istio.io/client-go.
+func WorkloadEntryToUpstream(in *networkingv1alpha3.WorkloadEntry) (*istionetworkingv1alpha3.WorkloadEntry, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	out := &istionetworkingv1alpha3.WorkloadEntry{}
+	if err := upstream.ToUpstream(in, out); err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
+
+// WorkloadEntryFromUpstream converts a WorkloadEntry of istio.io/client-go to the type of
+// this client.
+func WorkloadEntryFromUpstream(in *istionetworkingv1alpha3.WorkloadEntry) (*networkingv1alpha3.WorkloadEntry, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	out := &networkingv1alpha3.WorkloadEntry{}
+	if err := upstream.FromUpstream(in, out); err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
@@ -0,0 +1,51 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1beta1
+
+import (
+	istionetworkingv1beta1 "istio.io/client-go/pkg/apis/networking/v1beta1"
+
+	"github.com/banzaicloud/istio-client-go/client/upstream"
+	networkingv1beta1 "github.com/banzaicloud/istio-client-go/pkg/networking/v1beta1"
+)
+
+// GatewayToUpstream converts a Gateway to the type of istio.io/client-go.
+func GatewayToUpstream(in *networkingv1beta1.Gateway) (*istionetworkingv1beta1.Gateway, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	out := &istionetworkingv1beta1.Gateway{}
+	if err := upstream.ToUpstream(in, out); err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
+
+// GatewayFromUpstream converts a Gateway of istio.io/client-go to the type of
+// this client.
+func GatewayFromUpstream(in *istionetworkingv1beta1.Gateway) (*networkingv1beta1.Gateway, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	out := &networkingv1beta1.Gateway{}
+	if err := upstream.FromUpstream(in, out); err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
@@ -0,0 +1,51 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1beta1
+
+import (
+	istiosecurityv1beta1 "istio.io/client-go/pkg/apis/security/v1beta1"
+
+	"github.com/banzaicloud/istio-client-go/client/upstream"
+	securityv1beta1 "github.com/banzaicloud/istio-client-go/pkg/security/v1beta1"
+)
+
+// PeerAuthenticationToUpstream converts a PeerAuthentication to the type of istio.io/client-go.
+func PeerAuthenticationToUpstream(in *securityv1beta1.PeerAuthentication) (*istiosecurityv1beta1.PeerAuthentication, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	out := &istiosecurityv1beta1.PeerAuthentication{}
+	if err := upstream.ToUpstream(in, out); err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
+
+// PeerAuthenticationFromUpstream converts a PeerAuthentication of istio.io/client-go to the type of
+// this client.
+func PeerAuthenticationFromUpstream(in *istiosecurityv1beta1.PeerAuthentication) (*securityv1beta1.PeerAuthentication, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	out := &securityv1beta1.PeerAuthentication{}
+	if err := upstream.FromUpstream(in, out); err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
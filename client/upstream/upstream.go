@@ -0,0 +1,61 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package upstream converts the resources of this client to and from the types
+// of the official istio.io/client-go, so that the two can be used side by side
+// or migrated between incrementally.
+//
+// The conversions go through JSON. The specs of the upstream types are protobuf
+// messages, which encode 64 bit integers as strings; these are decoded into the
+// numeric fields of this client.
+//
+// The functions of the group version subpackages convert the individual kinds.
+package upstream
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/banzaicloud/istio-client-go/pkg/protoconv"
+)
+
+// ToUpstream converts in, a resource of this client, into out, the same kind
+// of istio.io/client-go.
+func ToUpstream(in, out interface{}) error {
+	data, err := json.Marshal(in)
+	if err != nil {
+		return err
+	}
+
+	if err := json.Unmarshal(data, out); err != nil {
+		return fmt.Errorf("could not convert %T to %T: %w", in, out, err)
+	}
+
+	return nil
+}
+
+// FromUpstream converts in, a resource of istio.io/client-go, into out, the
+// same kind of this client.
+func FromUpstream(in, out interface{}) error {
+	data, err := json.Marshal(in)
+	if err != nil {
+		return err
+	}
+
+	if err := protoconv.DecodeJSON(data, out); err != nil {
+		return fmt.Errorf("could not convert %T to %T: %w", in, out, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,70 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// Defaulter is implemented by the kinds which set the values Istio assumes
+// for their unset fields.
+type Defaulter interface {
+	Default()
+}
+
+// DefaultingHandler is an admission.Handler setting the values Istio assumes
+// for the unset fields of the objects implementing Defaulter, so that they
+// compare cleanly against the objects read back. The response patches only
+// the fields Default sets, leaving the fields this module does not model
+// untouched. Kinds this module does not model or default, and deletions, are
+// allowed as they are.
+type DefaultingHandler struct{}
+
+var _ admission.Handler = &DefaultingHandler{}
+
+// Handle implements admission.Handler.
+func (h *DefaultingHandler) Handle(ctx context.Context, req admission.Request) admission.Response {
+	if len(req.Object.Raw) == 0 {
+		return admission.Allowed("")
+	}
+
+	gvk := schema.GroupVersionKind{Group: req.Kind.Group, Version: req.Kind.Version, Kind: req.Kind.Kind}
+	obj, _, err := decode(gvk, req.Object.Raw)
+	if err != nil {
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+	defaulter, ok := obj.(Defaulter)
+	if !ok {
+		return admission.Allowed(fmt.Sprintf("%s is not defaulted", gvk))
+	}
+
+	before, err := json.Marshal(obj)
+	if err != nil {
+		return admission.Errored(http.StatusInternalServerError, err)
+	}
+	defaulter.Default()
+	after, err := json.Marshal(obj)
+	if err != nil {
+		return admission.Errored(http.StatusInternalServerError, err)
+	}
+
+	return admission.PatchResponseFromRaw(before, after)
+}
@@ -0,0 +1,95 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"sort"
+	"testing"
+
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+func TestDefaultingHandler(t *testing.T) {
+	tests := []struct {
+		name            string
+		req             admission.Request
+		expectedAllowed bool
+		expectedCode    int32
+		expectedPatches []string
+	}{
+		{
+			name:            "defaulted",
+			req:             request("networking.istio.io", "v1beta1", "VirtualService", `{"spec":{"hosts":["reviews"],"http":[{"route":[{"destination":{"host":"reviews"}}]}]}}`),
+			expectedAllowed: true,
+			expectedCode:    http.StatusOK,
+			expectedPatches: []string{
+				`add /spec/gateways [mesh]`,
+				`add /spec/http/0/route/0/weight 100`,
+			},
+		},
+		{
+			name:            "already defaulted",
+			req:             request("networking.istio.io", "v1beta1", "VirtualService", `{"spec":{"hosts":["reviews"],"gateways":["mesh"],"http":[{"route":[{"destination":{"host":"reviews"},"weight":100}]}]}}`),
+			expectedAllowed: true,
+			expectedCode:    http.StatusOK,
+		},
+		{
+			name:            "not defaulted",
+			req:             request("networking.istio.io", "v1beta1", "Gateway", `{"spec":{"servers":[]}}`),
+			expectedAllowed: true,
+			expectedCode:    http.StatusOK,
+		},
+		{
+			name:            "deletion",
+			req:             request("networking.istio.io", "v1beta1", "VirtualService", ""),
+			expectedAllowed: true,
+			expectedCode:    http.StatusOK,
+		},
+		{
+			name:         "malformed",
+			req:          request("networking.istio.io", "v1beta1", "VirtualService", `{"spec":{"hosts":"reviews"}}`),
+			expectedCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, test := range tests {
+		response := (&DefaultingHandler{}).Handle(context.Background(), test.req)
+		if response.Allowed != test.expectedAllowed {
+			t.Errorf("%s: unexpected allowed %t, expected %t: %v", test.name, response.Allowed, test.expectedAllowed, response.Result)
+		}
+		// patch responses have no result
+		code := int32(http.StatusOK)
+		if response.Result != nil {
+			code = response.Result.Code
+		}
+		if code != test.expectedCode {
+			t.Errorf("%s: unexpected result %v, expected the code %d", test.name, response.Result, test.expectedCode)
+			continue
+		}
+
+		var patches []string
+		for _, patch := range response.Patches {
+			patches = append(patches, fmt.Sprintf("%s %s %v", patch.Operation, patch.Path, patch.Value))
+		}
+		sort.Strings(patches)
+		if !reflect.DeepEqual(patches, test.expectedPatches) {
+			t.Errorf("%s: unexpected patches %q, expected %q", test.name, patches, test.expectedPatches)
+		}
+	}
+}
@@ -0,0 +1,118 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package webhook provides controller-runtime admission handlers for the
+// Istio kinds modeled by this module, so that clusters can default and reject
+// invalid Istio configuration before Istio sees it, e.g.
+//
+//	mgr.GetWebhookServer().Register("/mutate-istio", &webhook.Admission{Handler: &istiowebhook.DefaultingHandler{}})
+//	mgr.GetWebhookServer().Register("/validate-istio", &webhook.Admission{Handler: &istiowebhook.ValidatingHandler{}})
+//
+// A single handler serves every kind, so one webhook configuration can match
+// all the groups, versions and resources of Istio.
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/banzaicloud/istio-client-go/pkg/deprecation"
+	"github.com/banzaicloud/istio-client-go/pkg/registry"
+	securityv1beta1 "github.com/banzaicloud/istio-client-go/pkg/security/v1beta1"
+	"github.com/banzaicloud/istio-client-go/pkg/validation"
+)
+
+// ValidatingHandler is an admission.Handler denying the objects which fail
+// the checks of their validation.Validator implementation. The response is
+// an Invalid status listing the paths of the problems, like the API server
+// returns for built-in kinds, and carries a warning for each deprecated field,
+// value or kind the object uses, along with its replacement, and for the
+// authorization policies in dry-run mode, which are not enforced. Kinds this
+// module does not model or validate, and deletions, are allowed.
+type ValidatingHandler struct{}
+
+var _ admission.Handler = &ValidatingHandler{}
+
+// Handle implements admission.Handler.
+func (h *ValidatingHandler) Handle(ctx context.Context, req admission.Request) admission.Response {
+	if len(req.Object.Raw) == 0 {
+		return admission.Allowed("")
+	}
+
+	gvk := schema.GroupVersionKind{Group: req.Kind.Group, Version: req.Kind.Version, Kind: req.Kind.Kind}
+	obj, warnings, err := decode(gvk, req.Object.Raw)
+	if err != nil {
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+	if obj == nil {
+		return admission.Allowed(fmt.Sprintf("%s is not validated", gvk))
+	}
+
+	validator, ok := obj.(validation.Validator)
+	if !ok {
+		return admission.Allowed(fmt.Sprintf("%s is not validated", gvk)).WithWarnings(warnings...)
+	}
+	if errs := validator.Validate(); len(errs) > 0 {
+		return invalid(gvk.GroupKind(), req.Name, errs).WithWarnings(warnings...)
+	}
+
+	return admission.Allowed("").WithWarnings(warnings...)
+}
+
+// decode returns a new object of the kind decoded from the raw JSON and the
+// warnings for its deprecated fields, or nil if the kind is not modeled.
+func decode(gvk schema.GroupVersionKind, raw []byte) (runtime.Object, []string, error) {
+	kind, ok := registry.ForKind(gvk)
+	if !ok {
+		return nil, nil, nil
+	}
+
+	obj := kind.New()
+	if err := json.Unmarshal(raw, obj); err != nil {
+		return nil, nil, err
+	}
+
+	var content map[string]interface{}
+	if err := json.Unmarshal(raw, &content); err != nil {
+		return nil, nil, err
+	}
+	var warnings []string
+	for _, usage := range deprecation.Check(&unstructured.Unstructured{Object: content}) {
+		warnings = append(warnings, usage.String())
+	}
+	if ap, ok := obj.(*securityv1beta1.AuthorizationPolicy); ok && ap.IsDryRun() {
+		warnings = append(warnings, fmt.Sprintf("authorization policy %s/%s is in dry-run mode: it is evaluated but not enforced", ap.Namespace, ap.Name))
+	}
+
+	return obj, warnings, nil
+}
+
+// invalid returns a response denying the object with an Invalid status, whose
+// causes are the validation errors.
+func invalid(kind schema.GroupKind, name string, errs field.ErrorList) admission.Response {
+	status := apierrors.NewInvalid(kind, name, errs).ErrStatus
+	response := admission.Denied(status.Message)
+	response.Result = &status
+
+	return response
+}
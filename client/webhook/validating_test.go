@@ -0,0 +1,125 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"testing"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+func request(group, version, kind, raw string) admission.Request {
+	return admission.Request{AdmissionRequest: admissionv1.AdmissionRequest{
+		Kind:   metav1.GroupVersionKind{Group: group, Version: version, Kind: kind},
+		Name:   "test",
+		Object: runtime.RawExtension{Raw: []byte(raw)},
+	}}
+}
+
+func TestValidatingHandler(t *testing.T) {
+	tests := []struct {
+		name             string
+		req              admission.Request
+		expectedAllowed  bool
+		expectedCode     int32
+		expectedCauses   []string
+		expectedWarnings []string
+	}{
+		{
+			name:            "valid",
+			req:             request("networking.istio.io", "v1beta1", "VirtualService", `{"spec":{"hosts":["reviews"],"http":[{"route":[{"destination":{"host":"reviews"}}]}]}}`),
+			expectedAllowed: true,
+			expectedCode:    http.StatusOK,
+		},
+		{
+			name:           "invalid",
+			req:            request("security.istio.io", "v1beta1", "AuthorizationPolicy", `{"spec":{"action":"BOGUS","rules":[{}]}}`),
+			expectedCode:   http.StatusUnprocessableEntity,
+			expectedCauses: []string{"spec.action"},
+		},
+		{
+			name:            "deprecated field",
+			req:             request("networking.istio.io", "v1beta1", "VirtualService", `{"spec":{"hosts":["reviews"],"http":[{"route":[{"destination":{"host":"reviews"}}],"mirror":{"host":"reviews-shadow"},"mirror_percent":10}]}}`),
+			expectedAllowed: true,
+			expectedCode:    http.StatusOK,
+			expectedWarnings: []string{
+				"spec.http[0].mirrorPercent is deprecated, use spec.http[0].mirrorPercentage instead",
+				"spec.http[0].mirror_percent is deprecated, use spec.http[0].mirrorPercentage instead",
+			},
+		},
+		{
+			name:            "dry-run",
+			req:             request("security.istio.io", "v1beta1", "AuthorizationPolicy", `{"metadata":{"name":"deny-all","namespace":"foo","annotations":{"istio.io/dry-run":"true"}},"spec":{"action":"DENY","rules":[{}]}}`),
+			expectedAllowed: true,
+			expectedCode:    http.StatusOK,
+			expectedWarnings: []string{
+				"authorization policy foo/deny-all is in dry-run mode: it is evaluated but not enforced",
+			},
+		},
+		{
+			name:           "invalid dry-run",
+			req:            request("security.istio.io", "v1beta1", "AuthorizationPolicy", `{"metadata":{"annotations":{"istio.io/dry-run":"yes"}},"spec":{"action":"DENY","rules":[{}]}}`),
+			expectedCode:   http.StatusUnprocessableEntity,
+			expectedCauses: []string{"metadata.annotations[istio.io/dry-run]"},
+		},
+		{
+			name:            "unknown kind",
+			req:             request("example.com", "v1", "Widget", `{"spec":{}}`),
+			expectedAllowed: true,
+			expectedCode:    http.StatusOK,
+		},
+		{
+			name:            "deletion",
+			req:             request("networking.istio.io", "v1beta1", "VirtualService", ""),
+			expectedAllowed: true,
+			expectedCode:    http.StatusOK,
+		},
+		{
+			name:         "malformed",
+			req:          request("networking.istio.io", "v1beta1", "VirtualService", `{"spec":{"hosts":"reviews"}}`),
+			expectedCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, test := range tests {
+		response := (&ValidatingHandler{}).Handle(context.Background(), test.req)
+		if response.Allowed != test.expectedAllowed {
+			t.Errorf("%s: unexpected allowed %t, expected %t: %v", test.name, response.Allowed, test.expectedAllowed, response.Result)
+		}
+		if response.Result == nil || response.Result.Code != test.expectedCode {
+			t.Errorf("%s: unexpected result %v, expected the code %d", test.name, response.Result, test.expectedCode)
+			continue
+		}
+		if !reflect.DeepEqual(response.Warnings, test.expectedWarnings) {
+			t.Errorf("%s: unexpected warnings %q, expected %q", test.name, response.Warnings, test.expectedWarnings)
+		}
+
+		var causes []string
+		if response.Result.Details != nil {
+			for _, cause := range response.Result.Details.Causes {
+				causes = append(causes, cause.Field)
+			}
+		}
+		if !reflect.DeepEqual(causes, test.expectedCauses) {
+			t.Errorf("%s: unexpected causes %q, expected %q", test.name, causes, test.expectedCauses)
+		}
+	}
+}
@@ -0,0 +1,72 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package workloads finds the workloads Istio resources apply to, e.g. the
+// pods an authorization policy, a sidecar or a peer authentication selects.
+package workloads
+
+import (
+	"context"
+	"reflect"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/banzaicloud/istio-client-go/pkg/selector"
+)
+
+// DefaultRootNamespace is the root namespace of the mesh, unless Istio is
+// configured with another one.
+const DefaultRootNamespace = "istio-system"
+
+// SelectedPods returns the pods the workload selector of the Istio object
+// selects, every pod if it has none. The pods are looked up in the namespace
+// of the object, or in every namespace for the objects of the root namespace
+// of the mesh, which apply mesh-wide, and for the gateways.
+func SelectedPods(ctx context.Context, client kubernetes.Interface, obj runtime.Object, rootNamespace string) ([]corev1.Pod, error) {
+	accessor, err := meta.Accessor(obj)
+	if err != nil {
+		return nil, err
+	}
+	selected, err := selector.ForObject(obj)
+	if err != nil {
+		return nil, err
+	}
+
+	namespace := accessor.GetNamespace()
+	if namespace == rootNamespace || kind(obj) == "Gateway" {
+		namespace = metav1.NamespaceAll
+	}
+	pods, err := client.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: selector.ToLabelsSelector(selected).String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return pods.Items, nil
+}
+
+// kind returns the kind of the object, from its type if its TypeMeta is
+// empty.
+func kind(obj runtime.Object) string {
+	if kind := obj.GetObjectKind().GroupVersionKind().Kind; kind != "" {
+		return kind
+	}
+
+	return reflect.Indirect(reflect.ValueOf(obj)).Type().Name()
+}
@@ -0,0 +1,107 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package workloads
+
+import (
+	"context"
+	"reflect"
+	"sort"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+
+	networkingv1beta1 "github.com/banzaicloud/istio-client-go/pkg/networking/v1beta1"
+	securityv1beta1 "github.com/banzaicloud/istio-client-go/pkg/security/v1beta1"
+	typev1beta1 "github.com/banzaicloud/istio-client-go/pkg/type/v1beta1"
+)
+
+func pod(namespace, name string, labels map[string]string) *corev1.Pod {
+	return &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name, Labels: labels}}
+}
+
+func TestSelectedPods(t *testing.T) {
+	client := fake.NewSimpleClientset(
+		pod("prod", "httpbin", map[string]string{"app": "httpbin"}),
+		pod("prod", "ratings", map[string]string{"app": "ratings"}),
+		pod("test", "httpbin", map[string]string{"app": "httpbin"}),
+		pod("istio-system", "ingressgateway", map[string]string{"istio": "ingressgateway"}),
+	)
+	httpbin := &typev1beta1.WorkloadSelector{MatchLabels: map[string]string{"app": "httpbin"}}
+
+	tests := []struct {
+		name     string
+		obj      runtime.Object
+		expected []string
+	}{
+		{
+			name: "authorization policy",
+			obj: &securityv1beta1.AuthorizationPolicy{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "prod", Name: "httpbin"},
+				Spec:       securityv1beta1.AuthorizationPolicySpec{Selector: httpbin},
+			},
+			expected: []string{"prod/httpbin"},
+		},
+		{
+			name: "mesh-wide authorization policy",
+			obj: &securityv1beta1.AuthorizationPolicy{
+				ObjectMeta: metav1.ObjectMeta{Namespace: DefaultRootNamespace, Name: "httpbin"},
+				Spec:       securityv1beta1.AuthorizationPolicySpec{Selector: httpbin},
+			},
+			expected: []string{"prod/httpbin", "test/httpbin"},
+		},
+		{
+			name:     "namespace-wide peer authentication",
+			obj:      &securityv1beta1.PeerAuthentication{ObjectMeta: metav1.ObjectMeta{Namespace: "prod", Name: "default"}},
+			expected: []string{"prod/httpbin", "prod/ratings"},
+		},
+		{
+			name: "sidecar",
+			obj: &networkingv1beta1.Sidecar{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "prod", Name: "ratings"},
+				Spec: networkingv1beta1.SidecarSpec{
+					WorkloadSelector: &networkingv1beta1.WorkloadSelector{Labels: map[string]string{"app": "ratings"}},
+				},
+			},
+			expected: []string{"prod/ratings"},
+		},
+		{
+			name: "gateway",
+			obj: &networkingv1beta1.Gateway{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "prod", Name: "public"},
+				Spec:       networkingv1beta1.GatewaySpec{Selector: map[string]string{"istio": "ingressgateway"}},
+			},
+			expected: []string{"istio-system/ingressgateway"},
+		},
+	}
+
+	for _, test := range tests {
+		pods, err := SelectedPods(context.Background(), client, test.obj, DefaultRootNamespace)
+		if err != nil {
+			t.Errorf("%s: unexpected error %v", test.name, err)
+			continue
+		}
+		var names []string
+		for _, pod := range pods {
+			names = append(names, pod.Namespace+"/"+pod.Name)
+		}
+		sort.Strings(names)
+		if !reflect.DeepEqual(names, test.expected) {
+			t.Errorf("%s: unexpected pods %v, expected %v", test.name, names, test.expected)
+		}
+	}
+}
@@ -4,8 +4,8 @@ go 1.13
 
 require (
 	github.com/davecgh/go-spew v1.1.1 // indirect
-	github.com/gogo/protobuf v1.3.0 // indirect
-	github.com/google/gofuzz v1.0.0 // indirect
+	github.com/gogo/protobuf v1.3.0
+	github.com/google/gofuzz v1.0.0
 	github.com/json-iterator/go v1.1.6 // indirect
 	github.com/kr/pretty v0.1.0 // indirect
 	github.com/modern-go/concurrent v0.0.0-20180306012644-bacd9c7ef1dd // indirect
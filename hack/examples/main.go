@@ -0,0 +1,146 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Command examples extracts the YAML examples of the doc comments of the API
+// types into the corpus of the fixtures package of the client module, so that
+// the round-trip harness of the corpus checks that the types decode them
+// without losing fields. Run it from the root of the repository:
+//
+//	go run ./hack/examples
+//
+// Every example object becomes a doc-<kind>-<n>.yaml file under the API group
+// and version of the object. Examples which are not whole objects, such as the
+// snippets of a single field, are skipped, and so are the duplicates.
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+const corpus = "client/testing/fixtures/corpus"
+
+// example is an object of a YAML example.
+type example struct {
+	source     string
+	apiVersion string
+	kind       string
+	data       string
+}
+
+func main() {
+	sources, err := filepath.Glob("pkg/*/*/*_types.go")
+	if err != nil {
+		log.Fatal(err)
+	}
+	sort.Strings(sources)
+
+	var examples []example
+	seen := map[string]bool{}
+	for _, source := range sources {
+		blocks, err := yamlBlocks(source)
+		if err != nil {
+			log.Fatal(err)
+		}
+		for _, block := range blocks {
+			for _, doc := range strings.Split(block, "\n---\n") {
+				e := example{source: source, data: strings.TrimSpace(doc) + "\n"}
+				e.apiVersion = topLevelValue(e.data, "apiVersion")
+				e.kind = topLevelValue(e.data, "kind")
+				if e.apiVersion == "" || e.kind == "" || !strings.HasSuffix(strings.SplitN(e.apiVersion, "/", 2)[0], ".istio.io") || seen[e.data] {
+					continue
+				}
+				seen[e.data] = true
+				examples = append(examples, e)
+			}
+		}
+	}
+
+	stale, err := filepath.Glob(filepath.Join(corpus, "*", "*", "doc-*.yaml"))
+	if err != nil {
+		log.Fatal(err)
+	}
+	for _, name := range stale {
+		if err := os.Remove(name); err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	counts := map[string]int{}
+	for _, e := range examples {
+		dir := filepath.Join(corpus, e.apiVersion)
+		key := filepath.Join(dir, strings.ToLower(e.kind))
+		counts[key]++
+		name := fmt.Sprintf("%s/doc-%s-%02d.yaml", dir, strings.ToLower(e.kind), counts[key])
+		header := fmt.Sprintf("# Code generated by hack/examples from the doc comments of %s. DO NOT EDIT.\n", filepath.ToSlash(e.source))
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			log.Fatal(err)
+		}
+		if err := ioutil.WriteFile(name, []byte(header+e.data), 0644); err != nil {
+			log.Fatal(err)
+		}
+	}
+	log.Printf("extracted %d examples", len(examples))
+}
+
+// yamlBlocks returns the ```yaml blocks of the comments of the Go file.
+func yamlBlocks(name string) ([]string, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var blocks []string
+	var block []string
+	inBlock := false
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if !strings.HasPrefix(line, "//") {
+			inBlock = false
+			continue
+		}
+		text := strings.TrimPrefix(strings.TrimPrefix(line, "//"), " ")
+		switch {
+		case !inBlock && strings.HasPrefix(strings.TrimSpace(text), "```yaml"):
+			inBlock = true
+			block = nil
+		case inBlock && strings.HasPrefix(strings.TrimSpace(text), "```"):
+			inBlock = false
+			blocks = append(blocks, strings.Join(block, "\n"))
+		case inBlock:
+			block = append(block, strings.TrimRight(text, " \t"))
+		}
+	}
+
+	return blocks, scanner.Err()
+}
+
+// topLevelValue returns the value of the top level field of the YAML object.
+func topLevelValue(data, field string) string {
+	for _, line := range strings.Split(data, "\n") {
+		if strings.HasPrefix(line, field+":") {
+			return strings.Trim(strings.TrimSpace(strings.TrimPrefix(line, field+":")), `"'`)
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,65 @@
+#!/usr/bin/env bash
+
+# Generates the apply configurations, clientset, listers and informers for the Istio APIs into the client module.
+
+set -o errexit
+set -o nounset
+set -o pipefail
+
+SCRIPT_ROOT=$(cd "$(dirname "${BASH_SOURCE[0]}")/.." && pwd)
+CODEGEN_VERSION=${CODEGEN_VERSION:-v0.22.2}
+
+MODULE=github.com/banzaicloud/istio-client-go
+INPUT_BASE=${MODULE}/pkg
+INPUT_APIS=networking/v1alpha3,networking/v1beta1,security/v1beta1
+INPUT_DIRS=$(echo "${INPUT_APIS}" | tr ',' '\n' | sed "s|^|${INPUT_BASE}/|" | paste -sd, -)
+OUTPUT_PACKAGE=${MODULE}/client
+
+BIN_DIR="${SCRIPT_ROOT}/bin/code-generator-${CODEGEN_VERSION}"
+OUTPUT_BASE=$(mktemp -d)
+trap 'rm -rf "${OUTPUT_BASE}"' EXIT
+
+mkdir -p "${BIN_DIR}"
+for generator in applyconfiguration-gen client-gen lister-gen informer-gen; do
+    if [ ! -x "${BIN_DIR}/${generator}" ]; then
+        GOBIN="${BIN_DIR}" GO111MODULE=on go install "k8s.io/code-generator/cmd/${generator}@${CODEGEN_VERSION}"
+    fi
+done
+
+"${BIN_DIR}/applyconfiguration-gen" \
+    --input-dirs "${INPUT_DIRS}" \
+    --output-package "${OUTPUT_PACKAGE}/applyconfiguration" \
+    --output-base "${OUTPUT_BASE}" \
+    --go-header-file "${SCRIPT_ROOT}/hack/boilerplate.txt"
+
+# client-gen only generates the expansion interfaces of the kinds without a hand written one
+(cd "${SCRIPT_ROOT}/client" && find clientset -name '*_expansion.go' ! -name 'generated_expansion.go') | while read -r file; do
+    mkdir -p "$(dirname "${OUTPUT_BASE}/${OUTPUT_PACKAGE}/${file}")"
+    cp "${SCRIPT_ROOT}/client/${file}" "${OUTPUT_BASE}/${OUTPUT_PACKAGE}/${file}"
+done
+
+"${BIN_DIR}/client-gen" \
+    --clientset-name versioned \
+    --input-base "${INPUT_BASE}" \
+    --input "${INPUT_APIS}" \
+    --output-package "${OUTPUT_PACKAGE}/clientset" \
+    --apply-configuration-package "${OUTPUT_PACKAGE}/applyconfiguration" \
+    --output-base "${OUTPUT_BASE}" \
+    --go-header-file "${SCRIPT_ROOT}/hack/boilerplate.txt"
+
+"${BIN_DIR}/lister-gen" \
+    --input-dirs "${INPUT_DIRS}" \
+    --output-package "${OUTPUT_PACKAGE}/listers" \
+    --output-base "${OUTPUT_BASE}" \
+    --go-header-file "${SCRIPT_ROOT}/hack/boilerplate.txt"
+
+"${BIN_DIR}/informer-gen" \
+    --input-dirs "${INPUT_DIRS}" \
+    --versioned-clientset-package "${OUTPUT_PACKAGE}/clientset/versioned" \
+    --listers-package "${OUTPUT_PACKAGE}/listers" \
+    --output-package "${OUTPUT_PACKAGE}/informers" \
+    --output-base "${OUTPUT_BASE}" \
+    --go-header-file "${SCRIPT_ROOT}/hack/boilerplate.txt"
+
+rm -rf "${SCRIPT_ROOT}/client/applyconfiguration" "${SCRIPT_ROOT}/client/clientset" "${SCRIPT_ROOT}/client/listers" "${SCRIPT_ROOT}/client/informers"
+cp -r "${OUTPUT_BASE}/${OUTPUT_PACKAGE}/." "${SCRIPT_ROOT}/client/"
@@ -0,0 +1,97 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Command v1alpha3 generates the files of the networking.istio.io/v1alpha3
+// package which are the same as the ones of v1beta1, the validation, analysis
+// and manipulation of the types both versions share, so that the two versions
+// cannot drift apart. Run it from the root of the repository:
+//
+//	go run ./hack/v1alpha3
+//
+// Changes go to the v1beta1 files, the v1alpha3 ones are overwritten.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"log"
+	"path/filepath"
+)
+
+const (
+	source = "pkg/networking/v1beta1"
+	target = "pkg/networking/v1alpha3"
+)
+
+// files are the files of the source package which are copied.
+var files = []string{
+	"canary.go",
+	"destinationrule_validation.go",
+	"egress_host.go",
+	"equality.go",
+	"fault.go",
+	"gateway_analysis.go",
+	"headers.go",
+	"host_analysis.go",
+	"normalize.go",
+	"numeric.go",
+	"sidecar_validation.go",
+	"subset_analysis.go",
+	"subsets.go",
+	"virtualservice_deepcopy.go",
+	"virtualservice_defaults.go",
+	"virtualservice_json.go",
+	"virtualservice_validation.go",
+	"weights.go",
+}
+
+func main() {
+	for _, name := range files {
+		if err := generate(name); err != nil {
+			log.Fatal(err)
+		}
+	}
+	log.Printf("generated %d files", len(files))
+}
+
+// generate copies the source file to the target package, with a header
+// telling the file is generated after its license.
+func generate(name string) error {
+	path := filepath.ToSlash(filepath.Join(source, name))
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	clause := []byte("package " + filepath.Base(source) + "\n")
+	i := bytes.Index(data, clause)
+	if i < 0 {
+		return fmt.Errorf("%s has no %q clause", path, bytes.TrimSpace(clause))
+	}
+
+	var out bytes.Buffer
+	out.Write(data[:i])
+	fmt.Fprintf(&out, "// Code generated by hack/v1alpha3 from %s. DO NOT EDIT.\n\n", path)
+	fmt.Fprintf(&out, "package %s\n", filepath.Base(target))
+	out.Write(data[i+len(clause):])
+
+	formatted, err := format.Source(out.Bytes())
+	if err != nil {
+		return fmt.Errorf("could not format the copy of %s: %w", path, err)
+	}
+
+	return ioutil.WriteFile(filepath.Join(target, name), formatted, 0644)
+}
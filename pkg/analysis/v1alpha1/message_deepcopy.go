@@ -0,0 +1,60 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+// DeepCopyInto supports using AnalysisMessageBase within kubernetes types, where deepcopy-gen is used.
+func (in *AnalysisMessageBase) DeepCopyInto(out *AnalysisMessageBase) {
+	*out = *in
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
+		*out = new(AnalysisMessageBase_Type)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AnalysisMessageBase. Required by controller-gen.
+func (in *AnalysisMessageBase) DeepCopy() *AnalysisMessageBase {
+	if in == nil {
+		return nil
+	}
+	out := new(AnalysisMessageBase)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new AnalysisMessageBase. Required by controller-gen.
+func (in *AnalysisMessageBase) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
+// DeepCopyInto supports using AnalysisMessageBase_Type within kubernetes types, where deepcopy-gen is used.
+func (in *AnalysisMessageBase_Type) DeepCopyInto(out *AnalysisMessageBase_Type) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AnalysisMessageBase_Type. Required by controller-gen.
+func (in *AnalysisMessageBase_Type) DeepCopy() *AnalysisMessageBase_Type {
+	if in == nil {
+		return nil
+	}
+	out := new(AnalysisMessageBase_Type)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new AnalysisMessageBase_Type. Required by controller-gen.
+func (in *AnalysisMessageBase_Type) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
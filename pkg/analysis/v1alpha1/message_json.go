@@ -0,0 +1,47 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// MarshalJSON writes the level by its name, the same way Istio does when it
+// reports validation messages in the status of a resource.
+func (x AnalysisMessageBase_Level) MarshalJSON() ([]byte, error) {
+	return json.Marshal(x.String())
+}
+
+// UnmarshalJSON accepts the level either by its name or by its numeric value.
+func (x *AnalysisMessageBase_Level) UnmarshalJSON(data []byte) error {
+	var name string
+	if err := json.Unmarshal(data, &name); err == nil {
+		value, ok := AnalysisMessageBase_Level_value[name]
+		if !ok {
+			return fmt.Errorf("unknown analysis message level %q", name)
+		}
+		*x = AnalysisMessageBase_Level(value)
+		return nil
+	}
+
+	var value int32
+	if err := json.Unmarshal(data, &value); err != nil {
+		return fmt.Errorf("invalid analysis message level %s", string(data))
+	}
+	*x = AnalysisMessageBase_Level(value)
+
+	return nil
+}
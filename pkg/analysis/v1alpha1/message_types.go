@@ -0,0 +1,99 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	"encoding/json"
+	"strconv"
+)
+
+// The values here are chosen so that more severe messages get sorted higher,
+// as well as leaving space in between to add more later
+// +kubebuilder:validation:XIntOrString
+type AnalysisMessageBase_Level int32
+
+const (
+	AnalysisMessageBase_UNKNOWN AnalysisMessageBase_Level = 0
+	AnalysisMessageBase_ERROR   AnalysisMessageBase_Level = 3
+	AnalysisMessageBase_WARNING AnalysisMessageBase_Level = 8
+	AnalysisMessageBase_INFO    AnalysisMessageBase_Level = 12
+)
+
+var AnalysisMessageBase_Level_name = map[int32]string{
+	0:  "UNKNOWN",
+	3:  "ERROR",
+	8:  "WARNING",
+	12: "INFO",
+}
+
+var AnalysisMessageBase_Level_value = map[string]int32{
+	"UNKNOWN": 0,
+	"ERROR":   3,
+	"WARNING": 8,
+	"INFO":    12,
+}
+
+func (x AnalysisMessageBase_Level) String() string {
+	if name, ok := AnalysisMessageBase_Level_name[int32(x)]; ok {
+		return name
+	}
+	return strconv.Itoa(int(x))
+}
+
+// AnalysisMessageBase describes some common information that is needed for all
+// messages. All information should be static with respect to the error code.
+// It mirrors the AnalysisMessageBase message of istio.io/api/analysis/v1alpha1.
+type AnalysisMessageBase struct {
+	Type *AnalysisMessageBase_Type `json:"type,omitempty"`
+	// Represents how severe a message is. Required.
+	Level AnalysisMessageBase_Level `json:"level,omitempty"`
+	// A url pointing to the Istio documentation for this specific error type.
+	// Should be of the form
+	// `^http(s)?://(www\.)?istio.io/docs/reference/config/analysis/`
+	// Required.
+	DocumentationUrl string `json:"documentationUrl,omitempty"`
+}
+
+func (m *AnalysisMessageBase) Reset() { *m = AnalysisMessageBase{} }
+
+// String returns the JSON form of the message.
+func (m *AnalysisMessageBase) String() string { return jsonString(m) }
+
+// A unique identifier for the type of message. Name is intended to be
+// human-readable, code is intended to be machine readable. There should be a
+// one-to-one mapping between name and code. (i.e. do not re-use names or
+// codes between message types.)
+type AnalysisMessageBase_Type struct {
+	// A human-readable name for the message type. e.g. "InternalError",
+	// "PodMissingProxy". This should be the same for all messages of the same type.
+	// Required.
+	Name string `json:"name,omitempty"`
+	// A 7 character code matching `^IST[0-9]{4}$` intended to uniquely identify
+	// the message type. (e.g. "IST0001" is mapped to the "InternalError" message
+	// type.) 0000-0100 are reserved. Required.
+	Code string `json:"code,omitempty"`
+}
+
+func (m *AnalysisMessageBase_Type) Reset() { *m = AnalysisMessageBase_Type{} }
+
+// String returns the JSON form of the message type.
+func (m *AnalysisMessageBase_Type) String() string { return jsonString(m) }
+
+// jsonString returns the JSON form of v, which cannot fail for the message
+// types.
+func jsonString(v interface{}) string {
+	data, _ := json.Marshal(v)
+	return string(data)
+}
@@ -0,0 +1,43 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package apis registers every Istio group version modeled by this module
+// with a single call.
+package apis
+
+import (
+	"k8s.io/apimachinery/pkg/runtime"
+
+	authenticationv1alpha1 "github.com/banzaicloud/istio-client-go/pkg/authentication/v1alpha1"
+	networkingv1alpha3 "github.com/banzaicloud/istio-client-go/pkg/networking/v1alpha3"
+	networkingv1beta1 "github.com/banzaicloud/istio-client-go/pkg/networking/v1beta1"
+	securityv1beta1 "github.com/banzaicloud/istio-client-go/pkg/security/v1beta1"
+	telemetryv1alpha1 "github.com/banzaicloud/istio-client-go/pkg/telemetry/v1alpha1"
+)
+
+// SchemeBuilder collects the scheme builders of all Istio group versions.
+var SchemeBuilder = runtime.SchemeBuilder{
+	authenticationv1alpha1.AddToScheme,
+	networkingv1alpha3.AddToScheme,
+	networkingv1beta1.AddToScheme,
+	securityv1beta1.AddToScheme,
+	telemetryv1alpha1.AddToScheme,
+}
+
+// AddToScheme adds all Istio types to the scheme, for example:
+//
+//   scheme := runtime.NewScheme()
+//   _ = clientgoscheme.AddToScheme(scheme)
+//   _ = apis.AddToScheme(scheme)
+var AddToScheme = SchemeBuilder.AddToScheme
@@ -19,7 +19,9 @@ import (
 )
 
 // +genclient
+// +genclient:nonNamespaced
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:resource:scope=Cluster,categories=istio-io;authentication-istio-io
 // MeshPolicy
 type MeshPolicy struct {
 	metav1.TypeMeta   `json:",inline"`
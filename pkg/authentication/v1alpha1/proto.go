@@ -0,0 +1,45 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/banzaicloud/istio-client-go/pkg/protoconv"
+)
+
+// ToProto converts the spec into out, a *Policy of istio.io/api/authentication/v1alpha1.
+func (s *PolicySpec) ToProto(out proto.Message) error {
+	return protoconv.ToProto(s, out)
+}
+
+// FromProto sets the spec from in, a *Policy of istio.io/api/authentication/v1alpha1.
+func (s *PolicySpec) FromProto(in proto.Message) error {
+	*s = PolicySpec{}
+	return protoconv.FromProto(in, s)
+}
+
+// MarshalProtoJSON encodes the spec the way Istio's own tooling does, through
+// message, an empty *Policy of istio.io/api/authentication/v1alpha1.
+func (s *PolicySpec) MarshalProtoJSON(message proto.Message) ([]byte, error) {
+	return protoconv.Marshal(s, message)
+}
+
+// UnmarshalProtoJSON sets the spec from data, a *Policy of
+// istio.io/api/authentication/v1alpha1 encoded the way Istio's own tooling does.
+func (s *PolicySpec) UnmarshalProtoJSON(data []byte, message proto.Message) error {
+	*s = PolicySpec{}
+	return protoconv.Unmarshal(data, message, s)
+}
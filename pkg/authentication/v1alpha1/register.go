@@ -35,6 +35,14 @@ func Resource(resource string) schema.GroupResource {
 	return SchemeGroupVersion.WithResource(resource).GroupResource()
 }
 
+// GroupVersionKinds and GroupVersionResources of the types in this group version
+var (
+	MeshPolicyGroupVersionKind     = SchemeGroupVersion.WithKind("MeshPolicy")
+	MeshPolicyGroupVersionResource = SchemeGroupVersion.WithResource("meshpolicies")
+	PolicyGroupVersionKind         = SchemeGroupVersion.WithKind("Policy")
+	PolicyGroupVersionResource     = SchemeGroupVersion.WithResource("policies")
+)
+
 var (
 	SchemeBuilder = runtime.NewSchemeBuilder(addKnownTypes)
 	AddToScheme   = SchemeBuilder.AddToScheme
@@ -0,0 +1,338 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package authz simulates the evaluation of authorization policies, so that
+// policy changes can be tested offline before they are rolled out, e.g.
+//
+//	policies := authz.ForWorkload("istio-system", all, "prod", map[string]string{"app": "httpbin"})
+//	result := authz.Evaluate(policies, authz.Request{
+//		Principal: "cluster.local/ns/test/sa/sleep",
+//		Method:    "GET",
+//		Path:      "/status/200",
+//		Port:      8000,
+//	})
+//
+// The policies are evaluated in the order Istio documents: the request is
+// denied if a DENY policy matches it, allowed if there is no ALLOW policy or
+// if an ALLOW policy matches it, and denied otherwise. The CUSTOM policies
+// delegate the decision to an external authorizer, which comes first, so the
+// decision on the requests they match is CUSTOM. AUDIT policies and the policies in dry-run mode never change the
+// decision; the latter are reported when they would have.
+package authz
+
+import (
+	"net"
+	"strconv"
+	"strings"
+
+	securityv1beta1 "github.com/banzaicloud/istio-client-go/pkg/security/v1beta1"
+	"github.com/banzaicloud/istio-client-go/pkg/selector"
+)
+
+// Decision is the decision on a request.
+type Decision string
+
+const (
+	// Allow is the decision to let the request through
+	Allow Decision = "ALLOW"
+	// Deny is the decision to reject the request
+	Deny Decision = "DENY"
+	// Custom is the decision delegated to the external authorizer of a
+	// CUSTOM policy
+	Custom Decision = "CUSTOM"
+)
+
+// Request are the attributes of a request the policies match.
+type Request struct {
+	// Principal is the peer identity of the source, e.g.
+	// cluster.local/ns/test/sa/sleep, empty without mutual TLS
+	Principal string
+	// RequestPrincipal is the iss/sub identity of the request
+	// authentication, empty without a valid JWT
+	RequestPrincipal string
+	// Claims are the claims of the JWT of the request
+	Claims map[string][]string
+	// Namespace is the namespace of the source, empty without mutual TLS
+	Namespace string
+	// IP is the IP address of the source
+	IP string
+	// Host is the host, or authority, of the request
+	Host string
+	// Method is the HTTP method of the request
+	Method string
+	// Path is the path of the request, without the query string
+	Path string
+	// Headers are the headers of the request
+	Headers map[string]string
+	// Port is the destination port of the request
+	Port uint32
+}
+
+// Match is a rule of a policy matching a request.
+type Match struct {
+	// Policy is the policy of the rule
+	Policy *securityv1beta1.AuthorizationPolicy
+	// Rule is the index of the rule in the rules of the policy
+	Rule int
+}
+
+// Result is the result of the evaluation of a request.
+type Result struct {
+	// Decision is the decision on the request
+	Decision Decision
+	// Match is the rule the decision comes from, with a nil policy if no
+	// rule decided, i.e. the request was allowed without any ALLOW policy, or
+	// denied since no ALLOW policy matched it
+	Match Match
+	// DryRun are the rules of the policies in dry-run mode which matched the
+	// request
+	DryRun []Match
+}
+
+// Evaluate returns the decision of the policies on the request. The policies
+// are expected to be those applying to the destination workload, see
+// ForWorkload.
+func Evaluate(policies []*securityv1beta1.AuthorizationPolicy, req Request) Result {
+	var result Result
+	matches := map[securityv1beta1.AuthorizationPolicyAction][]Match{}
+	hasAllow := false
+	for _, policy := range policies {
+		if policy == nil {
+			continue
+		}
+		action := policy.Spec.Action
+		if action == "" {
+			action = securityv1beta1.AuthorizationPolicyActionAllow
+		}
+		dryRun := policy.IsDryRun()
+		if action == securityv1beta1.AuthorizationPolicyActionAllow && !dryRun {
+			hasAllow = true
+		}
+		for i, rule := range policy.Spec.Rules {
+			if rule == nil || !matchRule(rule, req) {
+				continue
+			}
+			match := Match{Policy: policy, Rule: i}
+			if dryRun {
+				result.DryRun = append(result.DryRun, match)
+			} else {
+				matches[action] = append(matches[action], match)
+			}
+			break
+		}
+	}
+
+	switch {
+	case len(matches[securityv1beta1.AuthorizationPolicyActionCustom]) > 0:
+		result.Decision, result.Match = Custom, matches[securityv1beta1.AuthorizationPolicyActionCustom][0]
+	case len(matches[securityv1beta1.AuthorizationPolicyActionDeny]) > 0:
+		result.Decision, result.Match = Deny, matches[securityv1beta1.AuthorizationPolicyActionDeny][0]
+	case len(matches[securityv1beta1.AuthorizationPolicyActionAllow]) > 0:
+		result.Decision, result.Match = Allow, matches[securityv1beta1.AuthorizationPolicyActionAllow][0]
+	case hasAllow:
+		result.Decision = Deny
+	default:
+		result.Decision = Allow
+	}
+
+	return result
+}
+
+// ForWorkload returns the policies applying to the workload of the namespace
+// with the labels: the policies of its namespace and of the root namespace
+// without a selector or with a selector selecting it.
+func ForWorkload(rootNamespace string, policies []*securityv1beta1.AuthorizationPolicy, namespace string, labels map[string]string) []*securityv1beta1.AuthorizationPolicy {
+	var applying []*securityv1beta1.AuthorizationPolicy
+	for _, policy := range policies {
+		if policy == nil || (policy.Namespace != namespace && policy.Namespace != rootNamespace) {
+			continue
+		}
+		if selector.Matches(policy.Spec.Selector, labels) {
+			applying = append(applying, policy)
+		}
+	}
+
+	return applying
+}
+
+// matchRule returns true if a source, an operation and every condition of the
+// rule match the request. A rule without sources or operations matches any.
+func matchRule(rule *securityv1beta1.Rule, req Request) bool {
+	if len(rule.From) > 0 {
+		matched := false
+		for _, from := range rule.From {
+			if from != nil && matchSource(from.Source, req) {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return false
+		}
+	}
+	if len(rule.To) > 0 {
+		matched := false
+		for _, to := range rule.To {
+			if to != nil && matchOperation(to.Operation, req) {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return false
+		}
+	}
+	for _, condition := range rule.When {
+		if condition != nil && !matchCondition(condition, req) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func matchSource(s *securityv1beta1.Source, req Request) bool {
+	if s == nil {
+		return true
+	}
+
+	return matchValues(s.Principals, s.NotPrincipals, req.Principal, matchString) &&
+		matchValues(s.RequestPrincipals, s.NotRequestPrincipals, req.RequestPrincipal, matchString) &&
+		matchValues(s.Namespaces, s.NotNamespaces, req.Namespace, matchString) &&
+		matchValues(s.IPBlocks, s.NotIPBlocks, req.IP, matchIP)
+}
+
+func matchOperation(o *securityv1beta1.Operation, req Request) bool {
+	if o == nil {
+		return true
+	}
+
+	return matchValues(o.Hosts, o.NotHosts, req.Host, matchHost) &&
+		matchValues(o.Ports, o.NotPorts, port(req.Port), matchString) &&
+		matchValues(o.Methods, o.NotMethods, req.Method, matchString) &&
+		matchValues(o.Paths, o.NotPaths, req.Path, matchString)
+}
+
+// matchCondition returns true if the attribute of the key of the condition
+// matches. Conditions on the attributes a Request does not model never match.
+func matchCondition(c *securityv1beta1.Condition, req Request) bool {
+	key := c.Key
+	switch {
+	case key == "source.ip" || key == "remote.ip":
+		return matchValues(c.Values, c.NotValues, req.IP, matchIP)
+	case key == "source.namespace":
+		return matchValues(c.Values, c.NotValues, req.Namespace, matchString)
+	case key == "source.principal":
+		return matchValues(c.Values, c.NotValues, req.Principal, matchString)
+	case key == "request.auth.principal":
+		return matchValues(c.Values, c.NotValues, req.RequestPrincipal, matchString)
+	case key == "destination.port":
+		return matchValues(c.Values, c.NotValues, port(req.Port), matchString)
+	case strings.HasPrefix(key, "request.headers[") && strings.HasSuffix(key, "]"):
+		name := key[len("request.headers[") : len(key)-1]
+		for header, value := range req.Headers {
+			if strings.EqualFold(header, name) {
+				return matchValues(c.Values, c.NotValues, value, matchString)
+			}
+		}
+		return matchValues(c.Values, c.NotValues, "", matchString)
+	case strings.HasPrefix(key, "request.auth.claims[") && strings.HasSuffix(key, "]"):
+		claims := req.Claims[key[len("request.auth.claims["):len(key)-1]]
+		if len(c.NotValues) > 0 {
+			for _, claim := range claims {
+				if matchAny(c.NotValues, claim, matchString) {
+					return false
+				}
+			}
+		}
+		if len(c.Values) == 0 {
+			return true
+		}
+		for _, claim := range claims {
+			if matchAny(c.Values, claim, matchString) {
+				return true
+			}
+		}
+		return false
+	default:
+		return false
+	}
+}
+
+// matchValues returns true if the value matches one of the values, if any,
+// and none of the negative values.
+func matchValues(values, notValues []string, value string, match func(pattern, value string) bool) bool {
+	if len(values) > 0 && !matchAny(values, value, match) {
+		return false
+	}
+
+	return !matchAny(notValues, value, match)
+}
+
+func matchAny(patterns []string, value string, match func(pattern, value string) bool) bool {
+	for _, pattern := range patterns {
+		if match(pattern, value) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// matchString returns true if the value matches the pattern, which is either
+// exact, a prefix ending in *, a suffix starting with * or * matching any
+// non-empty value.
+func matchString(pattern, value string) bool {
+	switch {
+	case pattern == "*":
+		return value != ""
+	case strings.HasPrefix(pattern, "*"):
+		return strings.HasSuffix(value, pattern[1:])
+	case strings.HasSuffix(pattern, "*"):
+		return strings.HasPrefix(value, pattern[:len(pattern)-1])
+	default:
+		return value == pattern
+	}
+}
+
+// matchHost matches the host case insensitively, ignoring its port.
+func matchHost(pattern, value string) bool {
+	if h, _, err := net.SplitHostPort(value); err == nil {
+		value = h
+	}
+
+	return matchString(strings.ToLower(pattern), strings.ToLower(value))
+}
+
+// matchIP returns true if the IP address is the one of the pattern, or in
+// its CIDR range.
+func matchIP(pattern, value string) bool {
+	ip := net.ParseIP(value)
+	if ip == nil {
+		return false
+	}
+	if _, cidr, err := net.ParseCIDR(pattern); err == nil {
+		return cidr.Contains(ip)
+	}
+
+	return ip.Equal(net.ParseIP(pattern))
+}
+
+func port(p uint32) string {
+	if p == 0 {
+		return ""
+	}
+
+	return strconv.FormatUint(uint64(p), 10)
+}
@@ -0,0 +1,194 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package authz
+
+import (
+	"reflect"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	securityv1beta1 "github.com/banzaicloud/istio-client-go/pkg/security/v1beta1"
+	typev1beta1 "github.com/banzaicloud/istio-client-go/pkg/type/v1beta1"
+)
+
+func policy(name string, action securityv1beta1.AuthorizationPolicyAction, rules ...*securityv1beta1.Rule) *securityv1beta1.AuthorizationPolicy {
+	return &securityv1beta1.AuthorizationPolicy{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "prod", Name: name},
+		Spec:       securityv1beta1.AuthorizationPolicySpec{Action: action, Rules: rules},
+	}
+}
+
+func TestEvaluate(t *testing.T) {
+	allowGet := policy("allow-get", securityv1beta1.AuthorizationPolicyActionAllow,
+		&securityv1beta1.Rule{
+			From: []*securityv1beta1.RuleFrom{{Source: &securityv1beta1.Source{Namespaces: []string{"admin"}}}},
+		},
+		&securityv1beta1.Rule{
+			From: []*securityv1beta1.RuleFrom{{Source: &securityv1beta1.Source{Principals: []string{"cluster.local/ns/test/*"}}}},
+			To:   []*securityv1beta1.RuleTo{{Operation: &securityv1beta1.Operation{Methods: []string{"GET"}, Paths: []string{"/status/*"}}}},
+		},
+	)
+	denyAdmin := policy("deny-admin", securityv1beta1.AuthorizationPolicyActionDeny, &securityv1beta1.Rule{
+		To:   []*securityv1beta1.RuleTo{{Operation: &securityv1beta1.Operation{Paths: []string{"/admin*"}}}},
+		When: []*securityv1beta1.Condition{{Key: "request.headers[X-Admin]", NotValues: []string{"true"}}},
+	})
+	denyIPs := policy("deny-ips", securityv1beta1.AuthorizationPolicyActionDeny, &securityv1beta1.Rule{
+		From: []*securityv1beta1.RuleFrom{{Source: &securityv1beta1.Source{IPBlocks: []string{"10.0.0.0/8"}, NotIPBlocks: []string{"10.0.0.1"}}}},
+	})
+	dryRunDeny := policy("dry-run", securityv1beta1.AuthorizationPolicyActionDeny, &securityv1beta1.Rule{})
+	dryRunDeny.SetDryRun(true)
+	allowNothing := policy("allow-nothing", "")
+	custom := policy("ext-authz", securityv1beta1.AuthorizationPolicyActionCustom, &securityv1beta1.Rule{
+		To: []*securityv1beta1.RuleTo{{Operation: &securityv1beta1.Operation{Ports: []string{"9000"}}}},
+	})
+	audit := policy("audit", securityv1beta1.AuthorizationPolicyActionAudit, &securityv1beta1.Rule{})
+	jwt := policy("jwt", securityv1beta1.AuthorizationPolicyActionAllow, &securityv1beta1.Rule{
+		When: []*securityv1beta1.Condition{
+			{Key: "request.auth.claims[groups]", Values: []string{"admins"}},
+			{Key: "destination.port", Values: []string{"8000"}},
+		},
+	})
+
+	sleep := Request{Principal: "cluster.local/ns/test/sa/sleep", Namespace: "test", IP: "10.0.0.1", Method: "GET", Path: "/status/200", Port: 8000}
+	admin := sleep
+	admin.Path = "/admin/users"
+	adminHeader := admin
+	adminHeader.Headers = map[string]string{"x-admin": "true"}
+	post := sleep
+	post.Method = "POST"
+	otherIP := sleep
+	otherIP.IP = "10.1.2.3"
+	fromAdmin := post
+	fromAdmin.Namespace = "admin"
+	extAuthz := sleep
+	extAuthz.Port = 9000
+	claims := sleep
+	claims.Claims = map[string][]string{"groups": {"users", "admins"}}
+
+	tests := []struct {
+		name     string
+		policies []*securityv1beta1.AuthorizationPolicy
+		request  Request
+		expected Result
+	}{
+		{
+			name:     "no policy",
+			request:  sleep,
+			expected: Result{Decision: Allow},
+		},
+		{
+			name:     "allowed",
+			policies: []*securityv1beta1.AuthorizationPolicy{denyAdmin, allowGet},
+			request:  sleep,
+			expected: Result{Decision: Allow, Match: Match{Policy: allowGet, Rule: 1}},
+		},
+		{
+			name:     "first rule",
+			policies: []*securityv1beta1.AuthorizationPolicy{allowGet},
+			request:  fromAdmin,
+			expected: Result{Decision: Allow, Match: Match{Policy: allowGet, Rule: 0}},
+		},
+		{
+			name:     "no allow policy matching",
+			policies: []*securityv1beta1.AuthorizationPolicy{allowGet},
+			request:  post,
+			expected: Result{Decision: Deny},
+		},
+		{
+			name:     "denied before allowed",
+			policies: []*securityv1beta1.AuthorizationPolicy{allowGet, denyAdmin},
+			request:  admin,
+			expected: Result{Decision: Deny, Match: Match{Policy: denyAdmin}},
+		},
+		{
+			name:     "condition on a header",
+			policies: []*securityv1beta1.AuthorizationPolicy{denyAdmin},
+			request:  adminHeader,
+			expected: Result{Decision: Allow},
+		},
+		{
+			name:     "ip blocks",
+			policies: []*securityv1beta1.AuthorizationPolicy{denyIPs},
+			request:  otherIP,
+			expected: Result{Decision: Deny, Match: Match{Policy: denyIPs}},
+		},
+		{
+			name:     "negative ip blocks",
+			policies: []*securityv1beta1.AuthorizationPolicy{denyIPs},
+			request:  sleep,
+			expected: Result{Decision: Allow},
+		},
+		{
+			name:     "dry run",
+			policies: []*securityv1beta1.AuthorizationPolicy{dryRunDeny, audit},
+			request:  sleep,
+			expected: Result{Decision: Allow, DryRun: []Match{{Policy: dryRunDeny}}},
+		},
+		{
+			name:     "allow nothing",
+			policies: []*securityv1beta1.AuthorizationPolicy{allowNothing},
+			request:  sleep,
+			expected: Result{Decision: Deny},
+		},
+		{
+			name:     "custom",
+			policies: []*securityv1beta1.AuthorizationPolicy{denyIPs, custom},
+			request:  extAuthz,
+			expected: Result{Decision: Custom, Match: Match{Policy: custom}},
+		},
+		{
+			name:     "claims",
+			policies: []*securityv1beta1.AuthorizationPolicy{jwt},
+			request:  claims,
+			expected: Result{Decision: Allow, Match: Match{Policy: jwt}},
+		},
+		{
+			name:     "missing claims",
+			policies: []*securityv1beta1.AuthorizationPolicy{jwt},
+			request:  sleep,
+			expected: Result{Decision: Deny},
+		},
+	}
+
+	for _, test := range tests {
+		if result := Evaluate(test.policies, test.request); !reflect.DeepEqual(result, test.expected) {
+			t.Errorf("%s: unexpected result %+v, expected %+v", test.name, result, test.expected)
+		}
+	}
+}
+
+func TestForWorkload(t *testing.T) {
+	mesh := &securityv1beta1.AuthorizationPolicy{ObjectMeta: metav1.ObjectMeta{Namespace: "istio-system", Name: "mesh"}}
+	meshSelector := &securityv1beta1.AuthorizationPolicy{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "istio-system", Name: "httpbin"},
+		Spec: securityv1beta1.AuthorizationPolicySpec{
+			Selector: &typev1beta1.WorkloadSelector{MatchLabels: map[string]string{"app": "httpbin"}},
+		},
+	}
+	ratings := &securityv1beta1.AuthorizationPolicy{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "prod", Name: "ratings"},
+		Spec: securityv1beta1.AuthorizationPolicySpec{
+			Selector: &typev1beta1.WorkloadSelector{MatchLabels: map[string]string{"app": "ratings"}},
+		},
+	}
+	namespace := &securityv1beta1.AuthorizationPolicy{ObjectMeta: metav1.ObjectMeta{Namespace: "prod", Name: "default"}}
+	other := &securityv1beta1.AuthorizationPolicy{ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "default"}}
+
+	policies := ForWorkload("istio-system", []*securityv1beta1.AuthorizationPolicy{mesh, meshSelector, ratings, namespace, other}, "prod", map[string]string{"app": "httpbin"})
+	if expected := []*securityv1beta1.AuthorizationPolicy{mesh, meshSelector, namespace}; !reflect.DeepEqual(policies, expected) {
+		t.Errorf("unexpected policies %v", policies)
+	}
+}
@@ -0,0 +1,47 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+// Deprecation describes a deprecated field found while decoding a resource.
+type Deprecation struct {
+	// Path is the path of the field in the resource, e.g.
+	// spec.http[0].mirror_percent
+	Path string
+	// Type is the name of the type the field belongs to, e.g. HTTPRoute
+	Type string
+	// Field is the deprecated name of the field, e.g. mirror_percent
+	Field string
+	// Replacement is the field to use instead, e.g. mirrorPercentage
+	Replacement string
+}
+
+// DeprecatedFields is implemented by the types that decode deprecated field
+// names, so that strict decoders accept the ones they do not model and tools
+// can point them out.
+type DeprecatedFields interface {
+	// DeprecatedFields returns the fields to use instead of the deprecated
+	// JSON field names of the type, by name, whether it models them or not.
+	DeprecatedFields() map[string]string
+}
+
+// Migrator is implemented by the resources that can move the values of their
+// deprecated fields to the replacements, e.g. mirrorPercent to
+// mirrorPercentage, so that the resources encoded again are current for
+// Istio.
+type Migrator interface {
+	// MigrateDeprecatedFields moves the values of the deprecated fields, and
+	// returns true if any field was migrated.
+	MigrateDeprecatedFields() bool
+}
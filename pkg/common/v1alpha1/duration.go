@@ -0,0 +1,99 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// MinDuration is the shortest duration Istio accepts.
+const MinDuration = time.Millisecond
+
+// Duration is a duration encoded as a Go duration string, e.g. 1m30s, the way
+// metav1.Duration is. The strings of seconds protobuf encodes durations as in
+// JSON, e.g. 90s or 1.5s, are valid duration strings, so the manifests of
+// istioctl and the Istio API are decoded too, and decoding fails on strings
+// that are not durations.
+// +kubebuilder:validation:Type=string
+type Duration struct {
+	time.Duration `json:"-"`
+}
+
+// NewDuration returns a Duration of d.
+func NewDuration(d time.Duration) *Duration {
+	return &Duration{Duration: d}
+}
+
+// ParseDuration parses a duration string, see time.ParseDuration.
+func ParseDuration(s string) (Duration, error) {
+	d, err := time.ParseDuration(s)
+	if err != nil {
+		return Duration{}, fmt.Errorf("invalid duration %q: %w", s, err)
+	}
+
+	return Duration{Duration: d}, nil
+}
+
+// Validate returns an error if the duration is shorter than MinDuration.
+func (d Duration) Validate() error {
+	if d.Duration < MinDuration {
+		return fmt.Errorf("duration %s must be at least %s", d.Duration, MinDuration)
+	}
+
+	return nil
+}
+
+// MarshalJSON writes the duration string, e.g. 1m30s.
+func (d Duration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.Duration.String())
+}
+
+// UnmarshalJSON parses the duration string.
+func (d *Duration) UnmarshalJSON(data []byte) error {
+	s, ok := "", false
+	if fastJSONDecoding {
+		s, ok = unquoteSimpleString(data)
+	}
+	if !ok {
+		if err := json.Unmarshal(data, &s); err != nil {
+			return err
+		}
+	}
+
+	parsed, err := ParseDuration(s)
+	if err != nil {
+		return err
+	}
+	*d = parsed
+
+	return nil
+}
+
+// DeepCopyInto copies the receiver into out.
+func (d *Duration) DeepCopyInto(out *Duration) {
+	*out = *d
+}
+
+// DeepCopy copies the receiver into a new Duration.
+func (d *Duration) DeepCopy() *Duration {
+	if d == nil {
+		return nil
+	}
+	out := new(Duration)
+	d.DeepCopyInto(out)
+	return out
+}
@@ -0,0 +1,104 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestDurationJSON(t *testing.T) {
+	var value struct {
+		Timeout *Duration `json:"timeout"`
+	}
+	if err := json.Unmarshal([]byte(`{"timeout":"1m30s"}`), &value); err != nil {
+		t.Fatal(err)
+	}
+	if value.Timeout.Duration != 90*time.Second {
+		t.Fatalf("unexpected duration %s", value.Timeout)
+	}
+
+	data, err := json.Marshal(value)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := `{"timeout":"1m30s"}`; string(data) != expected {
+		t.Fatalf("unexpected output %s, expected %s", data, expected)
+	}
+
+	if err := json.Unmarshal([]byte(`{"timeout":"5 seconds"}`), &value); err == nil {
+		t.Fatal("expected an error decoding an invalid duration")
+	}
+}
+
+func TestDurationRoundTrip(t *testing.T) {
+	tests := []struct {
+		input    string
+		duration time.Duration
+		expected string
+	}{
+		{input: `"0s"`, duration: 0, expected: `"0s"`},
+		{input: `"1m30s"`, duration: 90 * time.Second, expected: `"1m30s"`},
+		{input: `"90s"`, duration: 90 * time.Second, expected: `"1m30s"`},
+		{input: `"500ms"`, duration: 500 * time.Millisecond, expected: `"500ms"`},
+		{input: `"0.5s"`, duration: 500 * time.Millisecond, expected: `"500ms"`},
+		{input: `"1m0.25s"`, duration: time.Minute + 250*time.Millisecond, expected: `"1m0.25s"`},
+		{input: `"60.25s"`, duration: time.Minute + 250*time.Millisecond, expected: `"1m0.25s"`},
+		{input: `"0.000000001s"`, duration: time.Nanosecond, expected: `"1ns"`},
+		{input: `"-1.5s"`, duration: -1500 * time.Millisecond, expected: `"-1.5s"`},
+		{input: `"2540400h0m0s"`, duration: 2540400 * time.Hour, expected: `"2540400h0m0s"`},
+		{input: `"9145440000s"`, duration: 2540400 * time.Hour, expected: `"2540400h0m0s"`},
+	}
+
+	for _, test := range tests {
+		decoded := &Duration{}
+		if err := json.Unmarshal([]byte(test.input), decoded); err != nil {
+			t.Errorf("%s: unexpected error %v", test.input, err)
+			continue
+		}
+		if decoded.Duration != test.duration {
+			t.Errorf("%s: unexpected duration %s, expected %s", test.input, decoded.Duration, test.duration)
+		}
+
+		data, err := json.Marshal(decoded)
+		if err != nil {
+			t.Errorf("%s: unexpected error %v", test.input, err)
+			continue
+		}
+		if string(data) != test.expected {
+			t.Errorf("%s: unexpected output %s, expected %s", test.input, data, test.expected)
+		}
+
+		// the output is decoded to the same duration again
+		again := &Duration{}
+		if err := json.Unmarshal(data, again); err != nil {
+			t.Errorf("%s: unexpected error %v", test.input, err)
+			continue
+		}
+		if again.Duration != test.duration {
+			t.Errorf("%s: unexpected round trip to %s", test.input, again.Duration)
+		}
+	}
+}
+
+func TestDurationValidate(t *testing.T) {
+	if err := NewDuration(time.Millisecond).Validate(); err != nil {
+		t.Fatal(err)
+	}
+	if err := NewDuration(time.Microsecond).Validate(); err == nil {
+		t.Fatal("expected an error validating a duration shorter than a millisecond")
+	}
+}
@@ -0,0 +1,107 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+// objectScanner reads the fields of a JSON object of strings in the simple
+// form handled here.
+type objectScanner struct {
+	data   []byte
+	i      int
+	opened bool
+	fields int
+}
+
+// next returns the next field of the object. done is true once the object
+// is over, and ok is false if the data is not an object in the simple form.
+func (s *objectScanner) next() (key []byte, value string, done, ok bool) {
+	s.i = skipSpace(s.data, s.i)
+	if s.i >= len(s.data) {
+		return nil, "", false, false
+	}
+	switch c := s.data[s.i]; {
+	case !s.opened && c == '{':
+		s.opened = true
+		s.i = skipSpace(s.data, s.i+1)
+		if s.i < len(s.data) && s.data[s.i] == '}' {
+			return nil, "", true, skipSpace(s.data, s.i+1) == len(s.data)
+		}
+	case s.fields > 0 && c == ',':
+		s.i = skipSpace(s.data, s.i+1)
+	case s.fields > 0 && c == '}':
+		return nil, "", true, skipSpace(s.data, s.i+1) == len(s.data)
+	default:
+		return nil, "", false, false
+	}
+
+	if key, s.i, ok = scanSimpleBytes(s.data, s.i); !ok {
+		return nil, "", false, false
+	}
+	s.i = skipSpace(s.data, s.i)
+	if s.i >= len(s.data) || s.data[s.i] != ':' {
+		return nil, "", false, false
+	}
+	value, s.i, ok = scanSimpleString(s.data, skipSpace(s.data, s.i+1))
+	s.fields++
+
+	return key, value, false, ok
+}
+
+// unquoteSimpleString returns the JSON string the data consists of, and
+// false if it is not a string in the simple form handled here.
+func unquoteSimpleString(data []byte) (string, bool) {
+	s, next, ok := scanSimpleString(data, 0)
+	return s, ok && next == len(data)
+}
+
+// scanSimpleString returns the JSON string starting at i, and the index
+// following it. Only printable ASCII strings without escapes are handled.
+func scanSimpleString(data []byte, i int) (string, int, bool) {
+	b, next, ok := scanSimpleBytes(data, i)
+	if !ok {
+		return "", i, false
+	}
+
+	return string(b), next, true
+}
+
+// scanSimpleBytes works like scanSimpleString, without copying the string.
+func scanSimpleBytes(data []byte, i int) ([]byte, int, bool) {
+	if i >= len(data) || data[i] != '"' {
+		return nil, i, false
+	}
+	for j := i + 1; j < len(data); j++ {
+		switch c := data[j]; {
+		case c == '"':
+			return data[i+1 : j], j + 1, true
+		case c == '\\' || c < 0x20 || c > 0x7e:
+			return nil, i, false
+		}
+	}
+
+	return nil, i, false
+}
+
+func skipSpace(data []byte, i int) int {
+	for i < len(data) {
+		switch data[i] {
+		case ' ', '\t', '\n', '\r':
+			i++
+		default:
+			return i
+		}
+	}
+
+	return i
+}
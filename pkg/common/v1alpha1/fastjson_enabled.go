@@ -0,0 +1,27 @@
+//go:build fastjson
+// +build fastjson
+
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+// fastJSONDecoding enables the hand written decoders of the types decoded
+// the most often while decoding virtual services and destination rules,
+// such as StringMatch and Duration. They skip the reflection of
+// encoding/json for the common forms of these types, ASCII strings without
+// escapes, and fall back to it for any other form, so the results are the
+// same either way. They are enabled by building with the fastjson tag, e.g.
+// go build -tags fastjson.
+const fastJSONDecoding = true
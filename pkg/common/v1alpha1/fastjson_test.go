@@ -0,0 +1,76 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestFastJSONDecoding(t *testing.T) {
+	matches := []struct {
+		data   string
+		simple bool
+	}{
+		{data: `{"prefix":"/api"}`, simple: true},
+		{data: ` { "exact" : "/api" } `, simple: true},
+		{data: `{}`, simple: true},
+		{data: `{"regex":"/api/.*","regex":"/v2/.*"}`, simple: true},
+		{data: `{"exact":"/api","regex":"/api/.*"}`, simple: true},
+		{data: `{"Prefix":"/api"}`},
+		{data: `{"prefix":"/api","unknown":1}`},
+		{data: `{"prefix":"/ápi"}`},
+		{data: `{"prefix":"\/api"}`},
+		{data: `{"prefix":null}`},
+		{data: `{"prefix":"/api",}`},
+		{data: `{"prefix":"/api"`},
+		{data: `"/api"`},
+	}
+	for _, test := range matches {
+		type stringMatch StringMatch
+		var slow stringMatch
+		slowErr := json.Unmarshal([]byte(test.data), &slow)
+		fast, ok := decodeSimpleStringMatch([]byte(test.data))
+		if ok != test.simple {
+			t.Errorf("%s: unexpected simple form %t", test.data, ok)
+		}
+		if ok && (slowErr != nil || StringMatch(slow) != fast) {
+			t.Errorf("%s: expected %+v (%v), got %+v", test.data, slow, slowErr, fast)
+		}
+	}
+
+	durations := []struct {
+		data   string
+		simple bool
+	}{
+		{data: `"5s"`, simple: true},
+		{data: `"1h2m"`, simple: true},
+		{data: `"1ms" `},
+		{data: `5`},
+		{data: `null`},
+		{data: `"5s`},
+	}
+	for _, test := range durations {
+		var slow string
+		slowErr := json.Unmarshal([]byte(test.data), &slow)
+		fast, ok := unquoteSimpleString([]byte(test.data))
+		if ok != test.simple {
+			t.Errorf("%s: unexpected simple form %t", test.data, ok)
+		}
+		if ok && (slowErr != nil || slow != fast) {
+			t.Errorf("%s: expected %q (%v), got %q", test.data, slow, slowErr, fast)
+		}
+	}
+}
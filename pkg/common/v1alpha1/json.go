@@ -0,0 +1,88 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// JSON holds a free-form JSON object, such as the Envoy configuration of an
+// EnvoyFilter patch, that Istio passes on without interpreting it. The raw
+// value round-trips unchanged and unknown fields are preserved by the API
+// server.
+// +kubebuilder:validation:Type=object
+// +kubebuilder:pruning:PreserveUnknownFields
+type JSON struct {
+	Raw []byte `json:"-"`
+}
+
+// NewJSON marshals the value into a JSON.
+func NewJSON(value interface{}) (*JSON, error) {
+	raw, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+
+	return &JSON{Raw: raw}, nil
+}
+
+// Unmarshal decodes the raw value into out.
+func (j *JSON) Unmarshal(out interface{}) error {
+	if j == nil || len(j.Raw) == 0 {
+		return nil
+	}
+
+	return json.Unmarshal(j.Raw, out)
+}
+
+// MarshalJSON writes the raw value, or null if it is empty.
+func (j JSON) MarshalJSON() ([]byte, error) {
+	if len(j.Raw) == 0 {
+		return []byte("null"), nil
+	}
+
+	return j.Raw, nil
+}
+
+// UnmarshalJSON keeps a copy of the raw value.
+func (j *JSON) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		j.Raw = nil
+		return nil
+	}
+	j.Raw = append([]byte(nil), data...)
+
+	return nil
+}
+
+// DeepCopyInto copies the receiver into out.
+func (j *JSON) DeepCopyInto(out *JSON) {
+	*out = *j
+	if j.Raw != nil {
+		out.Raw = make([]byte, len(j.Raw))
+		copy(out.Raw, j.Raw)
+	}
+}
+
+// DeepCopy copies the receiver into a new JSON.
+func (j *JSON) DeepCopy() *JSON {
+	if j == nil {
+		return nil
+	}
+	out := new(JSON)
+	j.DeepCopyInto(out)
+	return out
+}
@@ -0,0 +1,75 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+type holder struct {
+	Value *JSON `json:"value,omitempty"`
+}
+
+func TestJSONRoundTrip(t *testing.T) {
+	in := `{"value":{"name":"envoy.filters.http.lua","typed_config":{"@type":"type.googleapis.com/envoy.extensions.filters.http.lua.v3.Lua","inlineCode":"function envoy_on_request(handle) end"}}}`
+
+	var h holder
+	if err := json.Unmarshal([]byte(in), &h); err != nil {
+		t.Fatal(err)
+	}
+
+	out, err := json.Marshal(h)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(out) != in {
+		t.Fatalf("unexpected round trip result:\n%s\n!=\n%s", out, in)
+	}
+
+	var value struct {
+		Name string `json:"name"`
+	}
+	if err := h.Value.Unmarshal(&value); err != nil {
+		t.Fatal(err)
+	}
+	if value.Name != "envoy.filters.http.lua" {
+		t.Fatalf("unexpected name %q", value.Name)
+	}
+
+	c := h.Value.DeepCopy()
+	c.Raw[2] = 'X'
+	if h.Value.Raw[2] == 'X' {
+		t.Fatal("modifying the copy changed the original")
+	}
+}
+
+func TestJSONNull(t *testing.T) {
+	var h holder
+	if err := json.Unmarshal([]byte(`{"value":null}`), &h); err != nil {
+		t.Fatal(err)
+	}
+	if h.Value != nil {
+		t.Fatalf("expected no value, got %s", h.Value.Raw)
+	}
+
+	out, err := json.Marshal(JSON{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(out) != "null" {
+		t.Fatalf("unexpected empty value %s", out)
+	}
+}
@@ -0,0 +1,114 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Port describes the properties of a specific port of a service, shared by
+// gateway servers, service entries and sidecar listeners.
+type Port struct {
+	// REQUIRED: A valid non-negative integer port number.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=65535
+	Number uint32 `json:"number"`
+
+	// REQUIRED: The protocol exposed on the port.
+	// MUST BE one of HTTP|HTTPS|GRPC|GRPC-Web|HTTP2|MONGO|MYSQL|REDIS|TCP|TLS.
+	// TLS implies the connection will be routed based on the SNI header to
+	// the destination without terminating the TLS connection.
+	Protocol PortProtocol `json:"protocol"`
+
+	// Label assigned to the port.
+	Name string `json:"name,omitempty"`
+
+	// The port number on the endpoint where the traffic will be received.
+	// Applicable only when used with ServiceEntries.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=65535
+	TargetPort uint32 `json:"targetPort,omitempty"`
+}
+
+// DeepCopyInto copies the receiver into out.
+func (p *Port) DeepCopyInto(out *Port) {
+	*out = *p
+}
+
+// DeepCopy copies the receiver into a new Port.
+func (p *Port) DeepCopy() *Port {
+	if p == nil {
+		return nil
+	}
+	out := new(Port)
+	p.DeepCopyInto(out)
+	return out
+}
+
+// PortProtocol is the protocol exposed on a port. Istio compares protocols
+// case-insensitively.
+type PortProtocol string
+
+const (
+	ProtocolHTTP    PortProtocol = "HTTP"
+	ProtocolHTTPS   PortProtocol = "HTTPS"
+	ProtocolGRPC    PortProtocol = "GRPC"
+	ProtocolGRPCWeb PortProtocol = "GRPC-Web"
+	ProtocolHTTP2   PortProtocol = "HTTP2"
+	ProtocolMongo   PortProtocol = "Mongo"
+	ProtocolMySQL   PortProtocol = "MySQL"
+	ProtocolRedis   PortProtocol = "Redis"
+	ProtocolTCP     PortProtocol = "TCP"
+	ProtocolTLS     PortProtocol = "TLS"
+)
+
+// PortProtocols are the protocols Istio supports on ports.
+var PortProtocols = []PortProtocol{
+	ProtocolHTTP,
+	ProtocolHTTPS,
+	ProtocolGRPC,
+	ProtocolGRPCWeb,
+	ProtocolHTTP2,
+	ProtocolMongo,
+	ProtocolMySQL,
+	ProtocolRedis,
+	ProtocolTCP,
+	ProtocolTLS,
+}
+
+// Canonical returns the protocol of PortProtocols matching p
+// case-insensitively, e.g. HTTP for http, or p itself if none does.
+func (p PortProtocol) Canonical() PortProtocol {
+	for _, protocol := range PortProtocols {
+		if strings.EqualFold(string(p), string(protocol)) {
+			return protocol
+		}
+	}
+
+	return p
+}
+
+// Validate returns an error unless the protocol is one of PortProtocols,
+// ignoring the case.
+func (p PortProtocol) Validate() error {
+	for _, protocol := range PortProtocols {
+		if strings.EqualFold(string(p), string(protocol)) {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("unsupported port protocol %q", p)
+}
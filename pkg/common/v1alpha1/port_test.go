@@ -0,0 +1,49 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	"testing"
+)
+
+func TestPortProtocolValidate(t *testing.T) {
+	tests := map[PortProtocol]bool{
+		ProtocolHTTP:  true,
+		"http":        true,
+		"grpc-web":    true,
+		ProtocolMySQL: true,
+		"SMTP":        false,
+		"":            false,
+	}
+	for protocol, valid := range tests {
+		if err := protocol.Validate(); (err == nil) != valid {
+			t.Errorf("unexpected result for %q: %v", protocol, err)
+		}
+	}
+}
+
+func TestPortProtocolCanonical(t *testing.T) {
+	tests := map[PortProtocol]PortProtocol{
+		"http":  ProtocolHTTP,
+		"MONGO": ProtocolMongo,
+		"redis": ProtocolRedis,
+		"SMTP":  "SMTP",
+	}
+	for protocol, expected := range tests {
+		if canonical := protocol.Canonical(); canonical != expected {
+			t.Errorf("expected %q to be %q, got %q", protocol, expected, canonical)
+		}
+	}
+}
@@ -14,8 +14,15 @@
 
 package v1alpha1
 
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
 // Describes how to match a given string in HTTP headers. Match is
 // case-sensitive.
+// +kubebuilder:validation:MaxProperties=1
 type StringMatch struct {
 	// Specified exactly one of the fields below.
 
@@ -31,3 +38,108 @@ type StringMatch struct {
 	// ECMAscript style regex-based match
 	Regex string `json:"regex,omitempty"`
 }
+
+// Exact returns a match of the exact string.
+func Exact(value string) *StringMatch {
+	return &StringMatch{Exact: value}
+}
+
+// Prefix returns a match of the strings with the prefix.
+func Prefix(value string) *StringMatch {
+	return &StringMatch{Prefix: value}
+}
+
+// Suffix returns a match of the strings with the suffix.
+func Suffix(value string) *StringMatch {
+	return &StringMatch{Suffix: value}
+}
+
+// Regex returns a match of the strings matching the ECMAscript style regex.
+func Regex(value string) *StringMatch {
+	return &StringMatch{Regex: value}
+}
+
+// Validate returns an error unless exactly one of the fields of the match is set.
+func (m *StringMatch) Validate() error {
+	switch set := m.set(); len(set) {
+	case 0:
+		return fmt.Errorf("string match must have one of exact, prefix, suffix or regex set")
+	case 1:
+		return nil
+	default:
+		return fmt.Errorf("string match must have only one of exact, prefix, suffix or regex set, got %s", strings.Join(set, ", "))
+	}
+}
+
+// UnmarshalJSON decodes the match, and returns an error if more than one of
+// its fields is set. The empty match is accepted, since empty strings cannot
+// be told apart from the unset ones.
+func (m *StringMatch) UnmarshalJSON(data []byte) error {
+	match, ok := StringMatch{}, false
+	if fastJSONDecoding {
+		match, ok = decodeSimpleStringMatch(data)
+	}
+	if !ok {
+		type stringMatch StringMatch
+		decoded := stringMatch{}
+		if err := json.Unmarshal(data, &decoded); err != nil {
+			return err
+		}
+		match = StringMatch(decoded)
+	}
+
+	if set := match.set(); len(set) > 1 {
+		return match.Validate()
+	}
+	*m = match
+
+	return nil
+}
+
+// decodeSimpleStringMatch decodes the match without reflection, and returns
+// false if it is not in the simple form handled by the fast decoding.
+func decodeSimpleStringMatch(data []byte) (StringMatch, bool) {
+	var match StringMatch
+	scanner := objectScanner{data: data}
+	for {
+		key, value, done, ok := scanner.next()
+		if !ok {
+			return match, false
+		}
+		if done {
+			return match, true
+		}
+
+		switch string(key) {
+		case "exact":
+			match.Exact = value
+		case "prefix":
+			match.Prefix = value
+		case "suffix":
+			match.Suffix = value
+		case "regex":
+			match.Regex = value
+		default:
+			return match, false
+		}
+	}
+}
+
+// set returns the JSON names of the fields of the match that are set.
+func (m *StringMatch) set() []string {
+	var set []string
+	if m.Exact != "" {
+		set = append(set, "exact")
+	}
+	if m.Prefix != "" {
+		set = append(set, "prefix")
+	}
+	if m.Suffix != "" {
+		set = append(set, "suffix")
+	}
+	if m.Regex != "" {
+		set = append(set, "regex")
+	}
+
+	return set
+}
@@ -0,0 +1,53 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestStringMatchUnmarshalJSON(t *testing.T) {
+	match := &StringMatch{}
+	if err := json.Unmarshal([]byte(`{"prefix":"/api"}`), match); err != nil {
+		t.Fatal(err)
+	}
+	if *match != *Prefix("/api") {
+		t.Fatalf("unexpected match %+v", match)
+	}
+
+	if err := json.Unmarshal([]byte(`{"exact":"/api","regex":"/api/.*"}`), match); err == nil {
+		t.Fatal("expected an error decoding a match with two fields set")
+	}
+	if *match != *Prefix("/api") {
+		t.Fatalf("the failed decoding modified the match: %+v", match)
+	}
+}
+
+func TestStringMatchValidate(t *testing.T) {
+	for _, test := range []struct {
+		match *StringMatch
+		valid bool
+	}{
+		{match: Exact("GET"), valid: true},
+		{match: Regex("v[0-9]+"), valid: true},
+		{match: &StringMatch{}, valid: false},
+		{match: &StringMatch{Prefix: "/", Suffix: ".js"}, valid: false},
+	} {
+		if err := test.match.Validate(); (err == nil) != test.valid {
+			t.Errorf("unexpected validation result of %+v: %v", test.match, err)
+		}
+	}
+}
@@ -0,0 +1,154 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	"fmt"
+	"strings"
+)
+
+// TLS protocol versions.
+// +kubebuilder:validation:Enum=TLS_AUTO;TLSV1_0;TLSV1_1;TLSV1_2;TLSV1_3
+type TLSProtocol string
+
+const (
+	// Automatically choose the optimal TLS version.
+	TLSProtocolAuto TLSProtocol = "TLS_AUTO"
+
+	// TLS version 1.0
+	TLSProtocolV10 TLSProtocol = "TLSV1_0"
+
+	// TLS version 1.1
+	TLSProtocolV11 TLSProtocol = "TLSV1_1"
+
+	// TLS version 1.2
+	TLSProtocolV12 TLSProtocol = "TLSV1_2"
+
+	// TLS version 1.3
+	TLSProtocolV13 TLSProtocol = "TLSV1_3"
+)
+
+// TLSProtocols are the TLS protocol versions, TLSProtocolAuto first, then from
+// the oldest to the newest.
+var TLSProtocols = []TLSProtocol{TLSProtocolAuto, TLSProtocolV10, TLSProtocolV11, TLSProtocolV12, TLSProtocolV13}
+
+// Validate returns an error unless the protocol is one of TLSProtocols.
+func (p TLSProtocol) Validate() error {
+	if p.index() < 0 {
+		return fmt.Errorf("unsupported TLS protocol version %q", p)
+	}
+
+	return nil
+}
+
+// ValidateTLSProtocolRange returns an error if both versions are set to
+// specific versions and the minimum is newer than the maximum. Unset versions
+// are nil.
+func ValidateTLSProtocolRange(min, max *TLSProtocol) error {
+	if min == nil || max == nil || *min == TLSProtocolAuto || *max == TLSProtocolAuto {
+		return nil
+	}
+	if min.index() > max.index() {
+		return fmt.Errorf("the minimum TLS protocol version %s is newer than the maximum %s", *min, *max)
+	}
+
+	return nil
+}
+
+func (p TLSProtocol) index() int {
+	for i, protocol := range TLSProtocols {
+		if p == protocol {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// TLSCipherSuite is the OpenSSL name of a cipher suite supported by Envoy,
+// e.g. ECDHE-RSA-AES128-GCM-SHA256, or an equivalence group of them in
+// brackets, e.g. [ECDHE-ECDSA-AES128-GCM-SHA256|ECDHE-ECDSA-CHACHA20-POLY1305],
+// letting the client choose among the suites of the group.
+type TLSCipherSuite string
+
+// The cipher suites supported by Envoy.
+const (
+	TLSCipherSuiteECDHEECDSAAES256GCMSHA384  TLSCipherSuite = "ECDHE-ECDSA-AES256-GCM-SHA384"
+	TLSCipherSuiteECDHERSAAES256GCMSHA384    TLSCipherSuite = "ECDHE-RSA-AES256-GCM-SHA384"
+	TLSCipherSuiteECDHEECDSAAES128GCMSHA256  TLSCipherSuite = "ECDHE-ECDSA-AES128-GCM-SHA256"
+	TLSCipherSuiteECDHERSAAES128GCMSHA256    TLSCipherSuite = "ECDHE-RSA-AES128-GCM-SHA256"
+	TLSCipherSuiteAES256GCMSHA384            TLSCipherSuite = "AES256-GCM-SHA384"
+	TLSCipherSuiteAES128GCMSHA256            TLSCipherSuite = "AES128-GCM-SHA256"
+	TLSCipherSuiteECDHEECDSACHACHA20POLY1305 TLSCipherSuite = "ECDHE-ECDSA-CHACHA20-POLY1305"
+	TLSCipherSuiteECDHERSACHACHA20POLY1305   TLSCipherSuite = "ECDHE-RSA-CHACHA20-POLY1305"
+	TLSCipherSuiteECDHEECDSAAES128SHA        TLSCipherSuite = "ECDHE-ECDSA-AES128-SHA"
+	TLSCipherSuiteECDHERSAAES128SHA          TLSCipherSuite = "ECDHE-RSA-AES128-SHA"
+	TLSCipherSuiteECDHEECDSAAES256SHA        TLSCipherSuite = "ECDHE-ECDSA-AES256-SHA"
+	TLSCipherSuiteECDHERSAAES256SHA          TLSCipherSuite = "ECDHE-RSA-AES256-SHA"
+	TLSCipherSuiteAES128SHA                  TLSCipherSuite = "AES128-SHA"
+	TLSCipherSuiteAES256SHA                  TLSCipherSuite = "AES256-SHA"
+	TLSCipherSuiteDESCBC3SHA                 TLSCipherSuite = "DES-CBC3-SHA"
+	TLSCipherSuiteECDHEPSKAES128CBCSHA       TLSCipherSuite = "ECDHE-PSK-AES128-CBC-SHA"
+	TLSCipherSuiteECDHEPSKCHACHA20POLY1305   TLSCipherSuite = "ECDHE-PSK-CHACHA20-POLY1305"
+)
+
+// TLSCipherSuites are the cipher suites supported by Envoy, the same list as
+// the one Istio validates the cipher suites of gateways with.
+var TLSCipherSuites = []TLSCipherSuite{
+	TLSCipherSuiteECDHEECDSAAES256GCMSHA384,
+	TLSCipherSuiteECDHERSAAES256GCMSHA384,
+	TLSCipherSuiteECDHEECDSAAES128GCMSHA256,
+	TLSCipherSuiteECDHERSAAES128GCMSHA256,
+	TLSCipherSuiteAES256GCMSHA384,
+	TLSCipherSuiteAES128GCMSHA256,
+	TLSCipherSuiteECDHEECDSACHACHA20POLY1305,
+	TLSCipherSuiteECDHERSACHACHA20POLY1305,
+	TLSCipherSuiteECDHEECDSAAES128SHA,
+	TLSCipherSuiteECDHERSAAES128SHA,
+	TLSCipherSuiteECDHEECDSAAES256SHA,
+	TLSCipherSuiteECDHERSAAES256SHA,
+	TLSCipherSuiteAES128SHA,
+	TLSCipherSuiteAES256SHA,
+	TLSCipherSuiteDESCBC3SHA,
+	TLSCipherSuiteECDHEPSKAES128CBCSHA,
+	TLSCipherSuiteECDHEPSKCHACHA20POLY1305,
+}
+
+// Validate returns an error unless the cipher suite, or every suite of the
+// equivalence group, is one of TLSCipherSuites.
+func (s TLSCipherSuite) Validate() error {
+	suites := []string{string(s)}
+	if strings.HasPrefix(string(s), "[") && strings.HasSuffix(string(s), "]") {
+		suites = strings.Split(strings.Trim(string(s), "[]"), "|")
+	}
+
+	for _, suite := range suites {
+		if !TLSCipherSuite(suite).supported() {
+			return fmt.Errorf("unsupported TLS cipher suite %q", suite)
+		}
+	}
+
+	return nil
+}
+
+func (s TLSCipherSuite) supported() bool {
+	for _, suite := range TLSCipherSuites {
+		if s == suite {
+			return true
+		}
+	}
+
+	return false
+}
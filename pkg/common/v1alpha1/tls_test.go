@@ -0,0 +1,65 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	"testing"
+)
+
+func TestTLSProtocolValidate(t *testing.T) {
+	for _, protocol := range TLSProtocols {
+		if err := protocol.Validate(); err != nil {
+			t.Errorf("expected %s to be valid, got %v", protocol, err)
+		}
+	}
+	if err := TLSProtocol("TLSV1_4").Validate(); err == nil {
+		t.Error("expected TLSV1_4 to be rejected")
+	}
+}
+
+func TestValidateTLSProtocolRange(t *testing.T) {
+	protocol := func(p TLSProtocol) *TLSProtocol { return &p }
+	tests := []struct {
+		min, max *TLSProtocol
+		valid    bool
+	}{
+		{nil, nil, true},
+		{protocol(TLSProtocolV13), nil, true},
+		{protocol(TLSProtocolV12), protocol(TLSProtocolV13), true},
+		{protocol(TLSProtocolV12), protocol(TLSProtocolV12), true},
+		{protocol(TLSProtocolV13), protocol(TLSProtocolAuto), true},
+		{protocol(TLSProtocolV13), protocol(TLSProtocolV12), false},
+	}
+	for _, test := range tests {
+		if err := ValidateTLSProtocolRange(test.min, test.max); (err == nil) != test.valid {
+			t.Errorf("unexpected result for %v and %v: %v", test.min, test.max, err)
+		}
+	}
+}
+
+func TestTLSCipherSuiteValidate(t *testing.T) {
+	tests := map[TLSCipherSuite]bool{
+		TLSCipherSuiteECDHERSAAES128GCMSHA256:                           true,
+		"[ECDHE-ECDSA-AES128-GCM-SHA256|ECDHE-ECDSA-CHACHA20-POLY1305]": true,
+		"ECDHE-RSA-AES128-GCM-SHA257":                                   false,
+		"[ECDHE-ECDSA-AES128-GCM-SHA256|ECDHE-ECDSA-CHACHA20-POLY1306]": false,
+		"": false,
+	}
+	for suite, valid := range tests {
+		if err := suite.Validate(); (err == nil) != valid {
+			t.Errorf("unexpected result for %q: %v", suite, err)
+		}
+	}
+}
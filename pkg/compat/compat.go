@@ -0,0 +1,319 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package compat tells whether Istio resources can be applied to a given
+// version of Istio, so that fleets running several versions of Istio can
+// hold back the configuration some of their clusters do not support, e.g.
+//
+//	issues, err := compat.Check(vs, "1.9")
+//
+// The kinds, fields and values Istio added or removed are listed in the
+// feature matrix of the package. Since the resources are checked in their
+// JSON form, the fields this module does not model are found too when the
+// resources are unstructured.
+package compat
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/banzaicloud/istio-client-go/pkg/registry"
+)
+
+// Feature is a kind, a field or a field value that only some versions of
+// Istio support.
+type Feature struct {
+	// Group is the API group of the kinds of the feature
+	Group string
+	// Version is the API version of the kinds of the feature, any if empty
+	Version string
+	// Kind is the kind of the feature, any kind of the group if empty
+	Kind string
+	// Path is the path of the field, e.g. spec.http[].directResponse, where
+	// [] matches any item of a list, * any key of a map and ** any number of
+	// fields. The kind itself is the feature if empty.
+	Path string
+	// Value is the value of the field, any if empty
+	Value string
+	// Since is the first minor version of Istio supporting the feature, e.g.
+	// 1.15, or empty if every version did
+	Since string
+	// Removed is the first minor version of Istio no longer supporting the
+	// feature, or empty if it is still supported
+	Removed string
+}
+
+func (f Feature) String() string {
+	var s string
+	switch {
+	case f.Path == "" && f.Kind == "":
+		s = f.Group
+		if f.Version != "" {
+			s += "/" + f.Version
+		}
+	case f.Path == "":
+		s = f.Kind
+	case f.Value != "":
+		s = fmt.Sprintf("%s %s of %s", f.Path, f.Value, f.Kind)
+	default:
+		s = fmt.Sprintf("%s of %s", f.Path, f.Kind)
+	}
+
+	return s
+}
+
+// Issue is the use of a feature the target version of Istio does not support.
+type Issue struct {
+	// Path is the path of the field using the feature, e.g.
+	// spec.http[0].directResponse, or empty if the kind is the feature
+	Path string
+	// Feature is the feature used
+	Feature Feature
+	// Removed is true if the feature was removed before the target version,
+	// false if it was added after it
+	Removed bool
+}
+
+func (i Issue) String() string {
+	subject := i.Feature.String()
+	if i.Path != "" {
+		subject = i.Path
+		if i.Feature.Value != "" {
+			subject = fmt.Sprintf("%s: %s", i.Path, i.Feature.Value)
+		}
+	}
+	if i.Removed {
+		return fmt.Sprintf("%s was removed in Istio %s", subject, i.Feature.Removed)
+	}
+
+	return fmt.Sprintf("%s requires Istio %s or newer", subject, i.Feature.Since)
+}
+
+// matrix lists the features of the Istio kinds, fields and values which were
+// not supported by every version of Istio since 1.1.
+var matrix = []Feature{
+	{Group: "networking.istio.io", Version: "v1beta1", Since: "1.5"},
+	{Group: "networking.istio.io", Kind: "WorkloadEntry", Since: "1.6"},
+	{Group: "networking.istio.io", Kind: "WorkloadGroup", Since: "1.8"},
+	{Group: "networking.istio.io", Kind: "VirtualService", Path: "spec.http[].mirrorPercentage", Since: "1.5"},
+	{Group: "networking.istio.io", Kind: "VirtualService", Path: "spec.http[].delegate", Since: "1.8"},
+	{Group: "networking.istio.io", Kind: "VirtualService", Path: "spec.http[].directResponse", Since: "1.15"},
+	{Group: "networking.istio.io", Kind: "DestinationRule", Path: "spec.**.outlierDetection.consecutive5xxErrors", Since: "1.5"},
+	{Group: "networking.istio.io", Kind: "DestinationRule", Path: "spec.**.outlierDetection.consecutiveGatewayErrors", Since: "1.5"},
+	{Group: "networking.istio.io", Kind: "ServiceEntry", Path: "spec.workloadSelector", Since: "1.6"},
+	{Group: "security.istio.io", Kind: "AuthorizationPolicy", Since: "1.4"},
+	{Group: "security.istio.io", Kind: "PeerAuthentication", Since: "1.5"},
+	{Group: "security.istio.io", Kind: "RequestAuthentication", Since: "1.5"},
+	{Group: "security.istio.io", Kind: "AuthorizationPolicy", Path: "spec.action", Value: "DENY", Since: "1.5"},
+	{Group: "security.istio.io", Kind: "AuthorizationPolicy", Path: "spec.action", Value: "CUSTOM", Since: "1.9"},
+	{Group: "security.istio.io", Kind: "AuthorizationPolicy", Path: "spec.action", Value: "AUDIT", Since: "1.10"},
+	{Group: "security.istio.io", Kind: "AuthorizationPolicy", Path: "spec.provider", Since: "1.9"},
+	{Group: "security.istio.io", Kind: "AuthorizationPolicy", Path: "spec.targetRef", Since: "1.17"},
+	{Group: "security.istio.io", Kind: "RequestAuthentication", Path: "spec.targetRef", Since: "1.17"},
+	{Group: "authentication.istio.io", Removed: "1.6"},
+	{Group: "telemetry.istio.io", Since: "1.11"},
+	{Group: "telemetry.istio.io", Kind: "Telemetry", Path: "spec.targetRef", Since: "1.17"},
+	{Group: "extensions.istio.io", Kind: "WasmPlugin", Since: "1.12"},
+}
+
+// Features returns the feature matrix the resources are checked against.
+func Features() []Feature {
+	out := make([]Feature, len(matrix))
+	copy(out, matrix)
+
+	return out
+}
+
+// Check returns the features the object uses which the given version of
+// Istio, e.g. 1.9 or 1.9.3, does not support. Typed objects without their
+// TypeMeta are checked as the kind registered for their type.
+func Check(obj runtime.Object, istioVersion string) ([]Issue, error) {
+	target, err := parseVersion(istioVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	content, gvk, err := toUnstructured(obj)
+	if err != nil {
+		return nil, err
+	}
+
+	var issues []Issue
+	for _, feature := range matrix {
+		if feature.Group != gvk.Group || (feature.Version != "" && feature.Version != gvk.Version) ||
+			(feature.Kind != "" && feature.Kind != gvk.Kind) {
+			continue
+		}
+		removed := feature.Removed != "" && !target.less(mustParseVersion(feature.Removed))
+		added := feature.Since == "" || !target.less(mustParseVersion(feature.Since))
+		if added && !removed {
+			continue
+		}
+
+		var paths []string
+		if feature.Path == "" {
+			paths = []string{""}
+		} else {
+			paths = find(content, strings.Split(feature.Path, "."), feature.Value, "")
+		}
+		for _, path := range paths {
+			issues = append(issues, Issue{Path: path, Feature: feature, Removed: removed})
+		}
+	}
+	sort.SliceStable(issues, func(i, j int) bool {
+		return issues[i].Path < issues[j].Path
+	})
+
+	return issues, nil
+}
+
+// toUnstructured returns the JSON content and the kind of the object.
+func toUnstructured(obj runtime.Object) (map[string]interface{}, schema.GroupVersionKind, error) {
+	if u, ok := obj.(*unstructured.Unstructured); ok {
+		return u.UnstructuredContent(), u.GroupVersionKind(), nil
+	}
+
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return nil, schema.GroupVersionKind{}, err
+	}
+	gvk := obj.GetObjectKind().GroupVersionKind()
+	if gvk.Empty() {
+		for _, kind := range registry.Kinds() {
+			if reflect.TypeOf(kind.New()) == reflect.TypeOf(obj) {
+				gvk = kind.GroupVersionKind
+			}
+		}
+	}
+	if gvk.Empty() {
+		return nil, gvk, fmt.Errorf("unknown kind of %T", obj)
+	}
+
+	return content, gvk, nil
+}
+
+// find returns the paths of the values matching the pattern, and the value
+// if it is not empty.
+func find(v interface{}, pattern []string, value, path string) []string {
+	if len(pattern) == 0 {
+		if value == "" || fmt.Sprint(v) == value {
+			return []string{path}
+		}
+		return nil
+	}
+
+	segment := pattern[0]
+	var paths []string
+	if segment == "**" {
+		// ** matches no field, or any field followed by ** again
+		paths = append(paths, find(v, pattern[1:], value, path)...)
+		if m, ok := v.(map[string]interface{}); ok {
+			for _, key := range sortedKeys(m) {
+				paths = append(paths, find(m[key], pattern, value, join(path, key))...)
+			}
+		}
+		if l, ok := v.([]interface{}); ok {
+			for i, item := range l {
+				paths = append(paths, find(item, pattern, value, fmt.Sprintf("%s[%d]", path, i))...)
+			}
+		}
+		return paths
+	}
+
+	m, ok := v.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	name := strings.TrimSuffix(segment, "[]")
+	var keys []string
+	if name == "*" {
+		keys = sortedKeys(m)
+	} else if _, ok := m[name]; ok {
+		keys = []string{name}
+	}
+	for _, key := range keys {
+		if !strings.HasSuffix(segment, "[]") {
+			paths = append(paths, find(m[key], pattern[1:], value, join(path, key))...)
+			continue
+		}
+		l, _ := m[key].([]interface{})
+		for i, item := range l {
+			paths = append(paths, find(item, pattern[1:], value, fmt.Sprintf("%s[%d]", join(path, key), i))...)
+		}
+	}
+
+	return paths
+}
+
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
+
+func join(path, key string) string {
+	if path == "" {
+		return key
+	}
+
+	return path + "." + key
+}
+
+// version is a minor version of Istio.
+type version struct {
+	major, minor int
+}
+
+func (v version) less(other version) bool {
+	return v.major < other.major || (v.major == other.major && v.minor < other.minor)
+}
+
+// parseVersion returns the minor version of the Istio version, e.g. 1.9 of
+// 1.9.3 or v1.9.0-beta.1.
+func parseVersion(s string) (version, error) {
+	parts := strings.SplitN(strings.TrimPrefix(s, "v"), ".", 3)
+	if len(parts) < 2 {
+		return version{}, fmt.Errorf("invalid Istio version %q", s)
+	}
+	major, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return version{}, fmt.Errorf("invalid Istio version %q", s)
+	}
+	minor, err := strconv.Atoi(strings.SplitN(parts[1], "-", 2)[0])
+	if err != nil {
+		return version{}, fmt.Errorf("invalid Istio version %q", s)
+	}
+
+	return version{major: major, minor: minor}, nil
+}
+
+func mustParseVersion(s string) version {
+	v, err := parseVersion(s)
+	if err != nil {
+		panic(err)
+	}
+
+	return v
+}
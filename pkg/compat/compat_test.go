@@ -0,0 +1,126 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compat
+
+import (
+	"reflect"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	authenticationv1alpha1 "github.com/banzaicloud/istio-client-go/pkg/authentication/v1alpha1"
+	networkingv1beta1 "github.com/banzaicloud/istio-client-go/pkg/networking/v1beta1"
+	securityv1beta1 "github.com/banzaicloud/istio-client-go/pkg/security/v1beta1"
+)
+
+func TestCheck(t *testing.T) {
+	five := uint32(5)
+	tests := []struct {
+		name     string
+		obj      runtime.Object
+		version  string
+		expected []string
+	}{
+		{
+			name: "virtual service",
+			obj: &unstructured.Unstructured{Object: map[string]interface{}{
+				"apiVersion": "networking.istio.io/v1beta1",
+				"kind":       "VirtualService",
+				"spec": map[string]interface{}{
+					"http": []interface{}{
+						map[string]interface{}{"route": []interface{}{}},
+						map[string]interface{}{"directResponse": map[string]interface{}{"status": int64(503)}},
+					},
+				},
+			}},
+			version: "1.14.3",
+			expected: []string{
+				"spec.http[1].directResponse requires Istio 1.15 or newer",
+			},
+		},
+		{
+			name: "destination rule",
+			obj: &networkingv1beta1.DestinationRule{Spec: networkingv1beta1.DestinationRuleSpec{
+				Host: "reviews",
+				Subsets: []networkingv1beta1.Subset{{Name: "v1", TrafficPolicy: &networkingv1beta1.TrafficPolicy{
+					TrafficPolicyCommon: networkingv1beta1.TrafficPolicyCommon{
+						OutlierDetection: &networkingv1beta1.OutlierDetection{Consecutive5XxErrors: &five},
+					},
+				}}},
+			}},
+			version: "1.4",
+			expected: []string{
+				"networking.istio.io/v1beta1 requires Istio 1.5 or newer",
+				"spec.subsets[0].trafficPolicy.outlierDetection.consecutive5xxErrors requires Istio 1.5 or newer",
+			},
+		},
+		{
+			name:    "value",
+			obj:     &securityv1beta1.AuthorizationPolicy{Spec: securityv1beta1.AuthorizationPolicySpec{Action: securityv1beta1.AuthorizationPolicyActionAudit}},
+			version: "v1.9.0-beta.1",
+			expected: []string{
+				"spec.action: AUDIT requires Istio 1.10 or newer",
+			},
+		},
+		{
+			name:     "removed",
+			obj:      &authenticationv1alpha1.Policy{},
+			version:  "1.6",
+			expected: []string{"authentication.istio.io was removed in Istio 1.6"},
+		},
+		{
+			name:    "supported",
+			obj:     &authenticationv1alpha1.Policy{},
+			version: "1.5",
+		},
+	}
+
+	for _, test := range tests {
+		issues, err := Check(test.obj, test.version)
+		if err != nil {
+			t.Errorf("%s: unexpected error %v", test.name, err)
+			continue
+		}
+		var messages []string
+		for _, issue := range issues {
+			messages = append(messages, issue.String())
+		}
+		if !reflect.DeepEqual(messages, test.expected) {
+			t.Errorf("%s: unexpected issues %q, expected %q", test.name, messages, test.expected)
+		}
+	}
+}
+
+func TestCheckInvalidVersion(t *testing.T) {
+	for _, version := range []string{"", "1", "one.two", "1.x"} {
+		if _, err := Check(&networkingv1beta1.VirtualService{}, version); err == nil {
+			t.Errorf("expected %q to be rejected", version)
+		}
+	}
+}
+
+func TestFeatures(t *testing.T) {
+	for _, feature := range Features() {
+		for _, v := range []string{feature.Since, feature.Removed} {
+			if _, err := parseVersion(v); v != "" && err != nil {
+				t.Errorf("%s: %v", feature, err)
+			}
+		}
+		if feature.Since == "" && feature.Removed == "" {
+			t.Errorf("%s: neither added nor removed", feature)
+		}
+	}
+}
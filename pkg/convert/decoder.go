@@ -0,0 +1,65 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package convert
+
+import (
+	"encoding/json"
+	"reflect"
+
+	"github.com/banzaicloud/istio-client-go/pkg/common/v1alpha1"
+)
+
+// Decoder decodes the JSON encoding of resources with the options set on it,
+// so that the callers decoding the same kinds in a process may handle the
+// deprecated fields differently. The zero value decodes the way json.Unmarshal
+// does.
+type Decoder struct {
+	// MigrateDeprecatedFields makes the decoder move the values of the
+	// deprecated fields to their replacements, e.g. mirrorPercent to
+	// mirrorPercentage, of the resources implementing v1alpha1.Migrator, so
+	// that the resources encoded again are current for Istio.
+	MigrateDeprecatedFields bool
+	// DeprecationHandler is called with every deprecated field decoded, in
+	// the order of their paths, e.g. to log warnings for the manifests to
+	// update. The fields are the ones listed by the types implementing
+	// v1alpha1.DeprecatedFields.
+	DeprecationHandler func(v1alpha1.Deprecation)
+}
+
+// Unmarshal decodes the JSON data into obj, reports its deprecated fields to
+// the DeprecationHandler and migrates them if set to.
+func (d *Decoder) Unmarshal(data []byte, obj interface{}) error {
+	if err := json.Unmarshal(data, obj); err != nil {
+		return err
+	}
+
+	if d.DeprecationHandler != nil {
+		var content interface{}
+		if err := json.Unmarshal(data, &content); err != nil {
+			return err
+		}
+		w := &fieldWalker{}
+		w.walk(content, reflect.TypeOf(obj), "")
+		for _, deprecation := range w.sortedDeprecations() {
+			d.DeprecationHandler(deprecation)
+		}
+	}
+
+	if m, ok := obj.(v1alpha1.Migrator); ok && d.MigrateDeprecatedFields {
+		m.MigrateDeprecatedFields()
+	}
+
+	return nil
+}
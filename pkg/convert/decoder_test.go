@@ -0,0 +1,89 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package convert
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/banzaicloud/istio-client-go/pkg/common/v1alpha1"
+	networkingv1alpha3 "github.com/banzaicloud/istio-client-go/pkg/networking/v1alpha3"
+	networkingv1beta1 "github.com/banzaicloud/istio-client-go/pkg/networking/v1beta1"
+)
+
+func TestDecoder(t *testing.T) {
+	data := []byte(`{"spec": {"http": [
+		{"mirror_percent": 50, "appendHeaders": {"x-version": "v1"}},
+		{"mirrorPercent": 20, "corsPolicy": {"allowOrigin": ["https://example.com"]}}
+	]}}`)
+
+	var deprecations []v1alpha1.Deprecation
+	decoder := &Decoder{
+		MigrateDeprecatedFields: true,
+		DeprecationHandler:      func(d v1alpha1.Deprecation) { deprecations = append(deprecations, d) },
+	}
+	vs := &networkingv1beta1.VirtualService{}
+	if err := decoder.Unmarshal(data, vs); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []v1alpha1.Deprecation{
+		{Path: "spec.http[0].appendHeaders", Type: "HTTPRoute", Field: "appendHeaders", Replacement: "headers.request.add"},
+		{Path: "spec.http[0].mirror_percent", Type: "HTTPRoute", Field: "mirror_percent", Replacement: "mirrorPercentage"},
+		{Path: "spec.http[1].corsPolicy.allowOrigin", Type: "CorsPolicy", Field: "allowOrigin", Replacement: "allowOrigins"},
+		{Path: "spec.http[1].mirrorPercent", Type: "HTTPRoute", Field: "mirrorPercent", Replacement: "mirrorPercentage"},
+	}
+	if !reflect.DeepEqual(deprecations, expected) {
+		t.Errorf("unexpected deprecations %+v, expected %+v", deprecations, expected)
+	}
+	for i, route := range vs.Spec.HTTP {
+		if route.MirrorPercent != nil || route.MirrorPercentage == nil {
+			t.Errorf("expected the mirror percentage of route %d to be migrated", i)
+		}
+	}
+
+	// the zero value decodes as json.Unmarshal does
+	vs = &networkingv1beta1.VirtualService{}
+	if err := (&Decoder{}).Unmarshal(data, vs); err != nil {
+		t.Fatal(err)
+	}
+	if vs.Spec.HTTP[0].MirrorPercent == nil || vs.Spec.HTTP[0].MirrorPercentage != nil {
+		t.Errorf("unexpected migration of the mirror percentage %+v", vs.Spec.HTTP[0])
+	}
+
+	if err := decoder.Unmarshal([]byte(`{"spec": {"hosts": "reviews"}}`), vs); err == nil {
+		t.Error("expected an error decoding an invalid virtual service")
+	}
+}
+
+func TestDecoderProtobufNames(t *testing.T) {
+	var fields []string
+	decoder := &Decoder{DeprecationHandler: func(d v1alpha1.Deprecation) {
+		fields = append(fields, d.Path+" "+d.Replacement)
+	}}
+	data := []byte(`{"spec": {"probe": {"initial_delay_seconds": 5, "http_get": {"port": 8080, "http_headers": [{"name": "Host"}]}}}}`)
+	if err := decoder.Unmarshal(data, &networkingv1alpha3.WorkloadGroup{}); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []string{
+		"spec.probe.http_get httpGet",
+		"spec.probe.http_get.http_headers httpHeaders",
+		"spec.probe.initial_delay_seconds initialDelaySeconds",
+	}
+	if !reflect.DeepEqual(fields, expected) {
+		t.Errorf("unexpected deprecations %q, expected %q", fields, expected)
+	}
+}
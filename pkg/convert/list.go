@@ -0,0 +1,101 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package convert
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// DecodeList decodes the JSON list read from r one item at a time, calling
+// fn with each of them, so that the memory used does not grow with the
+// length of the list, e.g. when listing every workload entry of a large mesh
+// through the stream of a REST request. newItem returns an empty object to
+// decode an item into, e.g. registry.Kind.New. The metadata of the list is
+// returned, which holds the continue token of paginated lists. Decoding
+// stops at the first error returned by fn.
+func DecodeList(r io.Reader, newItem func() runtime.Object, fn func(item runtime.Object) error) (metav1.ListMeta, error) {
+	var meta metav1.ListMeta
+	decoder := json.NewDecoder(r)
+	if err := expectDelim(decoder, '{'); err != nil {
+		return meta, err
+	}
+
+	for decoder.More() {
+		token, err := decoder.Token()
+		if err != nil {
+			return meta, err
+		}
+		switch key := token.(string); key {
+		case "items":
+			if err := decodeItems(decoder, newItem, fn); err != nil {
+				return meta, err
+			}
+		case "metadata":
+			if err := decoder.Decode(&meta); err != nil {
+				return meta, fmt.Errorf("metadata: %w", err)
+			}
+		default:
+			var skipped json.RawMessage
+			if err := decoder.Decode(&skipped); err != nil {
+				return meta, fmt.Errorf("%s: %w", key, err)
+			}
+		}
+	}
+
+	return meta, expectDelim(decoder, '}')
+}
+
+func decodeItems(decoder *json.Decoder, newItem func() runtime.Object, fn func(item runtime.Object) error) error {
+	token, err := decoder.Token()
+	if err != nil {
+		return err
+	}
+	if token == nil {
+		// null items
+		return nil
+	}
+	if token != json.Delim('[') {
+		return fmt.Errorf("items: expected an array, got %v", token)
+	}
+
+	for i := 0; decoder.More(); i++ {
+		item := newItem()
+		if err := decoder.Decode(item); err != nil {
+			return fmt.Errorf("items[%d]: %w", i, err)
+		}
+		if err := fn(item); err != nil {
+			return err
+		}
+	}
+
+	return expectDelim(decoder, ']')
+}
+
+func expectDelim(decoder *json.Decoder, delim json.Delim) error {
+	token, err := decoder.Token()
+	if err != nil {
+		return err
+	}
+	if token != delim {
+		return fmt.Errorf("expected %v, got %v", delim, token)
+	}
+
+	return nil
+}
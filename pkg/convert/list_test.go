@@ -0,0 +1,68 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package convert
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/runtime"
+
+	networkingv1beta1 "github.com/banzaicloud/istio-client-go/pkg/networking/v1beta1"
+)
+
+func TestDecodeList(t *testing.T) {
+	list := `{
+		"apiVersion": "networking.istio.io/v1beta1",
+		"kind": "WorkloadEntryList",
+		"metadata": {"resourceVersion": "42", "continue": "next"},
+		"items": [
+			{"metadata": {"name": "vm-1"}, "spec": {"address": "10.0.0.1"}},
+			{"metadata": {"name": "vm-2"}, "spec": {"address": "10.0.0.2"}}
+		]
+	}`
+	newItem := func() runtime.Object { return &networkingv1beta1.WorkloadEntry{} }
+
+	var addresses []string
+	meta, err := DecodeList(strings.NewReader(list), newItem, func(item runtime.Object) error {
+		addresses = append(addresses, item.(*networkingv1beta1.WorkloadEntry).Spec.Address)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := []string{"10.0.0.1", "10.0.0.2"}; !reflect.DeepEqual(addresses, expected) {
+		t.Errorf("expected %v, got %v", expected, addresses)
+	}
+	if meta.Continue != "next" || meta.ResourceVersion != "42" {
+		t.Errorf("unexpected list metadata %v", meta)
+	}
+
+	stop := errors.New("stop")
+	calls := 0
+	_, err = DecodeList(strings.NewReader(list), newItem, func(runtime.Object) error {
+		calls++
+		return stop
+	})
+	if err != stop || calls != 1 {
+		t.Errorf("expected decoding to stop at the first error, got %v after %d calls", err, calls)
+	}
+
+	if _, err := DecodeList(strings.NewReader(`{"items": {}}`), newItem, func(runtime.Object) error { return nil }); err == nil {
+		t.Error("expected an error for items which are not an array")
+	}
+}
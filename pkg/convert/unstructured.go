@@ -0,0 +1,229 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package convert converts the resources of this module to and from other
+// representations.
+package convert
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/banzaicloud/istio-client-go/pkg/common/v1alpha1"
+	"github.com/banzaicloud/istio-client-go/pkg/registry"
+)
+
+var unmarshalerType = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+
+// UnknownFieldsError is returned by the strict conversions if the unstructured
+// object has fields the typed object does not model.
+type UnknownFieldsError struct {
+	// Fields are the paths of the unknown fields, e.g. spec.htpp
+	Fields []string
+}
+
+func (e *UnknownFieldsError) Error() string {
+	return fmt.Sprintf("unknown fields: %s", strings.Join(e.Fields, ", "))
+}
+
+// FromUnstructured converts the unstructured object into out, dropping the
+// fields out does not model.
+func FromUnstructured(u *unstructured.Unstructured, out runtime.Object) error {
+	return runtime.DefaultUnstructuredConverter.FromUnstructured(u.UnstructuredContent(), out)
+}
+
+// FromUnstructuredStrict converts the unstructured object into out, or returns
+// an *UnknownFieldsError if it has fields out does not model.
+func FromUnstructuredStrict(u *unstructured.Unstructured, out runtime.Object) error {
+	w := &fieldWalker{}
+	w.walk(u.UnstructuredContent(), reflect.TypeOf(out), "")
+	if len(w.unknown) > 0 {
+		sort.Strings(w.unknown)
+		return &UnknownFieldsError{Fields: w.unknown}
+	}
+
+	return FromUnstructured(u, out)
+}
+
+// DeprecatedFields returns the sorted paths of the deprecated fields of the
+// unstructured object, as listed by the types of out implementing
+// v1alpha1.DeprecatedFields, e.g. spec.http[0].mirror_percent
+func DeprecatedFields(u *unstructured.Unstructured, out runtime.Object) []string {
+	w := &fieldWalker{}
+	w.walk(u.UnstructuredContent(), reflect.TypeOf(out), "")
+
+	paths := make([]string, 0, len(w.deprecated))
+	for _, deprecation := range w.sortedDeprecations() {
+		paths = append(paths, deprecation.Path)
+	}
+
+	return paths
+}
+
+// ToTyped converts the unstructured object into a new object of the kind
+// registered for its group version kind. In strict mode it returns an
+// *UnknownFieldsError if the object has fields the kind does not model.
+func ToTyped(u *unstructured.Unstructured, strict bool) (runtime.Object, error) {
+	gvk := u.GroupVersionKind()
+	kind, ok := registry.ForKind(gvk)
+	if !ok {
+		return nil, fmt.Errorf("unknown kind %s", gvk)
+	}
+
+	out := kind.New()
+	convert := FromUnstructured
+	if strict {
+		convert = FromUnstructuredStrict
+	}
+	if err := convert(u, out); err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
+
+// ToUnstructured converts the typed object into an unstructured one.
+func ToUnstructured(obj runtime.Object) (*unstructured.Unstructured, error) {
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return nil, err
+	}
+
+	return &unstructured.Unstructured{Object: content}, nil
+}
+
+// fieldWalker collects the paths of the fields of an unstructured value that
+// its type does not model or deprecates.
+type fieldWalker struct {
+	unknown    []string
+	deprecated []v1alpha1.Deprecation
+}
+
+// walk descends into the unstructured value along t. Types that unmarshal
+// themselves are only descended into if they model fields, and their
+// deprecated fields are accepted.
+func (w *fieldWalker) walk(value interface{}, t reflect.Type, path string) {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if reflect.PtrTo(t).Implements(unmarshalerType) && (t.Kind() != reflect.Struct || len(jsonFields(t)) == 0) {
+		return
+	}
+
+	switch v := value.(type) {
+	case []interface{}:
+		if t.Kind() == reflect.Slice || t.Kind() == reflect.Array {
+			for i := range v {
+				w.walk(v[i], t.Elem(), fmt.Sprintf("%s[%d]", path, i))
+			}
+		}
+	case map[string]interface{}:
+		switch t.Kind() {
+		case reflect.Map:
+			for key := range v {
+				w.walk(v[key], t.Elem(), join(path, key))
+			}
+		case reflect.Struct:
+			fields := jsonFields(t)
+			deprecated := map[string]string{}
+			if d, ok := reflect.New(t).Interface().(v1alpha1.DeprecatedFields); ok {
+				deprecated = d.DeprecatedFields()
+				for name, replacement := range deprecated {
+					if _, ok := fields[name]; ok {
+						continue
+					}
+					// the fields the type does not model are descended into
+					// along their replacement, if it is a field of the type
+					if replacementType, ok := fields[replacement]; ok {
+						fields[name] = replacementType
+					} else {
+						fields[name] = reflect.TypeOf((*interface{})(nil)).Elem()
+					}
+				}
+			}
+			for key := range v {
+				field, ok := fields[key]
+				if !ok {
+					w.unknown = append(w.unknown, join(path, key))
+					continue
+				}
+				if replacement, ok := deprecated[key]; ok {
+					w.deprecated = append(w.deprecated, v1alpha1.Deprecation{
+						Path:        join(path, key),
+						Type:        t.Name(),
+						Field:       key,
+						Replacement: replacement,
+					})
+				}
+				w.walk(v[key], field, join(path, key))
+			}
+		}
+	}
+}
+
+// sortedDeprecations returns the deprecated fields sorted by path.
+func (w *fieldWalker) sortedDeprecations() []v1alpha1.Deprecation {
+	sort.Slice(w.deprecated, func(i, j int) bool {
+		return w.deprecated[i].Path < w.deprecated[j].Path
+	})
+
+	return w.deprecated
+}
+
+func join(path, key string) string {
+	if path == "" {
+		return key
+	}
+
+	return path + "." + key
+}
+
+// jsonFields returns the types of the fields of a struct by their JSON name,
+// including the fields of the embedded structs.
+func jsonFields(t reflect.Type) map[string]reflect.Type {
+	fields := make(map[string]reflect.Type, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		name := strings.Split(field.Tag.Get("json"), ",")[0]
+		if name == "-" || (field.PkgPath != "" && !field.Anonymous) {
+			continue
+		}
+
+		fieldType := field.Type
+		for fieldType.Kind() == reflect.Ptr {
+			fieldType = fieldType.Elem()
+		}
+		if field.Anonymous && name == "" && fieldType.Kind() == reflect.Struct {
+			for embeddedName, embeddedType := range jsonFields(fieldType) {
+				if _, ok := fields[embeddedName]; !ok {
+					fields[embeddedName] = embeddedType
+				}
+			}
+			continue
+		}
+
+		if name == "" {
+			name = field.Name
+		}
+		fields[name] = field.Type
+	}
+
+	return fields
+}
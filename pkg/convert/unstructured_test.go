@@ -0,0 +1,110 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package convert
+
+import (
+	"reflect"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	networkingv1beta1 "github.com/banzaicloud/istio-client-go/pkg/networking/v1beta1"
+)
+
+func newVirtualService(spec map[string]interface{}) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "networking.istio.io/v1beta1",
+		"kind":       "VirtualService",
+		"metadata": map[string]interface{}{
+			"name":              "reviews",
+			"namespace":         "default",
+			"creationTimestamp": nil,
+		},
+		"spec": spec,
+	}}
+}
+
+func TestFromUnstructuredStrict(t *testing.T) {
+	u := newVirtualService(map[string]interface{}{
+		"hosts": []interface{}{"reviews"},
+		"htpp":  []interface{}{},
+		"http": []interface{}{
+			map[string]interface{}{
+				"mirror_percent": int64(50),
+				"route": []interface{}{
+					map[string]interface{}{"destination": map[string]interface{}{"host": "reviews", "subnet": "v1"}},
+				},
+			},
+		},
+	})
+
+	out := &networkingv1beta1.VirtualService{}
+	if err := FromUnstructured(u, out); err != nil {
+		t.Fatal(err)
+	}
+	if out.Spec.HTTP[0].Route[0].Destination.Host != "reviews" {
+		t.Fatalf("unexpected spec %+v", out.Spec)
+	}
+
+	err := FromUnstructuredStrict(u, &networkingv1beta1.VirtualService{})
+	unknown, ok := err.(*UnknownFieldsError)
+	if !ok {
+		t.Fatalf("expected unknown fields, got %v", err)
+	}
+	if expected := []string{"spec.htpp", "spec.http[0].route[0].destination.subnet"}; !reflect.DeepEqual(unknown.Fields, expected) {
+		t.Fatalf("unexpected unknown fields %v, expected %v", unknown.Fields, expected)
+	}
+}
+
+func TestDeprecatedFields(t *testing.T) {
+	u := newVirtualService(map[string]interface{}{
+		"hosts": []interface{}{"reviews"},
+		"http": []interface{}{
+			map[string]interface{}{
+				"mirror_percent": int64(50),
+				"appendHeaders":  map[string]interface{}{"x-version": "v1"},
+				"corsPolicy":     map[string]interface{}{"allowOrigin": []interface{}{"*"}},
+			},
+		},
+	})
+
+	expected := []string{"spec.http[0].appendHeaders", "spec.http[0].corsPolicy.allowOrigin", "spec.http[0].mirror_percent"}
+	if fields := DeprecatedFields(u, &networkingv1beta1.VirtualService{}); !reflect.DeepEqual(fields, expected) {
+		t.Fatalf("unexpected deprecated fields %v, expected %v", fields, expected)
+	}
+}
+
+func TestToTypedRoundTrip(t *testing.T) {
+	u := newVirtualService(map[string]interface{}{
+		"hosts": []interface{}{"reviews"},
+	})
+
+	obj, err := ToTyped(u, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	vs, ok := obj.(*networkingv1beta1.VirtualService)
+	if !ok || vs.Spec.Hosts[0] != "reviews" {
+		t.Fatalf("unexpected object %#v", obj)
+	}
+
+	back, err := ToUnstructured(vs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(back.Object["spec"], u.Object["spec"]) {
+		t.Fatalf("round trip changed the spec: %v != %v", back.Object["spec"], u.Object["spec"])
+	}
+}
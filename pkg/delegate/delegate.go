@@ -0,0 +1,337 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package delegate flattens the HTTP routes of the virtual services which
+// delegate some of their routes to other virtual services, the way Istio
+// merges them, e.g.
+//
+//	routes, errs := delegate.Flatten(root, func(namespace, name string) (*networkingv1beta1.VirtualService, bool) {
+//		vs, err := lister.VirtualServices(namespace).Get(name)
+//		return vs, err == nil
+//	})
+//
+// Each delegating route is replaced by the routes of its delegate, whose
+// match requests are merged with those of the delegating route: the conditions
+// the delegate does not set are inherited, and the delegate routes whose
+// conditions are not a subset of those of the delegating route are dropped as
+// conflicting. The other fields the delegate routes do not set, e.g. the
+// timeout, are inherited too.
+//
+// Istio only supports one level of delegation. The chains of delegates are
+// followed nonetheless, and the cycles are reported.
+package delegate
+
+import (
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/banzaicloud/istio-client-go/pkg/common/v1alpha1"
+	networkingv1beta1 "github.com/banzaicloud/istio-client-go/pkg/networking/v1beta1"
+)
+
+// Lookup returns the virtual service of the namespace with the name, or false
+// if there is none.
+type Lookup func(namespace, name string) (*networkingv1beta1.VirtualService, bool)
+
+// Flatten returns the HTTP routes of the virtual service with its delegating
+// routes replaced by the routes of their delegates. The routes are copies, so
+// the virtual services are not modified. The errors report the missing
+// delegates, which cannot be used as such, the cycles and the conflicting
+// routes, which are dropped, at the path of the delegating route, e.g.
+// spec.http[1].delegate.
+func Flatten(vs *networkingv1beta1.VirtualService, lookup Lookup) ([]networkingv1beta1.HTTPRoute, field.ErrorList) {
+	f := flattener{lookup: lookup}
+	return f.flatten(vs, field.NewPath("spec", "http"), []string{key(vs.Namespace, vs.Name)})
+}
+
+type flattener struct {
+	lookup Lookup
+	errs   field.ErrorList
+}
+
+func (f *flattener) flatten(vs *networkingv1beta1.VirtualService, path *field.Path, chain []string) ([]networkingv1beta1.HTTPRoute, field.ErrorList) {
+	var routes []networkingv1beta1.HTTPRoute
+	for i := range vs.Spec.HTTP {
+		route := vs.Spec.HTTP[i].DeepCopy()
+		if route.Delegate == nil {
+			routes = append(routes, *route)
+			continue
+		}
+
+		delegatePath := path.Index(i).Child("delegate")
+		namespace := route.Delegate.Namespace
+		if namespace == "" {
+			namespace = vs.Namespace
+		}
+		name := key(namespace, route.Delegate.Name)
+		next := append(append([]string(nil), chain...), name)
+		if contains(chain, name) {
+			f.errs = append(f.errs, field.Invalid(delegatePath, name, fmt.Sprintf("delegation cycle %s", strings.Join(next, " -> "))))
+			continue
+		}
+		delegate, ok := f.lookup(namespace, route.Delegate.Name)
+		switch {
+		case !ok || delegate == nil:
+			f.errs = append(f.errs, field.NotFound(delegatePath, name))
+			continue
+		case len(delegate.Spec.Hosts) > 0:
+			f.errs = append(f.errs, field.Invalid(delegatePath, name, "a virtual service with hosts cannot be a delegate"))
+			continue
+		case !exportedTo(delegate, vs.Namespace):
+			f.errs = append(f.errs, field.Invalid(delegatePath, name, fmt.Sprintf("the delegate is not exported to the namespace %s", vs.Namespace)))
+			continue
+		}
+
+		delegateRoutes, _ := f.flatten(delegate, delegatePath.Child("http"), next)
+		for j := range delegateRoutes {
+			merged, ok := merge(route, &delegateRoutes[j])
+			if !ok {
+				f.errs = append(f.errs, field.Invalid(delegatePath, name, fmt.Sprintf("the matches of the route %d of the delegate conflict with the matches of the route", j)))
+				continue
+			}
+			routes = append(routes, *merged)
+		}
+	}
+
+	return routes, f.errs
+}
+
+func key(namespace, name string) string {
+	return namespace + "/" + name
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// exportedTo returns true if the virtual service is exported to the
+// namespace.
+func exportedTo(vs *networkingv1beta1.VirtualService, namespace string) bool {
+	if len(vs.Spec.ExportTo) == 0 {
+		return true
+	}
+	for _, to := range vs.Spec.ExportTo {
+		if to == "*" || to == namespace || (to == "." && vs.Namespace == namespace) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// merge returns the delegate route with the matches and the fields of the
+// root route it does not set, or false if every match conflicts.
+func merge(root, delegate *networkingv1beta1.HTTPRoute) (*networkingv1beta1.HTTPRoute, bool) {
+	out := delegate.DeepCopy()
+
+	switch {
+	case len(root.Match) == 0:
+	case len(delegate.Match) == 0:
+		out.Match = root.DeepCopy().Match
+	default:
+		out.Match = nil
+		for _, rootMatch := range root.Match {
+			for _, delegateMatch := range delegate.Match {
+				if rootMatch == nil || delegateMatch == nil || conflict(rootMatch, delegateMatch) {
+					continue
+				}
+				out.Match = append(out.Match, mergeMatch(rootMatch, delegateMatch))
+			}
+		}
+		if len(out.Match) == 0 {
+			return nil, false
+		}
+	}
+
+	switch {
+	case out.Name == nil:
+		out.Name = root.Name
+	case root.Name != nil:
+		name := *root.Name + "-" + *out.Name
+		out.Name = &name
+	}
+	if out.Rewrite == nil {
+		out.Rewrite = root.Rewrite
+	}
+	if out.Timeout == nil {
+		out.Timeout = root.Timeout
+	}
+	if out.Retries == nil {
+		out.Retries = root.Retries
+	}
+	if out.Fault == nil {
+		out.Fault = root.Fault
+	}
+	if out.Mirror == nil {
+		out.Mirror = root.Mirror
+	}
+	if out.MirrorPercent == nil {
+		out.MirrorPercent = root.MirrorPercent
+	}
+	if out.MirrorPercentage == nil {
+		out.MirrorPercentage = root.MirrorPercentage
+	}
+	if out.CorsPolicy == nil {
+		out.CorsPolicy = root.CorsPolicy
+	}
+	if out.Headers == nil {
+		out.Headers = root.Headers
+	}
+
+	return out.DeepCopy(), true
+}
+
+// mergeMatch returns the delegate match with the conditions of the root
+// match it does not set.
+func mergeMatch(root, delegate *networkingv1beta1.HTTPMatchRequest) *networkingv1beta1.HTTPMatchRequest {
+	out := delegate.DeepCopy()
+	root = root.DeepCopy()
+
+	switch {
+	case out.Name == nil:
+		out.Name = root.Name
+	case root.Name != nil:
+		name := *root.Name + "-" + *out.Name
+		out.Name = &name
+	}
+	if out.URI == nil {
+		out.URI = root.URI
+	}
+	if out.Scheme == nil {
+		out.Scheme = root.Scheme
+	}
+	if out.Method == nil {
+		out.Method = root.Method
+	}
+	if out.Authority == nil {
+		out.Authority = root.Authority
+	}
+	if out.Port == nil {
+		out.Port = root.Port
+	}
+	if out.IgnoreURICase == nil {
+		out.IgnoreURICase = root.IgnoreURICase
+	}
+	if len(out.Gateways) == 0 {
+		out.Gateways = root.Gateways
+	}
+	for name, match := range root.Headers {
+		if _, ok := out.Headers[name]; !ok {
+			if out.Headers == nil {
+				out.Headers = map[string]v1alpha1.StringMatch{}
+			}
+			out.Headers[name] = match
+		}
+	}
+	for name, match := range root.QueryParams {
+		if _, ok := out.QueryParams[name]; !ok {
+			if out.QueryParams == nil {
+				out.QueryParams = map[string]*v1alpha1.StringMatch{}
+			}
+			out.QueryParams[name] = match
+		}
+	}
+	for name, value := range root.SourceLabels {
+		if _, ok := out.SourceLabels[name]; !ok {
+			if out.SourceLabels == nil {
+				out.SourceLabels = map[string]string{}
+			}
+			out.SourceLabels[name] = value
+		}
+	}
+
+	return out
+}
+
+// conflict returns true if the delegate match is not a subset of the root
+// match, i.e. if it sets a condition the root sets too to a value the root
+// does not match.
+func conflict(root, delegate *networkingv1beta1.HTTPMatchRequest) bool {
+	if stringMatchConflict(root.URI, delegate.URI) || stringMatchConflict(root.Scheme, delegate.Scheme) ||
+		stringMatchConflict(root.Method, delegate.Method) || stringMatchConflict(root.Authority, delegate.Authority) {
+		return true
+	}
+	if root.Port != nil && delegate.Port != nil && *root.Port != *delegate.Port {
+		return true
+	}
+	if (root.IgnoreURICase != nil && *root.IgnoreURICase) != (delegate.IgnoreURICase != nil && *delegate.IgnoreURICase) {
+		return true
+	}
+	for name, match := range root.Headers {
+		if delegateMatch, ok := delegate.Headers[name]; ok {
+			match := match
+			if stringMatchConflict(&match, &delegateMatch) {
+				return true
+			}
+		}
+	}
+	for name, match := range root.QueryParams {
+		if stringMatchConflict(match, delegate.QueryParams[name]) {
+			return true
+		}
+	}
+	for name, value := range root.SourceLabels {
+		if delegateValue, ok := delegate.SourceLabels[name]; ok && delegateValue != value {
+			return true
+		}
+	}
+	if len(root.Gateways) > 0 {
+		for _, gateway := range delegate.Gateways {
+			if !contains(root.Gateways, gateway) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// stringMatchConflict returns true if both matches are set and the leaf
+// match may match strings the root does not. Regular expressions conflict
+// with anything but themselves.
+func stringMatchConflict(root, leaf *v1alpha1.StringMatch) bool {
+	if root == nil || leaf == nil {
+		return false
+	}
+	if root.Regex != "" || leaf.Regex != "" {
+		return *root != *leaf
+	}
+
+	switch {
+	case root.Exact != "":
+		return leaf.Exact != root.Exact
+	case root.Prefix != "":
+		value := leaf.Exact
+		if value == "" {
+			value = leaf.Prefix
+		}
+		return !strings.HasPrefix(value, root.Prefix)
+	case root.Suffix != "":
+		value := leaf.Exact
+		if value == "" {
+			value = leaf.Suffix
+		}
+		return !strings.HasSuffix(value, root.Suffix)
+	default:
+		return false
+	}
+}
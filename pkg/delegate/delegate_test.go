@@ -0,0 +1,161 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package delegate
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/banzaicloud/istio-client-go/pkg/common/v1alpha1"
+	networkingv1beta1 "github.com/banzaicloud/istio-client-go/pkg/networking/v1beta1"
+)
+
+func virtualService(namespace, name string, routes ...networkingv1beta1.HTTPRoute) *networkingv1beta1.VirtualService {
+	return &networkingv1beta1.VirtualService{
+		ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+		Spec:       networkingv1beta1.VirtualServiceSpec{HTTP: routes},
+	}
+}
+
+func route(host string, matches ...*networkingv1beta1.HTTPMatchRequest) networkingv1beta1.HTTPRoute {
+	return networkingv1beta1.HTTPRoute{
+		Match: matches,
+		Route: []*networkingv1beta1.HTTPRouteDestination{{Destination: &networkingv1beta1.Destination{Host: host}}},
+	}
+}
+
+func delegating(namespace, name string, matches ...*networkingv1beta1.HTTPMatchRequest) networkingv1beta1.HTTPRoute {
+	return networkingv1beta1.HTTPRoute{
+		Match:    matches,
+		Delegate: &networkingv1beta1.Delegate{Name: name, Namespace: namespace},
+	}
+}
+
+func lookup(services ...*networkingv1beta1.VirtualService) Lookup {
+	return func(namespace, name string) (*networkingv1beta1.VirtualService, bool) {
+		for _, vs := range services {
+			if vs.Namespace == namespace && vs.Name == name {
+				return vs, true
+			}
+		}
+		return nil, false
+	}
+}
+
+func TestFlatten(t *testing.T) {
+	productpage := virtualService("nsA", "productpage",
+		route("productpage-v1", &networkingv1beta1.HTTPMatchRequest{URI: v1alpha1.Prefix("/productpage/v1/")}),
+		route("productpage-v2", &networkingv1beta1.HTTPMatchRequest{URI: v1alpha1.Prefix("/v2/")}),
+		route("productpage"),
+	)
+	productpage.Spec.HTTP[2].Timeout = v1alpha1.NewDuration(time.Second)
+	root := virtualService("istio-system", "bookinfo",
+		delegating("nsA", "productpage", &networkingv1beta1.HTTPMatchRequest{
+			URI:     v1alpha1.Prefix("/productpage"),
+			Headers: map[string]v1alpha1.StringMatch{"end-user": {Exact: "jason"}},
+		}),
+		delegating("nsB", "reviews", &networkingv1beta1.HTTPMatchRequest{URI: v1alpha1.Prefix("/reviews")}),
+		route("details"),
+	)
+	root.Spec.Hosts = []string{"bookinfo.com"}
+	root.Spec.HTTP[0].Timeout = v1alpha1.NewDuration(5 * time.Second)
+	before := root.DeepCopy()
+
+	routes, errs := Flatten(root, lookup(productpage))
+	expected := []networkingv1beta1.HTTPRoute{
+		route("productpage-v1", &networkingv1beta1.HTTPMatchRequest{
+			URI:     v1alpha1.Prefix("/productpage/v1/"),
+			Headers: map[string]v1alpha1.StringMatch{"end-user": {Exact: "jason"}},
+		}),
+		route("productpage", &networkingv1beta1.HTTPMatchRequest{
+			URI:     v1alpha1.Prefix("/productpage"),
+			Headers: map[string]v1alpha1.StringMatch{"end-user": {Exact: "jason"}},
+		}),
+		route("details"),
+	}
+	expected[0].Timeout = v1alpha1.NewDuration(5 * time.Second)
+	expected[1].Timeout = v1alpha1.NewDuration(time.Second)
+	if !reflect.DeepEqual(routes, expected) {
+		t.Errorf("unexpected routes %+v, expected %+v", routes, expected)
+	}
+
+	expectedErrors := []string{
+		`spec.http[0].delegate: Invalid value: "nsA/productpage": the matches of the route 1 of the delegate conflict with the matches of the route`,
+		`spec.http[1].delegate: Not found: "nsB/reviews"`,
+	}
+	if len(errs) != len(expectedErrors) {
+		t.Fatalf("unexpected errors %v", errs)
+	}
+	for i, err := range errs {
+		if err.Error() != expectedErrors[i] {
+			t.Errorf("unexpected error %q, expected %q", err, expectedErrors[i])
+		}
+	}
+	if !reflect.DeepEqual(root, before) {
+		t.Error("the root virtual service was modified")
+	}
+}
+
+func TestFlattenCycle(t *testing.T) {
+	a := virtualService("prod", "a", delegating("", "b"))
+	b := virtualService("prod", "b", delegating("", "a"), route("b"))
+
+	routes, errs := Flatten(a, lookup(a, b))
+	if !reflect.DeepEqual(routes, []networkingv1beta1.HTTPRoute{route("b")}) {
+		t.Errorf("unexpected routes %+v", routes)
+	}
+	expected := `spec.http[0].delegate.http[0].delegate: Invalid value: "prod/a": delegation cycle prod/a -> prod/b -> prod/a`
+	if len(errs) != 1 || errs[0].Error() != expected {
+		t.Errorf("unexpected errors %v", errs)
+	}
+}
+
+func TestFlattenInvalidDelegates(t *testing.T) {
+	withHosts := virtualService("prod", "hosts", route("reviews"))
+	withHosts.Spec.Hosts = []string{"reviews"}
+	private := virtualService("other", "private", route("reviews"))
+	private.Spec.ExportTo = []string{"."}
+	root := virtualService("prod", "root", delegating("", "hosts"), delegating("other", "private"))
+
+	routes, errs := Flatten(root, lookup(withHosts, private))
+	if len(routes) != 0 || len(errs) != 2 {
+		t.Errorf("unexpected routes %+v and errors %v", routes, errs)
+	}
+}
+
+func TestStringMatchConflict(t *testing.T) {
+	tests := []struct {
+		root, leaf *v1alpha1.StringMatch
+		expected   bool
+	}{
+		{nil, v1alpha1.Exact("/a"), false},
+		{v1alpha1.Exact("/a"), v1alpha1.Exact("/a"), false},
+		{v1alpha1.Exact("/a"), v1alpha1.Prefix("/a"), true},
+		{v1alpha1.Prefix("/a"), v1alpha1.Exact("/a/b"), false},
+		{v1alpha1.Prefix("/a"), v1alpha1.Prefix("/b"), true},
+		{v1alpha1.Suffix(".js"), v1alpha1.Suffix("app.js"), false},
+		{v1alpha1.Regex("/a.*"), v1alpha1.Prefix("/a"), true},
+		{v1alpha1.Regex("/a.*"), v1alpha1.Regex("/a.*"), false},
+	}
+
+	for _, test := range tests {
+		if conflict := stringMatchConflict(test.root, test.leaf); conflict != test.expected {
+			t.Errorf("%+v %+v: unexpected result %t", test.root, test.leaf, conflict)
+		}
+	}
+}
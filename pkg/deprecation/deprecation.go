@@ -0,0 +1,244 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package deprecation reports the deprecated fields, values and kinds Istio
+// resources use, along with their replacements, so that platforms can warn
+// their users before upgrading Istio removes them.
+package deprecation
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	authenticationv1alpha1 "github.com/banzaicloud/istio-client-go/pkg/authentication/v1alpha1"
+	"github.com/banzaicloud/istio-client-go/pkg/convert"
+	networkingv1alpha3 "github.com/banzaicloud/istio-client-go/pkg/networking/v1alpha3"
+	networkingv1beta1 "github.com/banzaicloud/istio-client-go/pkg/networking/v1beta1"
+)
+
+// Usage is a deprecated field, field value or kind used by a resource.
+type Usage struct {
+	// Path is the path of the field, e.g. spec.http[0].mirrorPercent, or
+	// kind for a deprecated kind
+	Path string
+	// Value is the deprecated value of the field if only the value is
+	// deprecated, e.g. LEAST_CONN, or the deprecated kind, e.g. Policy
+	Value string
+	// Replacement is the path of the field, or the value, to use instead,
+	// e.g. spec.http[0].mirrorPercentage or LEAST_REQUEST
+	Replacement string
+}
+
+func (u Usage) String() string {
+	if u.Value != "" {
+		return fmt.Sprintf("%s: %s is deprecated, use %s instead", u.Path, u.Value, u.Replacement)
+	}
+
+	return fmt.Sprintf("%s is deprecated, use %s instead", u.Path, u.Replacement)
+}
+
+// field is a deprecated field of a type, or a deprecated value of the field
+// if value is set.
+type field struct {
+	name        string
+	value       string
+	replacement string
+}
+
+// fields are the deprecated fields of the types, by type, in both API
+// versions where the type exists.
+var fields = map[reflect.Type][]field{}
+
+// kinds are the replacements of the deprecated kinds.
+var kinds = map[reflect.Type]string{
+	reflect.TypeOf(authenticationv1alpha1.Policy{}):     "PeerAuthentication and RequestAuthentication",
+	reflect.TypeOf(authenticationv1alpha1.MeshPolicy{}): "PeerAuthentication in the root namespace",
+}
+
+// legacyFields are the replacements of the field names only older manifests
+// spell, which the types decode into their replacements, so that only the
+// unstructured objects still have them.
+var legacyFields = map[string]string{
+	"mirror_percent":        "mirrorPercentage",
+	"appendHeaders":         "headers.request.add",
+	"appendRequestHeaders":  "headers.request.add",
+	"removeRequestHeaders":  "headers.request.remove",
+	"appendResponseHeaders": "headers.response.add",
+	"removeResponseHeaders": "headers.response.remove",
+}
+
+func init() {
+	deprecate := func(value interface{}, deprecated ...field) {
+		t := reflect.TypeOf(value)
+		fields[t] = append(fields[t], deprecated...)
+	}
+
+	for _, route := range []interface{}{networkingv1beta1.HTTPRoute{}, networkingv1alpha3.HTTPRoute{}} {
+		deprecate(route, field{name: "mirrorPercent", replacement: "mirrorPercentage"})
+	}
+	for _, policy := range []interface{}{networkingv1beta1.CorsPolicy{}, networkingv1alpha3.CorsPolicy{}} {
+		deprecate(policy, field{name: "allowOrigin", replacement: "allowOrigins"})
+	}
+	for _, detection := range []interface{}{networkingv1beta1.OutlierDetection{}, networkingv1alpha3.OutlierDetection{}} {
+		deprecate(detection, field{name: "consecutiveErrors", replacement: "consecutive5xxErrors"})
+	}
+	for _, lb := range []interface{}{networkingv1beta1.LoadBalancerSettings{}, networkingv1alpha3.LoadBalancerSettings{}} {
+		deprecate(lb, field{name: "simple", value: "LEAST_CONN", replacement: "LEAST_REQUEST"})
+	}
+}
+
+// Check returns the deprecated fields, field values and kinds the object
+// uses, in the order of the fields of its type. Lists are checked item by
+// item, with the paths of the fields prefixed with items[i], and unstructured
+// objects are also checked for the field names only older manifests spell,
+// e.g. mirror_percent. Kinds this module does not model have no usages.
+func Check(obj runtime.Object) []Usage {
+	if meta.IsListType(obj) {
+		items, err := meta.ExtractList(obj)
+		if err != nil {
+			return nil
+		}
+		var usages []Usage
+		for i, item := range items {
+			prefix := fmt.Sprintf("items[%d].", i)
+			for _, usage := range Check(item) {
+				usage.Path = prefix + usage.Path
+				if usage.Value == "" {
+					usage.Replacement = prefix + usage.Replacement
+				}
+				usages = append(usages, usage)
+			}
+		}
+		return usages
+	}
+
+	if u, ok := obj.(*unstructured.Unstructured); ok {
+		return checkUnstructured(u)
+	}
+
+	v := reflect.ValueOf(obj)
+	if v.Kind() != reflect.Ptr || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+		return nil
+	}
+	v = v.Elem()
+
+	var usages []Usage
+	if replacement, ok := kinds[v.Type()]; ok {
+		usages = append(usages, Usage{Path: "kind", Value: v.Type().Name(), Replacement: replacement})
+	}
+	if spec := v.FieldByName("Spec"); spec.IsValid() {
+		usages = walk(usages, spec, "spec")
+	}
+
+	return usages
+}
+
+// checkUnstructured checks the typed object of the kind of the unstructured
+// object, and its legacy field names.
+func checkUnstructured(u *unstructured.Unstructured) []Usage {
+	obj, err := convert.ToTyped(u, false)
+	if err != nil {
+		return nil
+	}
+
+	usages := Check(obj)
+	for _, path := range convert.DeprecatedFields(u, obj) {
+		i := strings.LastIndex(path, ".")
+		if replacement, ok := legacyFields[path[i+1:]]; ok {
+			usages = append(usages, Usage{Path: path, Replacement: path[:i+1] + replacement})
+		}
+	}
+
+	return usages
+}
+
+// walk appends the usages of the value and the values it contains to usages.
+func walk(usages []Usage, v reflect.Value, path string) []Usage {
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if !v.IsNil() {
+			usages = walk(usages, v.Elem(), path)
+		}
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			usages = walk(usages, v.Index(i), fmt.Sprintf("%s[%d]", path, i))
+		}
+	case reflect.Map:
+		keys := v.MapKeys()
+		sort.Slice(keys, func(i, j int) bool {
+			return fmt.Sprint(keys[i].Interface()) < fmt.Sprint(keys[j].Interface())
+		})
+		for _, key := range keys {
+			usages = walk(usages, v.MapIndex(key), fmt.Sprintf("%s.%v", path, key.Interface()))
+		}
+	case reflect.Struct:
+		usages = walkStruct(usages, v, path)
+	}
+
+	return usages
+}
+
+func walkStruct(usages []Usage, v reflect.Value, path string) []Usage {
+	deprecated := fields[v.Type()]
+	for i := 0; i < v.NumField(); i++ {
+		f := v.Type().Field(i)
+		if f.PkgPath != "" {
+			continue
+		}
+		name := strings.Split(f.Tag.Get("json"), ",")[0]
+		if name == "-" {
+			continue
+		}
+		if name == "" {
+			// inlined fields keep the path of their struct
+			usages = walk(usages, v.Field(i), path)
+			continue
+		}
+
+		fieldPath := path + "." + name
+		for _, d := range deprecated {
+			if d.name == name && isUsed(v.Field(i), d.value) {
+				usage := Usage{Path: fieldPath, Value: d.value, Replacement: d.replacement}
+				if d.value == "" {
+					usage.Replacement = path + "." + d.replacement
+				}
+				usages = append(usages, usage)
+			}
+		}
+		usages = walk(usages, v.Field(i), fieldPath)
+	}
+
+	return usages
+}
+
+// isUsed returns true if the field is set, to the value if it is not empty.
+func isUsed(v reflect.Value, value string) bool {
+	if value == "" {
+		return !v.IsZero()
+	}
+	for v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return false
+		}
+		v = v.Elem()
+	}
+
+	return v.Kind() == reflect.String && v.String() == value
+}
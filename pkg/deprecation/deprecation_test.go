@@ -0,0 +1,109 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deprecation
+
+import (
+	"reflect"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	authenticationv1alpha1 "github.com/banzaicloud/istio-client-go/pkg/authentication/v1alpha1"
+	networkingv1alpha3 "github.com/banzaicloud/istio-client-go/pkg/networking/v1alpha3"
+	networkingv1beta1 "github.com/banzaicloud/istio-client-go/pkg/networking/v1beta1"
+)
+
+func TestCheck(t *testing.T) {
+	mirrorPercent := uint32(50)
+	leastConn := networkingv1beta1.SimpleLBLeastConn
+	tests := []struct {
+		name     string
+		obj      runtime.Object
+		expected []Usage
+	}{
+		{
+			name: "virtual service",
+			obj: &networkingv1beta1.VirtualService{Spec: networkingv1beta1.VirtualServiceSpec{
+				HTTP: []networkingv1beta1.HTTPRoute{
+					{},
+					{MirrorPercent: &mirrorPercent, CorsPolicy: &networkingv1beta1.CorsPolicy{AllowOrigin: []string{"*"}}},
+				},
+			}},
+			expected: []Usage{
+				{Path: "spec.http[1].mirrorPercent", Replacement: "spec.http[1].mirrorPercentage"},
+				{Path: "spec.http[1].corsPolicy.allowOrigin", Replacement: "spec.http[1].corsPolicy.allowOrigins"},
+			},
+		},
+		{
+			name: "destination rule",
+			obj: &networkingv1beta1.DestinationRule{Spec: networkingv1beta1.DestinationRuleSpec{
+				TrafficPolicy: &networkingv1beta1.TrafficPolicy{TrafficPolicyCommon: networkingv1beta1.TrafficPolicyCommon{
+					LoadBalancer:     &networkingv1beta1.LoadBalancerSettings{Simple: &leastConn},
+					OutlierDetection: &networkingv1beta1.OutlierDetection{ConsecutiveErrors: 5},
+				}},
+			}},
+			expected: []Usage{
+				{Path: "spec.trafficPolicy.loadBalancer.simple", Value: "LEAST_CONN", Replacement: "LEAST_REQUEST"},
+				{Path: "spec.trafficPolicy.outlierDetection.consecutiveErrors", Replacement: "spec.trafficPolicy.outlierDetection.consecutive5xxErrors"},
+			},
+		},
+		{
+			name: "list",
+			obj: &networkingv1alpha3.VirtualServiceList{Items: []networkingv1alpha3.VirtualService{
+				{},
+				{Spec: networkingv1alpha3.VirtualServiceSpec{HTTP: []networkingv1alpha3.HTTPRoute{{MirrorPercent: &mirrorPercent}}}},
+			}},
+			expected: []Usage{
+				{Path: "items[1].spec.http[0].mirrorPercent", Replacement: "items[1].spec.http[0].mirrorPercentage"},
+			},
+		},
+		{
+			name:     "kind",
+			obj:      &authenticationv1alpha1.Policy{},
+			expected: []Usage{{Path: "kind", Value: "Policy", Replacement: "PeerAuthentication and RequestAuthentication"}},
+		},
+		{
+			name: "unstructured",
+			obj: &unstructured.Unstructured{Object: map[string]interface{}{
+				"apiVersion": "networking.istio.io/v1beta1",
+				"kind":       "VirtualService",
+				"spec": map[string]interface{}{
+					"http": []interface{}{map[string]interface{}{"appendHeaders": map[string]interface{}{"x-version": "v1"}}},
+				},
+			}},
+			expected: []Usage{
+				{Path: "spec.http[0].appendHeaders", Replacement: "spec.http[0].headers.request.add"},
+			},
+		},
+		{
+			name: "current fields",
+			obj:  &networkingv1beta1.VirtualService{Spec: networkingv1beta1.VirtualServiceSpec{Hosts: []string{"reviews"}}},
+		},
+	}
+
+	for _, test := range tests {
+		if usages := Check(test.obj); !reflect.DeepEqual(usages, test.expected) {
+			t.Errorf("%s: unexpected usages %v, expected %v", test.name, usages, test.expected)
+		}
+	}
+}
+
+func TestUsageString(t *testing.T) {
+	usage := Usage{Path: "spec.trafficPolicy.loadBalancer.simple", Value: "LEAST_CONN", Replacement: "LEAST_REQUEST"}
+	if s := usage.String(); s != "spec.trafficPolicy.loadBalancer.simple: LEAST_CONN is deprecated, use LEAST_REQUEST instead" {
+		t.Errorf("unexpected message %q", s)
+	}
+}
@@ -0,0 +1,175 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package diff compares Istio resources field by field, for logging the
+// changes made while reconciling them.
+package diff
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// Change is a field of which the value differs between two resources.
+type Change struct {
+	// Path is the path of the field, e.g. spec.http[0].route[1].weight
+	Path string
+	// Old is the old value of the field, nil if the field was added.
+	Old interface{}
+	// New is the new value of the field, nil if the field was removed.
+	New interface{}
+}
+
+// String formats the change as "path: old → new", e.g.
+// spec.http[0].route[1].weight: 75 → 50
+func (c Change) String() string {
+	return fmt.Sprintf("%s: %s → %s", c.Path, format(c.Old), format(c.New))
+}
+
+// Changes are the changes between two resources, in the order of their
+// paths.
+type Changes []Change
+
+// String formats the changes one per line.
+func (c Changes) String() string {
+	lines := make([]string, 0, len(c))
+	for _, change := range c {
+		lines = append(lines, change.String())
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// Diff returns the changes of the fields between the old and the new value,
+// which may be any type of resource or part of it encoded as JSON, e.g. two
+// virtual services or two of their specs. Lists are compared item by item,
+// so an item inserted in the middle of a list changes the ones after it.
+func Diff(old, new interface{}) (Changes, error) {
+	oldValue, err := toJSONValue(old)
+	if err != nil {
+		return nil, err
+	}
+	newValue, err := toJSONValue(new)
+	if err != nil {
+		return nil, err
+	}
+
+	var changes Changes
+	compare(nil, oldValue, newValue, &changes)
+
+	return changes, nil
+}
+
+func toJSONValue(obj interface{}) (interface{}, error) {
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return nil, err
+	}
+	var value interface{}
+	if err := json.Unmarshal(data, &value); err != nil {
+		return nil, err
+	}
+
+	return value, nil
+}
+
+func compare(path *field.Path, old, new interface{}, changes *Changes) {
+	switch oldValue := old.(type) {
+	case map[string]interface{}:
+		if newValue, ok := new.(map[string]interface{}); ok {
+			compareMaps(path, oldValue, newValue, changes)
+			return
+		}
+	case []interface{}:
+		if newValue, ok := new.([]interface{}); ok {
+			compareLists(path, oldValue, newValue, changes)
+			return
+		}
+	}
+
+	if !reflect.DeepEqual(old, new) {
+		*changes = append(*changes, Change{Path: pathString(path), Old: old, New: new})
+	}
+}
+
+func compareMaps(path *field.Path, old, new map[string]interface{}, changes *Changes) {
+	keys := make([]string, 0, len(old)+len(new))
+	for key := range old {
+		keys = append(keys, key)
+	}
+	for key := range new {
+		if _, ok := old[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		var child *field.Path
+		if path == nil {
+			child = field.NewPath(key)
+		} else {
+			child = path.Child(key)
+		}
+		compare(child, old[key], new[key], changes)
+	}
+}
+
+func compareLists(path *field.Path, old, new []interface{}, changes *Changes) {
+	if path == nil {
+		// the lists are the values themselves, which have no path
+		path = field.NewPath("")
+	}
+	for i := 0; i < len(old) || i < len(new); i++ {
+		var oldItem, newItem interface{}
+		if i < len(old) {
+			oldItem = old[i]
+		}
+		if i < len(new) {
+			newItem = new[i]
+		}
+		compare(path.Index(i), oldItem, newItem, changes)
+	}
+}
+
+func pathString(path *field.Path) string {
+	if path == nil {
+		return "<root>"
+	}
+
+	return path.String()
+}
+
+// format prints scalars as they are, and the other values as compact JSON.
+func format(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return "<unset>"
+	case string:
+		return v
+	case map[string]interface{}, []interface{}:
+		data, err := json.Marshal(v)
+		if err != nil {
+			return fmt.Sprint(v)
+		}
+		return string(data)
+	default:
+		return fmt.Sprint(v)
+	}
+}
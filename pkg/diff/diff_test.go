@@ -0,0 +1,63 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package diff
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/banzaicloud/istio-client-go/pkg/networking/v1beta1"
+)
+
+func TestDiff(t *testing.T) {
+	weight := func(weight int32) *int32 { return &weight }
+	old := &v1beta1.VirtualServiceSpec{
+		Hosts: []string{"reviews"},
+		HTTP: []v1beta1.HTTPRoute{{
+			Route: []*v1beta1.HTTPRouteDestination{
+				{Destination: &v1beta1.Destination{Host: "reviews"}, Weight: weight(25)},
+				{Destination: &v1beta1.Destination{Host: "ratings"}, Weight: weight(75)},
+			},
+		}},
+	}
+	new := old.DeepCopy()
+	new.Hosts = append(new.Hosts, "ratings")
+	new.Gateways = []string{"mesh"}
+	new.HTTP[0].Route[0].Weight = weight(50)
+	new.HTTP[0].Route[1].Weight = weight(50)
+
+	changes, err := Diff(old, new)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []string{
+		"gateways: <unset> → [\"mesh\"]",
+		"hosts[1]: <unset> → ratings",
+		"http[0].route[0].weight: 25 → 50",
+		"http[0].route[1].weight: 75 → 50",
+	}
+	var lines []string
+	for _, change := range changes {
+		lines = append(lines, change.String())
+	}
+	if !reflect.DeepEqual(lines, expected) {
+		t.Errorf("expected %v, got %v", expected, lines)
+	}
+
+	if changes, _ := Diff(old, old.DeepCopy()); len(changes) > 0 {
+		t.Errorf("expected no changes, got %v", changes)
+	}
+}
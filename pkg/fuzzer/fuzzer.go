@@ -0,0 +1,150 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package fuzzer fills the Istio resources with random values and checks that
+// they survive a JSON round-trip and a deep copy, to catch the fields of new
+// types with typos in their tags, or with encodings or copies that lose or
+// reject values, before they are released.
+package fuzzer
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"reflect"
+	"time"
+
+	fuzz "github.com/google/gofuzz"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	analysisv1alpha1 "github.com/banzaicloud/istio-client-go/pkg/analysis/v1alpha1"
+	"github.com/banzaicloud/istio-client-go/pkg/common/v1alpha1"
+	"github.com/banzaicloud/istio-client-go/pkg/diff"
+	networkingv1alpha3 "github.com/banzaicloud/istio-client-go/pkg/networking/v1alpha3"
+	networkingv1beta1 "github.com/banzaicloud/istio-client-go/pkg/networking/v1beta1"
+)
+
+// Funcs are the fuzz functions of the types that only some of the random
+// values are valid for, such as the string matches, of which at most one
+// field may be set.
+var Funcs = []interface{}{
+	func(m *metav1.ObjectMeta, c fuzz.Continue) {
+		// the managed fields do not round-trip, and are of no interest here
+		c.Fuzz(&m.Name)
+		c.Fuzz(&m.Namespace)
+		c.Fuzz(&m.UID)
+		c.Fuzz(&m.ResourceVersion)
+		c.Fuzz(&m.Generation)
+		c.Fuzz(&m.CreationTimestamp)
+		c.Fuzz(&m.Labels)
+		c.Fuzz(&m.Annotations)
+	},
+	func(m *v1alpha1.StringMatch, c fuzz.Continue) {
+		*m = v1alpha1.StringMatch{}
+		switch c.Intn(5) {
+		case 0:
+			m.Exact = c.RandString()
+		case 1:
+			m.Prefix = c.RandString()
+		case 2:
+			m.Suffix = c.RandString()
+		case 3:
+			m.Regex = c.RandString()
+		}
+	},
+	func(d *v1alpha1.Duration, c fuzz.Continue) {
+		// negative durations beyond the range of time.ParseDuration do not
+		// round-trip
+		d.Duration = time.Duration(c.Int63())
+	},
+	func(j *v1alpha1.JSON, c fuzz.Continue) {
+		// a null value is decoded as an unset one
+		value := map[string]string{}
+		for i := c.Intn(3); i >= 0; i-- {
+			value[c.RandString()] = c.RandString()
+		}
+		j.Raw, _ = json.Marshal(value)
+	},
+	func(p *networkingv1alpha3.CorsPolicy, c fuzz.Continue) {
+		// the origins of allowOrigin are added to allowOrigins when decoded
+		c.FuzzNoCustom(p)
+		for _, origin := range p.AllowOrigin {
+			if origin == "" {
+				continue
+			}
+			p.AllowOrigins = append(p.AllowOrigins, v1alpha1.Exact(origin))
+		}
+	},
+	func(p *networkingv1beta1.CorsPolicy, c fuzz.Continue) {
+		// the origins of allowOrigin are added to allowOrigins when decoded
+		c.FuzzNoCustom(p)
+		for _, origin := range p.AllowOrigin {
+			if origin == "" {
+				continue
+			}
+			p.AllowOrigins = append(p.AllowOrigins, v1alpha1.Exact(origin))
+		}
+	},
+	func(l *analysisv1alpha1.AnalysisMessageBase_Level, c fuzz.Continue) {
+		levels := []analysisv1alpha1.AnalysisMessageBase_Level{
+			analysisv1alpha1.AnalysisMessageBase_UNKNOWN,
+			analysisv1alpha1.AnalysisMessageBase_ERROR,
+			analysisv1alpha1.AnalysisMessageBase_WARNING,
+			analysisv1alpha1.AnalysisMessageBase_INFO,
+		}
+		*l = levels[c.Intn(len(levels))]
+	},
+}
+
+// New returns a fuzzer with the Funcs, seeded with seed. The slices and maps
+// it fills are either nil or not empty, since the empty ones are encoded the
+// same way as the nil ones.
+func New(seed int64) *fuzz.Fuzzer {
+	return fuzz.New().
+		RandSource(rand.NewSource(seed)).
+		NilChance(.2).
+		NumElements(1, 3).
+		Funcs(Funcs...)
+}
+
+// RoundTrip fills obj with random values, then checks that it is equal to its
+// deep copy, and to the object its JSON encoding decodes to. The error
+// includes the encoding of obj, so that a failure can be reproduced.
+func RoundTrip(f *fuzz.Fuzzer, obj runtime.Object) error {
+	f.Fuzz(obj)
+
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return fmt.Errorf("could not encode %T: %w", obj, err)
+	}
+
+	if copied := obj.DeepCopyObject(); !reflect.DeepEqual(obj, copied) {
+		return fmt.Errorf("the deep copy of %T differs from the original %s", obj, data)
+	}
+
+	decoded := reflect.New(reflect.TypeOf(obj).Elem()).Interface()
+	if err := json.Unmarshal(data, decoded); err != nil {
+		return fmt.Errorf("could not decode %T %s: %w", obj, data, err)
+	}
+	if !reflect.DeepEqual(obj, decoded) {
+		changes, err := diff.Diff(obj, decoded)
+		if err != nil {
+			return err
+		}
+		return fmt.Errorf("%T %s differs after a JSON round-trip:\n%s", obj, data, changes)
+	}
+
+	return nil
+}
@@ -0,0 +1,64 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fuzzer
+
+import (
+	"fmt"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/runtime"
+
+	networkingv1alpha3 "github.com/banzaicloud/istio-client-go/pkg/networking/v1alpha3"
+	networkingv1beta1 "github.com/banzaicloud/istio-client-go/pkg/networking/v1beta1"
+	securityv1beta1 "github.com/banzaicloud/istio-client-go/pkg/security/v1beta1"
+	telemetryv1alpha1 "github.com/banzaicloud/istio-client-go/pkg/telemetry/v1alpha1"
+)
+
+const iterations = 100
+
+func TestRoundTrip(t *testing.T) {
+	objects := []runtime.Object{
+		&networkingv1alpha3.DestinationRule{},
+		&networkingv1alpha3.EnvoyFilter{},
+		&networkingv1alpha3.Gateway{},
+		&networkingv1alpha3.ServiceEntry{},
+		&networkingv1alpha3.Sidecar{},
+		&networkingv1alpha3.VirtualService{},
+		&networkingv1alpha3.WorkloadEntry{},
+		&networkingv1alpha3.WorkloadGroup{},
+		&networkingv1beta1.DestinationRule{},
+		&networkingv1beta1.Gateway{},
+		&networkingv1beta1.ServiceEntry{},
+		&networkingv1beta1.Sidecar{},
+		&networkingv1beta1.VirtualService{},
+		&networkingv1beta1.WorkloadEntry{},
+		&securityv1beta1.AuthorizationPolicy{},
+		&securityv1beta1.PeerAuthentication{},
+		&securityv1beta1.RequestAuthentication{},
+		&telemetryv1alpha1.Telemetry{},
+	}
+
+	for _, obj := range objects {
+		obj := obj
+		t.Run(fmt.Sprintf("%T", obj), func(t *testing.T) {
+			f := New(1)
+			for i := 0; i < iterations; i++ {
+				if err := RoundTrip(f, obj); err != nil {
+					t.Fatal(err)
+				}
+			}
+		})
+	}
+}
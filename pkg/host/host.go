@@ -0,0 +1,98 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package host implements the semantics of the host names of Istio. A host
+// name is case insensitive, and may start with a * wildcard matching any
+// prefix, e.g. *.example.com matches api.example.com and *.api.example.com.
+// A single * matches any name.
+package host
+
+import (
+	"net"
+	"strings"
+)
+
+// DefaultDomainSuffix is the domain suffix of the cluster, which Istio
+// appends to the short names of services.
+const DefaultDomainSuffix = "cluster.local"
+
+// IsWildcard returns true if the host matches more than one name.
+func IsWildcard(host string) bool {
+	return strings.HasPrefix(host, "*")
+}
+
+// IsShortName returns true if the host is the short name of a service,
+// which has no dots and is neither * nor an IP address.
+func IsShortName(host string) bool {
+	return host != "*" && net.ParseIP(host) == nil && !strings.Contains(host, ".")
+}
+
+// Resolve returns the fully qualified name of the host the way Istio does:
+// short names are qualified with the namespace and the DefaultDomainSuffix,
+// e.g. reviews in the prod namespace becomes reviews.prod.svc.cluster.local.
+// Other hosts are returned as they are.
+func Resolve(host, namespace string) string {
+	if !IsShortName(host) {
+		return host
+	}
+	if namespace != "" {
+		host += "." + namespace
+	}
+
+	return host + ".svc." + DefaultDomainSuffix
+}
+
+// SubsetOf returns true if every name matched by host is matched by of
+// too, e.g. a.example.com and *.example.com are subsets of *.example.com.
+func SubsetOf(host, of string) bool {
+	host, of = strings.ToLower(host), strings.ToLower(of)
+	if of == "*" || host == of {
+		return true
+	}
+	if !IsWildcard(of) {
+		return false
+	}
+
+	return strings.HasSuffix(strings.TrimPrefix(host, "*"), of[1:])
+}
+
+// SubsetOfAny returns true if the host is a subset of any of the hosts, e.g.
+// the SNI host of a TLS route is expected to be one of the hosts of its
+// virtual service.
+func SubsetOfAny(host string, hosts []string) bool {
+	for _, of := range hosts {
+		if SubsetOf(host, of) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Matches returns true if some name is matched by both hosts. Since the
+// wildcards only match prefixes, one of them is then a subset of the other.
+func Matches(a, b string) bool {
+	return SubsetOf(a, b) || SubsetOf(b, a)
+}
+
+// MatchesAny returns true if the host matches any of the hosts.
+func MatchesAny(host string, hosts []string) bool {
+	for _, other := range hosts {
+		if Matches(host, other) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,80 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package host
+
+import (
+	"testing"
+)
+
+func TestResolve(t *testing.T) {
+	tests := []struct {
+		host      string
+		namespace string
+		expected  string
+	}{
+		{"reviews", "prod", "reviews.prod.svc.cluster.local"},
+		{"reviews", "", "reviews.svc.cluster.local"},
+		{"reviews.prod", "default", "reviews.prod"},
+		{"*.example.com", "prod", "*.example.com"},
+		{"*", "prod", "*"},
+		{"10.0.0.1", "prod", "10.0.0.1"},
+	}
+
+	for _, test := range tests {
+		if resolved := Resolve(test.host, test.namespace); resolved != test.expected {
+			t.Errorf("expected %s in %s to resolve to %s, got %s", test.host, test.namespace, test.expected, resolved)
+		}
+	}
+}
+
+func TestSubsetOf(t *testing.T) {
+	tests := []struct {
+		host     string
+		of       string
+		subset   bool
+		matches  bool
+		wildcard bool
+	}{
+		{"api.example.com", "api.example.com", true, true, false},
+		{"API.example.com", "api.EXAMPLE.com", true, true, false},
+		{"api.example.com", "*.example.com", true, true, false},
+		{"*.api.example.com", "*.example.com", true, true, true},
+		{"*.example.com", "api.example.com", false, true, true},
+		{"example.com", "*.example.com", false, false, false},
+		{"api.example.org", "*.example.com", false, false, false},
+		{"*", "*.example.com", false, true, true},
+		{"*.example.com", "*", true, true, true},
+	}
+
+	for _, test := range tests {
+		if subset := SubsetOf(test.host, test.of); subset != test.subset {
+			t.Errorf("expected %s to be a subset of %s: %t", test.host, test.of, test.subset)
+		}
+		if matches := Matches(test.host, test.of); matches != test.matches {
+			t.Errorf("expected %s to match %s: %t", test.host, test.of, test.matches)
+		}
+		if wildcard := IsWildcard(test.host); wildcard != test.wildcard {
+			t.Errorf("expected %s to be a wildcard: %t", test.host, test.wildcard)
+		}
+	}
+
+	hosts := []string{"*.example.com", "reviews.prod.svc.cluster.local"}
+	if !SubsetOfAny("api.example.com", hosts) || SubsetOfAny("*.com", hosts) {
+		t.Error("unexpected subset of the hosts")
+	}
+	if !MatchesAny("*.com", hosts) || MatchesAny("ratings.prod.svc.cluster.local", hosts) {
+		t.Error("unexpected match of the hosts")
+	}
+}
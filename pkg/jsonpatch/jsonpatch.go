@@ -0,0 +1,102 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package jsonpatch builds JSON patch documents as defined by RFC 6902.
+package jsonpatch
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// Operations of a JSON patch
+const (
+	OpAdd     = "add"
+	OpRemove  = "remove"
+	OpReplace = "replace"
+	OpTest    = "test"
+)
+
+// Operation is a single operation of a JSON patch.
+type Operation struct {
+	Op    string
+	Path  string
+	Value interface{}
+}
+
+type operation struct {
+	Op    string       `json:"op"`
+	Path  string       `json:"path"`
+	Value *interface{} `json:"value,omitempty"`
+}
+
+// MarshalJSON writes the value of the operation unless it is a remove, so
+// zero values can be added, replaced and tested as well.
+func (o Operation) MarshalJSON() ([]byte, error) {
+	out := operation{Op: o.Op, Path: o.Path}
+	if o.Op != OpRemove {
+		out.Value = &o.Value
+	}
+
+	return json.Marshal(out)
+}
+
+// Patch is a JSON patch document. The operations are applied in order and the
+// patch fails as a whole if any of them fails.
+type Patch []Operation
+
+// Add appends an add operation to the patch.
+func (p Patch) Add(path string, value interface{}) Patch {
+	return append(p, Operation{Op: OpAdd, Path: path, Value: value})
+}
+
+// Remove appends a remove operation to the patch.
+func (p Patch) Remove(path string) Patch {
+	return append(p, Operation{Op: OpRemove, Path: path})
+}
+
+// Replace appends a replace operation to the patch.
+func (p Patch) Replace(path string, value interface{}) Patch {
+	return append(p, Operation{Op: OpReplace, Path: path, Value: value})
+}
+
+// Test appends a test operation to the patch, which makes the patch fail unless
+// the value at the path equals to the given value.
+func (p Patch) Test(path string, value interface{}) Patch {
+	return append(p, Operation{Op: OpTest, Path: path, Value: value})
+}
+
+// Data returns the JSON encoded patch.
+func (p Patch) Data() ([]byte, error) {
+	if len(p) == 0 {
+		return []byte("[]"), nil
+	}
+
+	return json.Marshal([]Operation(p))
+}
+
+var pathEscaper = strings.NewReplacer("~", "~0", "/", "~1")
+
+// Path builds a JSON pointer from the segments and escapes them as described in
+// RFC 6901, e.g. Path("spec", "http", 0, "route") returns "/spec/http/0/route".
+func Path(segments ...interface{}) string {
+	var b strings.Builder
+	for _, segment := range segments {
+		b.WriteString("/")
+		b.WriteString(pathEscaper.Replace(fmt.Sprint(segment)))
+	}
+
+	return b.String()
+}
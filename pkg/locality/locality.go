@@ -0,0 +1,130 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package locality implements the localities of Istio, which place workloads
+// in the region/zone/subzone format, e.g. us-west/zone1/rack2, as the
+// locality of workload entries does. The rules of locality load balancing
+// may end their localities with a * wildcard, e.g. us-west/*, matching any
+// zone of the us-west region.
+package locality
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Wildcard matches any region, zone or subzone.
+const Wildcard = "*"
+
+// Priorities of a locality from the point of view of another, the lower the
+// closer, as Istio assigns them when failing over.
+const (
+	SameSubzone = iota
+	SameZone
+	SameRegion
+	OtherRegion
+)
+
+// Locality is a region, a zone in the region and a subzone in the zone. The
+// zone and the subzone are optional.
+type Locality struct {
+	Region  string
+	Zone    string
+	Subzone string
+}
+
+// Parse returns the locality in the region/zone/subzone format. A * wildcard
+// may only be the last part of the locality. The empty string is the empty
+// locality.
+func Parse(s string) (Locality, error) {
+	if s == "" {
+		return Locality{}, nil
+	}
+
+	parts := strings.Split(s, "/")
+	if len(parts) > 3 {
+		return Locality{}, fmt.Errorf("locality %q has more parts than region/zone/subzone", s)
+	}
+	for i, part := range parts {
+		if part == "" {
+			return Locality{}, fmt.Errorf("locality %q has an empty part", s)
+		}
+		if part == Wildcard && i != len(parts)-1 {
+			return Locality{}, fmt.Errorf("locality %q has a wildcard before its last part", s)
+		}
+	}
+
+	parts = append(parts, "", "")
+	return Locality{Region: parts[0], Zone: parts[1], Subzone: parts[2]}, nil
+}
+
+// String returns the locality in the region/zone/subzone format.
+func (l Locality) String() string {
+	switch {
+	case l.Subzone != "":
+		return l.Region + "/" + l.Zone + "/" + l.Subzone
+	case l.Zone != "":
+		return l.Region + "/" + l.Zone
+	default:
+		return l.Region
+	}
+}
+
+// IsWildcard returns true if the locality matches more than one locality.
+func (l Locality) IsWildcard() bool {
+	return l.Region == Wildcard || l.Zone == Wildcard || l.Subzone == Wildcard
+}
+
+// Matches returns true if the locality of the workload is matched by l, the
+// locality of a rule. The * wildcard and the missing parts of l match any
+// zone or subzone, e.g. us-west and us-west/* match us-west/zone1/rack2.
+func (l Locality) Matches(workload Locality) bool {
+	return matchPart(l.Region, workload.Region, false) &&
+		matchPart(l.Zone, workload.Zone, true) &&
+		matchPart(l.Subzone, workload.Subzone, true)
+}
+
+func matchPart(rule, part string, optional bool) bool {
+	return rule == Wildcard || (optional && rule == "") || rule == part
+}
+
+// Match returns true if the locality of the workload is matched by the
+// locality of the rule. Invalid localities match nothing.
+func Match(rule, workload string) bool {
+	r, err := Parse(rule)
+	if err != nil {
+		return false
+	}
+	w, err := Parse(workload)
+	if err != nil {
+		return false
+	}
+
+	return r.Matches(w)
+}
+
+// Priority returns how close the locality to is to the locality from, from
+// SameSubzone to OtherRegion.
+func Priority(from, to Locality) int {
+	switch {
+	case from.Region != to.Region:
+		return OtherRegion
+	case from.Zone != to.Zone:
+		return SameRegion
+	case from.Subzone != to.Subzone:
+		return SameZone
+	default:
+		return SameSubzone
+	}
+}
@@ -0,0 +1,83 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package locality
+
+import (
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		locality string
+		expected Locality
+		valid    bool
+	}{
+		{"", Locality{}, true},
+		{"us-west", Locality{Region: "us-west"}, true},
+		{"us-west/zone1/rack2", Locality{Region: "us-west", Zone: "zone1", Subzone: "rack2"}, true},
+		{"us-west/*", Locality{Region: "us-west", Zone: "*"}, true},
+		{"*/zone1", Locality{}, false},
+		{"us-west//rack2", Locality{}, false},
+		{"us-west/zone1/rack2/shelf3", Locality{}, false},
+	}
+
+	for _, test := range tests {
+		locality, err := Parse(test.locality)
+		if (err == nil) != test.valid || locality != test.expected {
+			t.Errorf("unexpected result for %q: %v, %v", test.locality, locality, err)
+		}
+		if test.valid && locality.String() != test.locality {
+			t.Errorf("expected %q to format as itself, got %q", test.locality, locality.String())
+		}
+	}
+}
+
+func TestMatch(t *testing.T) {
+	tests := []struct {
+		rule, workload string
+		expected       bool
+	}{
+		{"*", "us-west/zone1/rack2", true},
+		{"us-west", "us-west/zone1/rack2", true},
+		{"us-west/*", "us-west/zone1", true},
+		{"us-west/zone1/*", "us-west/zone1/rack2", true},
+		{"us-west/zone1", "us-west/zone2", false},
+		{"us-east/*", "us-west/zone1", false},
+		{"us-west/zone1/rack2", "us-west/zone1", false},
+		{"*/zone1", "us-west/zone1", false},
+	}
+
+	for _, test := range tests {
+		if matched := Match(test.rule, test.workload); matched != test.expected {
+			t.Errorf("expected %s matching %s to be %t", test.rule, test.workload, test.expected)
+		}
+	}
+}
+
+func TestPriority(t *testing.T) {
+	from := Locality{Region: "us-west", Zone: "zone1", Subzone: "rack2"}
+	tests := map[Locality]int{
+		from: SameSubzone,
+		{Region: "us-west", Zone: "zone1", Subzone: "rack3"}: SameZone,
+		{Region: "us-west", Zone: "zone2", Subzone: "rack2"}: SameRegion,
+		{Region: "us-east", Zone: "zone1", Subzone: "rack2"}: OtherRegion,
+	}
+
+	for to, expected := range tests {
+		if priority := Priority(from, to); priority != expected {
+			t.Errorf("expected the priority of %s to be %d, got %d", to, expected, priority)
+		}
+	}
+}
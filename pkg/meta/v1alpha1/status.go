@@ -0,0 +1,120 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// ConditionReconciled is set by Istio once the latest generation of a resource has been
+	// distributed to all of the proxies it applies to.
+	ConditionReconciled = "Reconciled"
+	// ConditionHealthy is set by Istio on workload entries to report the result of the
+	// health checks of the workload.
+	ConditionHealthy = "Healthy"
+)
+
+// Possible values of the status of a condition.
+const (
+	ConditionStatusTrue    = "True"
+	ConditionStatusFalse   = "False"
+	ConditionStatusUnknown = "Unknown"
+)
+
+// ConditionsAccessor is implemented by the kinds with an Istio status, so that
+// controllers and tools computing the readiness of resources, like kstatus,
+// read and write their conditions without knowing their types. The conditions
+// are kept in status.conditions in the type, status, reason and message
+// layout these tools expect.
+type ConditionsAccessor interface {
+	// GetStatusConditions returns the conditions of the status, nil if the
+	// resource has no status.
+	GetStatusConditions() []*IstioCondition
+	// SetStatusConditions sets the conditions of the status, which is added
+	// if the resource has none.
+	SetStatusConditions(conditions []*IstioCondition)
+}
+
+// GetCondition returns the condition with the given type or nil if the status
+// does not contain such a condition.
+func (m *IstioStatus) GetCondition(conditionType string) *IstioCondition {
+	if m == nil {
+		return nil
+	}
+
+	for _, condition := range m.Conditions {
+		if condition != nil && condition.Type == conditionType {
+			return condition
+		}
+	}
+
+	return nil
+}
+
+// SetCondition adds the condition to the status or replaces the one with the same
+// type. The last transition time is kept when the status of the condition does not
+// change and is set to the current time when it does, unless the given condition
+// has it set explicitly.
+func (m *IstioStatus) SetCondition(condition IstioCondition) {
+	existing := m.GetCondition(condition.Type)
+	if existing == nil {
+		if condition.LastTransitionTime == nil {
+			now := metav1.Now()
+			condition.LastTransitionTime = &now
+		}
+		m.Conditions = append(m.Conditions, &condition)
+		return
+	}
+
+	if condition.LastTransitionTime == nil {
+		if existing.Status == condition.Status {
+			condition.LastTransitionTime = existing.LastTransitionTime
+		} else {
+			now := metav1.Now()
+			condition.LastTransitionTime = &now
+		}
+	}
+	*existing = condition
+}
+
+// IsReconciled returns true if Istio has observed the given generation of the
+// resource and reports it as reconciled.
+func (m *IstioStatus) IsReconciled(generation int64) bool {
+	if m == nil || m.ObservedGeneration != generation {
+		return false
+	}
+
+	return m.isConditionTrue(ConditionReconciled)
+}
+
+// IsReady returns true if the resource is reconciled and, when Istio reports the
+// health of the workload, it is healthy as well.
+func (m *IstioStatus) IsReady() bool {
+	if !m.isConditionTrue(ConditionReconciled) {
+		return false
+	}
+
+	if healthy := m.GetCondition(ConditionHealthy); healthy != nil {
+		return healthy.Status == ConditionStatusTrue
+	}
+
+	return true
+}
+
+func (m *IstioStatus) isConditionTrue(conditionType string) bool {
+	condition := m.GetCondition(conditionType)
+	return condition != nil && condition.Status == ConditionStatusTrue
+}
@@ -0,0 +1,89 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	v1alpha1 "github.com/banzaicloud/istio-client-go/pkg/analysis/v1alpha1"
+)
+
+// DeepCopyInto supports using IstioStatus within kubernetes types, where deepcopy-gen is used.
+func (in *IstioStatus) DeepCopyInto(out *IstioStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]*IstioCondition, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(IstioCondition)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.ValidationMessages != nil {
+		in, out := &in.ValidationMessages, &out.ValidationMessages
+		*out = make([]*v1alpha1.AnalysisMessageBase, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(v1alpha1.AnalysisMessageBase)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IstioStatus. Required by controller-gen.
+func (in *IstioStatus) DeepCopy() *IstioStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(IstioStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new IstioStatus. Required by controller-gen.
+func (in *IstioStatus) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
+// DeepCopyInto supports using IstioCondition within kubernetes types, where deepcopy-gen is used.
+func (in *IstioCondition) DeepCopyInto(out *IstioCondition) {
+	*out = *in
+	if in.LastProbeTime != nil {
+		in, out := &in.LastProbeTime, &out.LastProbeTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastTransitionTime != nil {
+		in, out := &in.LastTransitionTime, &out.LastTransitionTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IstioCondition. Required by controller-gen.
+func (in *IstioCondition) DeepCopy() *IstioCondition {
+	if in == nil {
+		return nil
+	}
+	out := new(IstioCondition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new IstioCondition. Required by controller-gen.
+func (in *IstioCondition) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
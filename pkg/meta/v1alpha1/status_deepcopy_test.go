@@ -0,0 +1,73 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	analysisv1alpha1 "github.com/banzaicloud/istio-client-go/pkg/analysis/v1alpha1"
+)
+
+func newTestStatus() *IstioStatus {
+	return &IstioStatus{
+		Conditions: []*IstioCondition{
+			{
+				Type:               ConditionReconciled,
+				Status:             ConditionStatusTrue,
+				LastProbeTime:      &metav1.Time{Time: time.Unix(1600000000, 0)},
+				LastTransitionTime: &metav1.Time{Time: time.Unix(1600000000, 0)},
+				Reason:             "Reconciled",
+				Message:            "configuration distributed to all proxies",
+			},
+		},
+		ObservedGeneration: 3,
+		ValidationMessages: []*analysisv1alpha1.AnalysisMessageBase{
+			{
+				Type:             &analysisv1alpha1.AnalysisMessageBase_Type{Name: "ReferencedResourceNotFound", Code: "IST0101"},
+				Level:            analysisv1alpha1.AnalysisMessageBase_ERROR,
+				DocumentationUrl: "https://istio.io/latest/docs/reference/config/analysis/ist0101/",
+			},
+		},
+	}
+}
+
+func TestIstioStatusDeepCopy(t *testing.T) {
+	in := newTestStatus()
+	out := in.DeepCopy()
+
+	if !reflect.DeepEqual(in, out) {
+		t.Fatalf("copy differs from the original: %v != %v", out, in)
+	}
+
+	out.Conditions[0].LastTransitionTime.Time = time.Time{}
+	out.ValidationMessages[0].Type.Code = "IST0000"
+	if in.Conditions[0].LastTransitionTime.IsZero() || in.ValidationMessages[0].Type.Code == "IST0000" {
+		t.Fatal("modifying the copy changed the original")
+	}
+}
+
+func BenchmarkIstioStatusDeepCopy(b *testing.B) {
+	in := newTestStatus()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = in.DeepCopy()
+	}
+}
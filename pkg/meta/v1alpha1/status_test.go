@@ -0,0 +1,207 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestGetCondition(t *testing.T) {
+	status := newTestStatus()
+
+	tests := []struct {
+		name          string
+		status        *IstioStatus
+		conditionType string
+		expected      *IstioCondition
+	}{
+		{
+			name:          "existing",
+			status:        status,
+			conditionType: ConditionReconciled,
+			expected:      status.Conditions[0],
+		},
+		{
+			name:          "missing",
+			status:        status,
+			conditionType: ConditionHealthy,
+		},
+		{
+			name:          "nil conditions are skipped",
+			status:        &IstioStatus{Conditions: []*IstioCondition{nil}},
+			conditionType: ConditionReconciled,
+		},
+		{
+			name:          "nil status",
+			conditionType: ConditionReconciled,
+		},
+	}
+
+	for _, test := range tests {
+		if actual := test.status.GetCondition(test.conditionType); actual != test.expected {
+			t.Errorf("%s: unexpected condition %v, expected %v", test.name, actual, test.expected)
+		}
+	}
+}
+
+func TestSetCondition(t *testing.T) {
+	past := metav1.NewTime(time.Unix(1600000000, 0))
+	explicit := metav1.NewTime(time.Unix(1700000000, 0))
+
+	tests := []struct {
+		name      string
+		condition IstioCondition
+		// expectedTime is the expected last transition time, or nil if it
+		// must be the current time
+		expectedTime *metav1.Time
+	}{
+		{
+			name:         "same status keeps the transition time",
+			condition:    IstioCondition{Type: ConditionReconciled, Status: ConditionStatusTrue, Reason: "Distributed"},
+			expectedTime: &past,
+		},
+		{
+			name:      "changed status sets the transition time",
+			condition: IstioCondition{Type: ConditionReconciled, Status: ConditionStatusFalse, Reason: "Pending"},
+		},
+		{
+			name:         "explicit transition time",
+			condition:    IstioCondition{Type: ConditionReconciled, Status: ConditionStatusFalse, LastTransitionTime: &explicit},
+			expectedTime: &explicit,
+		},
+		{
+			name:      "new condition sets the transition time",
+			condition: IstioCondition{Type: ConditionHealthy, Status: ConditionStatusTrue},
+		},
+	}
+
+	for _, test := range tests {
+		status := &IstioStatus{Conditions: []*IstioCondition{
+			{Type: ConditionReconciled, Status: ConditionStatusTrue, LastTransitionTime: past.DeepCopy(), Reason: "Reconciled"},
+		}}
+		before := time.Now().Truncate(time.Second)
+		status.SetCondition(test.condition)
+
+		expectedLen := 1
+		if test.condition.Type != ConditionReconciled {
+			expectedLen = 2
+		}
+		if len(status.Conditions) != expectedLen {
+			t.Errorf("%s: unexpected number of conditions %d, expected %d", test.name, len(status.Conditions), expectedLen)
+			continue
+		}
+
+		actual := status.GetCondition(test.condition.Type)
+		if actual.Status != test.condition.Status || actual.Reason != test.condition.Reason {
+			t.Errorf("%s: unexpected condition %v, expected %v", test.name, actual, test.condition)
+		}
+		switch {
+		case actual.LastTransitionTime == nil:
+			t.Errorf("%s: the transition time is not set", test.name)
+		case test.expectedTime != nil && !actual.LastTransitionTime.Equal(test.expectedTime):
+			t.Errorf("%s: unexpected transition time %v, expected %v", test.name, actual.LastTransitionTime, test.expectedTime)
+		case test.expectedTime == nil && actual.LastTransitionTime.Time.Before(before):
+			t.Errorf("%s: unexpected transition time %v, expected the current time", test.name, actual.LastTransitionTime)
+		}
+	}
+}
+
+func TestIsReconciledAndReady(t *testing.T) {
+	condition := func(conditionType, status string) *IstioCondition {
+		return &IstioCondition{Type: conditionType, Status: status}
+	}
+
+	tests := []struct {
+		name               string
+		status             *IstioStatus
+		generation         int64
+		expectedReconciled bool
+		expectedReady      bool
+	}{
+		{
+			name:               "reconciled",
+			status:             &IstioStatus{ObservedGeneration: 2, Conditions: []*IstioCondition{condition(ConditionReconciled, ConditionStatusTrue)}},
+			generation:         2,
+			expectedReconciled: true,
+			expectedReady:      true,
+		},
+		{
+			name:          "older generation",
+			status:        &IstioStatus{ObservedGeneration: 1, Conditions: []*IstioCondition{condition(ConditionReconciled, ConditionStatusTrue)}},
+			generation:    2,
+			expectedReady: true,
+		},
+		{
+			name:       "not reconciled",
+			status:     &IstioStatus{ObservedGeneration: 2, Conditions: []*IstioCondition{condition(ConditionReconciled, ConditionStatusFalse)}},
+			generation: 2,
+		},
+		{
+			name: "unhealthy",
+			status: &IstioStatus{ObservedGeneration: 2, Conditions: []*IstioCondition{
+				condition(ConditionReconciled, ConditionStatusTrue),
+				condition(ConditionHealthy, ConditionStatusFalse),
+			}},
+			generation:         2,
+			expectedReconciled: true,
+		},
+		{
+			name: "healthy",
+			status: &IstioStatus{ObservedGeneration: 2, Conditions: []*IstioCondition{
+				condition(ConditionHealthy, ConditionStatusTrue),
+				condition(ConditionReconciled, ConditionStatusTrue),
+			}},
+			generation:         2,
+			expectedReconciled: true,
+			expectedReady:      true,
+		},
+		{
+			name:       "no conditions",
+			status:     &IstioStatus{},
+			generation: 0,
+		},
+		{
+			name: "nil status",
+		},
+	}
+
+	for _, test := range tests {
+		if actual := test.status.IsReconciled(test.generation); actual != test.expectedReconciled {
+			t.Errorf("%s: unexpected reconciled %t, expected %t", test.name, actual, test.expectedReconciled)
+		}
+		if actual := test.status.IsReady(); actual != test.expectedReady {
+			t.Errorf("%s: unexpected ready %t, expected %t", test.name, actual, test.expectedReady)
+		}
+	}
+}
+
+func TestIstioStatusString(t *testing.T) {
+	status := newTestStatus()
+	status.Conditions[0].LastProbeTime = nil
+	status.Conditions[0].LastTransitionTime = nil
+
+	expected := `{"conditions":[{"type":"Reconciled","status":"True","reason":"Reconciled","message":"configuration distributed to all proxies"}],` +
+		`"observedGeneration":3,` +
+		`"validationMessages":[{"type":{"name":"ReferencedResourceNotFound","code":"IST0101"},"level":"ERROR","documentationUrl":"https://istio.io/latest/docs/reference/config/analysis/ist0101/"}]}`
+	if actual := status.String(); actual != expected {
+		t.Errorf("unexpected string %s, expected %s", actual, expected)
+	}
+	if actual := status.Conditions[0].String(); actual != `{"type":"Reconciled","status":"True","reason":"Reconciled","message":"configuration distributed to all proxies"}` {
+		t.Errorf("unexpected string %s of the condition", actual)
+	}
+}
@@ -0,0 +1,80 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	"encoding/json"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1alpha1 "github.com/banzaicloud/istio-client-go/pkg/analysis/v1alpha1"
+)
+
+// IstioStatus is the status Istio reports on its resources, mirroring the
+// IstioStatus message of istio.io/api/meta/v1alpha1.
+type IstioStatus struct {
+	// Current service state of pod.
+	// More info: https://istio.io/docs/reference/config/config-status/
+	// +optional
+	// +patchMergeKey=type
+	// +patchStrategy=merge
+	Conditions []*IstioCondition `json:"conditions,omitempty"`
+	// Resource Generation to which the Reconciled Condition refers.
+	// When this value is not equal to the object's metadata generation, reconciled condition  calculation for the current
+	// generation is still in progress.  See https://istio.io/latest/docs/reference/config/config-status/ for more info.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// Includes any errors or warnings detected by Istio's analyzers.
+	// +optional
+	ValidationMessages []*v1alpha1.AnalysisMessageBase `json:"validationMessages,omitempty"`
+}
+
+func (m *IstioStatus) Reset() { *m = IstioStatus{} }
+
+// String returns the JSON form of the status.
+func (m *IstioStatus) String() string { return jsonString(m) }
+
+// IstioCondition is a condition of an IstioStatus.
+type IstioCondition struct {
+	// Type is the type of the condition.
+	Type string `json:"type,omitempty"`
+	// Status is the status of the condition.
+	// Can be True, False, Unknown.
+	Status string `json:"status,omitempty"`
+	// Last time we probed the condition.
+	// +optional
+	LastProbeTime *metav1.Time `json:"lastProbeTime,omitempty"`
+	// Last time the condition transitioned from one status to another.
+	// +optional
+	LastTransitionTime *metav1.Time `json:"lastTransitionTime,omitempty"`
+	// Unique, one-word, CamelCase reason for the condition's last transition.
+	// +optional
+	Reason string `json:"reason,omitempty"`
+	// Human-readable message indicating details about last transition.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+func (m *IstioCondition) Reset() { *m = IstioCondition{} }
+
+// String returns the JSON form of the condition.
+func (m *IstioCondition) String() string { return jsonString(m) }
+
+// jsonString returns the JSON form of v, which cannot fail for the status
+// types.
+func jsonString(v interface{}) string {
+	data, _ := json.Marshal(v)
+	return string(data)
+}
@@ -0,0 +1,177 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package mtls resolves the mutual TLS mode in effect for a workload from the
+// peer authentications of the mesh, the way Istio composes them, e.g.
+//
+//	resolver := mtls.NewResolver("istio-system", policies...)
+//	effective := resolver.Resolve("prod", map[string]string{"app": "httpbin"})
+//	mode := effective.PortMode(8080)
+//
+// The policies without a selector apply to the whole mesh in the root
+// namespace and to the whole namespace elsewhere, and the policies with a
+// selector to the workloads they select in their namespace. The policies with
+// a selector in the root namespace are ignored, like Istio does. Where several
+// policies apply at the same level, the oldest one is used.
+//
+// An UNSET mode inherits the mode of the level above: a port the mode of the
+// workload, the workload the mode of the namespace and the namespace the mode
+// of the mesh. The mode of the mesh defaults to PERMISSIVE. Port level modes
+// are only honoured on the policies with a selector.
+package mtls
+
+import (
+	"sort"
+
+	securityv1beta1 "github.com/banzaicloud/istio-client-go/pkg/security/v1beta1"
+	"github.com/banzaicloud/istio-client-go/pkg/selector"
+)
+
+// Effective is the mutual TLS mode in effect for a workload and the policies
+// it comes from.
+type Effective struct {
+	// Mode is the mode of the ports of the workload without a port level mode
+	Mode securityv1beta1.MTLSMode
+	// PortModes are the modes of the ports with a port level mode, by port
+	// number of the workload
+	PortModes map[uint32]securityv1beta1.MTLSMode
+	// Mesh is the mesh-wide policy, if any
+	Mesh *securityv1beta1.PeerAuthentication
+	// Namespace is the namespace-wide policy, if any
+	Namespace *securityv1beta1.PeerAuthentication
+	// Workload is the policy selecting the workload, if any
+	Workload *securityv1beta1.PeerAuthentication
+}
+
+// PortMode returns the mode in effect for the port of the workload.
+func (e Effective) PortMode(port uint32) securityv1beta1.MTLSMode {
+	if mode, ok := e.PortModes[port]; ok {
+		return mode
+	}
+
+	return e.Mode
+}
+
+// Ports returns the ports with a port level mode, in order.
+func (e Effective) Ports() []uint32 {
+	ports := make([]uint32, 0, len(e.PortModes))
+	for port := range e.PortModes {
+		ports = append(ports, port)
+	}
+	sort.Slice(ports, func(i, j int) bool { return ports[i] < ports[j] })
+
+	return ports
+}
+
+// Resolver resolves the mode in effect for the workloads from a set of peer
+// authentications.
+type Resolver struct {
+	rootNamespace string
+	policies      []*securityv1beta1.PeerAuthentication
+}
+
+// NewResolver returns a resolver of the policies, for the mesh of the root
+// namespace.
+func NewResolver(rootNamespace string, policies ...*securityv1beta1.PeerAuthentication) *Resolver {
+	return &Resolver{
+		rootNamespace: rootNamespace,
+		policies:      policies,
+	}
+}
+
+// Resolve returns the mode in effect for the workload of the namespace with
+// the labels.
+func (r *Resolver) Resolve(namespace string, labels map[string]string) Effective {
+	var e Effective
+	for _, policy := range r.policies {
+		if policy == nil {
+			continue
+		}
+		switch {
+		case !hasSelector(policy):
+			if policy.Namespace == r.rootNamespace {
+				e.Mesh = oldest(e.Mesh, policy)
+			} else if policy.Namespace == namespace {
+				e.Namespace = oldest(e.Namespace, policy)
+			}
+		case policy.Namespace == namespace && policy.Namespace != r.rootNamespace &&
+			selector.Matches(policy.Spec.Selector, labels):
+			e.Workload = oldest(e.Workload, policy)
+		}
+	}
+
+	e.Mode = securityv1beta1.MTLSModePermissive
+	for _, policy := range []*securityv1beta1.PeerAuthentication{e.Mesh, e.Namespace, e.Workload} {
+		e.Mode = inherit(mode(policy), e.Mode)
+	}
+	if e.Workload != nil {
+		for port, mtls := range e.Workload.Spec.PortLevelMtls {
+			if e.PortModes == nil {
+				e.PortModes = make(map[uint32]securityv1beta1.MTLSMode)
+			}
+			e.PortModes[port] = e.Mode
+			if mtls != nil {
+				e.PortModes[port] = inherit(mtls.Mode, e.Mode)
+			}
+		}
+	}
+
+	return e
+}
+
+// Resolve returns the mode in effect for the workload of the namespace with
+// the labels, from the policies of the mesh of the root namespace.
+func Resolve(rootNamespace string, policies []*securityv1beta1.PeerAuthentication, namespace string, labels map[string]string) Effective {
+	return NewResolver(rootNamespace, policies...).Resolve(namespace, labels)
+}
+
+func hasSelector(policy *securityv1beta1.PeerAuthentication) bool {
+	return len(selector.MatchLabels(policy.Spec.Selector)) > 0
+}
+
+func mode(policy *securityv1beta1.PeerAuthentication) securityv1beta1.MTLSMode {
+	if policy == nil || policy.Spec.Mtls == nil {
+		return securityv1beta1.MTLSModeUnset
+	}
+
+	return policy.Spec.Mtls.Mode
+}
+
+// inherit returns the mode, or the mode of the parent if it is UNSET.
+func inherit(mode, parent securityv1beta1.MTLSMode) securityv1beta1.MTLSMode {
+	if mode == "" || mode == securityv1beta1.MTLSModeUnset {
+		return parent
+	}
+
+	return mode
+}
+
+// oldest returns the older of the policies, by creation time and then by
+// name.
+func oldest(current, policy *securityv1beta1.PeerAuthentication) *securityv1beta1.PeerAuthentication {
+	if current == nil {
+		return policy
+	}
+	if policy.CreationTimestamp.Equal(&current.CreationTimestamp) {
+		if policy.Name < current.Name {
+			return policy
+		}
+		return current
+	}
+	if policy.CreationTimestamp.Before(&current.CreationTimestamp) {
+		return policy
+	}
+
+	return current
+}
@@ -0,0 +1,153 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mtls
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	securityv1beta1 "github.com/banzaicloud/istio-client-go/pkg/security/v1beta1"
+	typev1beta1 "github.com/banzaicloud/istio-client-go/pkg/type/v1beta1"
+)
+
+var created = time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func policy(namespace, name string, age int, labels map[string]string, mode securityv1beta1.MTLSMode, ports map[uint32]securityv1beta1.MTLSMode) *securityv1beta1.PeerAuthentication {
+	p := &securityv1beta1.PeerAuthentication{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:         namespace,
+			Name:              name,
+			CreationTimestamp: metav1.NewTime(created.Add(-time.Duration(age) * time.Hour)),
+		},
+	}
+	if labels != nil {
+		p.Spec.Selector = &typev1beta1.WorkloadSelector{MatchLabels: labels}
+	}
+	if mode != "" {
+		p.Spec.Mtls = &securityv1beta1.PeerAuthenticationMTLS{Mode: mode}
+	}
+	for port, mode := range ports {
+		p.Spec.SetPortMTLSMode(port, mode)
+	}
+
+	return p
+}
+
+func TestResolve(t *testing.T) {
+	httpbin := map[string]string{"app": "httpbin"}
+	mesh := policy("istio-system", "default", 0, nil, securityv1beta1.MTLSModeStrict, nil)
+	namespace := policy("prod", "default", 0, nil, securityv1beta1.MTLSModePermissive, nil)
+	unsetNamespace := policy("prod", "default", 0, nil, securityv1beta1.MTLSModeUnset, nil)
+	workload := policy("prod", "httpbin", 0, httpbin, securityv1beta1.MTLSModeUnset, map[uint32]securityv1beta1.MTLSMode{
+		8080: securityv1beta1.MTLSModeDisable,
+		9090: securityv1beta1.MTLSModeUnset,
+	})
+	newer := policy("prod", "httpbin-strict", -1, httpbin, securityv1beta1.MTLSModeStrict, nil)
+	older := policy("prod", "httpbin-disable", 1, httpbin, securityv1beta1.MTLSModeDisable, nil)
+	rootSelector := policy("istio-system", "httpbin", 0, httpbin, securityv1beta1.MTLSModeDisable, nil)
+	portsWithoutSelector := policy("prod", "ports", 1, nil, securityv1beta1.MTLSModeUnset, map[uint32]securityv1beta1.MTLSMode{
+		8080: securityv1beta1.MTLSModeDisable,
+	})
+
+	tests := []struct {
+		name      string
+		policies  []*securityv1beta1.PeerAuthentication
+		namespace string
+		expected  Effective
+	}{
+		{
+			name:      "no policy",
+			namespace: "prod",
+			expected:  Effective{Mode: securityv1beta1.MTLSModePermissive},
+		},
+		{
+			name:      "mesh",
+			policies:  []*securityv1beta1.PeerAuthentication{mesh},
+			namespace: "prod",
+			expected:  Effective{Mode: securityv1beta1.MTLSModeStrict, Mesh: mesh},
+		},
+		{
+			name:      "namespace",
+			policies:  []*securityv1beta1.PeerAuthentication{mesh, namespace},
+			namespace: "prod",
+			expected:  Effective{Mode: securityv1beta1.MTLSModePermissive, Mesh: mesh, Namespace: namespace},
+		},
+		{
+			name:      "policies of other namespaces",
+			policies:  []*securityv1beta1.PeerAuthentication{mesh, namespace, workload},
+			namespace: "test",
+			expected:  Effective{Mode: securityv1beta1.MTLSModeStrict, Mesh: mesh},
+		},
+		{
+			name:      "unset inheritance",
+			policies:  []*securityv1beta1.PeerAuthentication{mesh, unsetNamespace, workload},
+			namespace: "prod",
+			expected: Effective{
+				Mode: securityv1beta1.MTLSModeStrict,
+				PortModes: map[uint32]securityv1beta1.MTLSMode{
+					8080: securityv1beta1.MTLSModeDisable,
+					9090: securityv1beta1.MTLSModeStrict,
+				},
+				Mesh:      mesh,
+				Namespace: unsetNamespace,
+				Workload:  workload,
+			},
+		},
+		{
+			name:      "oldest workload policy",
+			policies:  []*securityv1beta1.PeerAuthentication{newer, older},
+			namespace: "prod",
+			expected:  Effective{Mode: securityv1beta1.MTLSModeDisable, Workload: older},
+		},
+		{
+			name:      "selector in the root namespace",
+			policies:  []*securityv1beta1.PeerAuthentication{mesh, rootSelector},
+			namespace: "prod",
+			expected:  Effective{Mode: securityv1beta1.MTLSModeStrict, Mesh: mesh},
+		},
+		{
+			name:      "port level mode without selector",
+			policies:  []*securityv1beta1.PeerAuthentication{portsWithoutSelector},
+			namespace: "prod",
+			expected:  Effective{Mode: securityv1beta1.MTLSModePermissive, Namespace: portsWithoutSelector},
+		},
+	}
+
+	for _, test := range tests {
+		effective := Resolve("istio-system", test.policies, test.namespace, httpbin)
+		if !reflect.DeepEqual(effective, test.expected) {
+			t.Errorf("%s: unexpected result %+v, expected %+v", test.name, effective, test.expected)
+		}
+	}
+}
+
+func TestPortMode(t *testing.T) {
+	effective := Effective{
+		Mode:      securityv1beta1.MTLSModeStrict,
+		PortModes: map[uint32]securityv1beta1.MTLSMode{9080: securityv1beta1.MTLSModeDisable, 80: securityv1beta1.MTLSModePermissive},
+	}
+	if mode := effective.PortMode(9080); mode != securityv1beta1.MTLSModeDisable {
+		t.Errorf("unexpected mode %s of port 9080", mode)
+	}
+	if mode := effective.PortMode(443); mode != securityv1beta1.MTLSModeStrict {
+		t.Errorf("unexpected mode %s of port 443", mode)
+	}
+	if ports := effective.Ports(); !reflect.DeepEqual(ports, []uint32{80, 9080}) {
+		t.Errorf("unexpected ports %v", ports)
+	}
+}
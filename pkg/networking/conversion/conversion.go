@@ -0,0 +1,278 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package conversion converts the networking kinds served in both v1alpha3
+// and v1beta1 between the two versions, e.g. to migrate stored manifests.
+//
+// The two versions share their schema, so the conversions are lossless. A
+// field that is present in the source but has no counterpart in the target
+// version is reported as an error instead of being dropped.
+package conversion
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"k8s.io/apimachinery/pkg/runtime"
+
+	networkingv1alpha3 "github.com/banzaicloud/istio-client-go/pkg/networking/v1alpha3"
+	networkingv1beta1 "github.com/banzaicloud/istio-client-go/pkg/networking/v1beta1"
+)
+
+// Convert converts in into out, which must be the same kind in the other
+// version.
+func Convert(in, out runtime.Object) error {
+	var (
+		converted runtime.Object
+		err       error
+	)
+	switch in := in.(type) {
+	case *networkingv1alpha3.DestinationRule:
+		converted, err = ConvertDestinationRuleV1alpha3ToV1beta1(in)
+	case *networkingv1beta1.DestinationRule:
+		converted, err = ConvertDestinationRuleV1beta1ToV1alpha3(in)
+	case *networkingv1alpha3.Gateway:
+		converted, err = ConvertGatewayV1alpha3ToV1beta1(in)
+	case *networkingv1beta1.Gateway:
+		converted, err = ConvertGatewayV1beta1ToV1alpha3(in)
+	case *networkingv1alpha3.ServiceEntry:
+		converted, err = ConvertServiceEntryV1alpha3ToV1beta1(in)
+	case *networkingv1beta1.ServiceEntry:
+		converted, err = ConvertServiceEntryV1beta1ToV1alpha3(in)
+	case *networkingv1alpha3.Sidecar:
+		converted, err = ConvertSidecarV1alpha3ToV1beta1(in)
+	case *networkingv1beta1.Sidecar:
+		converted, err = ConvertSidecarV1beta1ToV1alpha3(in)
+	case *networkingv1alpha3.VirtualService:
+		converted, err = ConvertVirtualServiceV1alpha3ToV1beta1(in)
+	case *networkingv1beta1.VirtualService:
+		converted, err = ConvertVirtualServiceV1beta1ToV1alpha3(in)
+	case *networkingv1alpha3.WorkloadEntry:
+		converted, err = ConvertWorkloadEntryV1alpha3ToV1beta1(in)
+	case *networkingv1beta1.WorkloadEntry:
+		converted, err = ConvertWorkloadEntryV1beta1ToV1alpha3(in)
+	default:
+		return fmt.Errorf("no conversion for %T", in)
+	}
+	if err != nil {
+		return err
+	}
+
+	outValue := reflect.ValueOf(out)
+	if outValue.Type() != reflect.TypeOf(converted) {
+		return fmt.Errorf("cannot convert %T to %T", in, out)
+	}
+	outValue.Elem().Set(reflect.ValueOf(converted).Elem())
+
+	return nil
+}
+
+// ConvertDestinationRuleV1alpha3ToV1beta1 converts a v1alpha3 DestinationRule to v1beta1.
+func ConvertDestinationRuleV1alpha3ToV1beta1(in *networkingv1alpha3.DestinationRule) (*networkingv1beta1.DestinationRule, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	out := &networkingv1beta1.DestinationRule{}
+	if err := convert(in, out); err != nil {
+		return nil, err
+	}
+	out.SetGroupVersionKind(networkingv1beta1.DestinationRuleGroupVersionKind)
+
+	return out, nil
+}
+
+// ConvertDestinationRuleV1beta1ToV1alpha3 converts a v1beta1 DestinationRule to v1alpha3.
+func ConvertDestinationRuleV1beta1ToV1alpha3(in *networkingv1beta1.DestinationRule) (*networkingv1alpha3.DestinationRule, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	out := &networkingv1alpha3.DestinationRule{}
+	if err := convert(in, out); err != nil {
+		return nil, err
+	}
+	out.SetGroupVersionKind(networkingv1alpha3.DestinationRuleGroupVersionKind)
+
+	return out, nil
+}
+
+// ConvertGatewayV1alpha3ToV1beta1 converts a v1alpha3 Gateway to v1beta1.
+func ConvertGatewayV1alpha3ToV1beta1(in *networkingv1alpha3.Gateway) (*networkingv1beta1.Gateway, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	out := &networkingv1beta1.Gateway{}
+	if err := convert(in, out); err != nil {
+		return nil, err
+	}
+	out.SetGroupVersionKind(networkingv1beta1.GatewayGroupVersionKind)
+
+	return out, nil
+}
+
+// ConvertGatewayV1beta1ToV1alpha3 converts a v1beta1 Gateway to v1alpha3.
+func ConvertGatewayV1beta1ToV1alpha3(in *networkingv1beta1.Gateway) (*networkingv1alpha3.Gateway, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	out := &networkingv1alpha3.Gateway{}
+	if err := convert(in, out); err != nil {
+		return nil, err
+	}
+	out.SetGroupVersionKind(networkingv1alpha3.GatewayGroupVersionKind)
+
+	return out, nil
+}
+
+// ConvertServiceEntryV1alpha3ToV1beta1 converts a v1alpha3 ServiceEntry to v1beta1.
+func ConvertServiceEntryV1alpha3ToV1beta1(in *networkingv1alpha3.ServiceEntry) (*networkingv1beta1.ServiceEntry, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	out := &networkingv1beta1.ServiceEntry{}
+	if err := convert(in, out); err != nil {
+		return nil, err
+	}
+	out.SetGroupVersionKind(networkingv1beta1.ServiceEntryGroupVersionKind)
+
+	return out, nil
+}
+
+// ConvertServiceEntryV1beta1ToV1alpha3 converts a v1beta1 ServiceEntry to v1alpha3.
+func ConvertServiceEntryV1beta1ToV1alpha3(in *networkingv1beta1.ServiceEntry) (*networkingv1alpha3.ServiceEntry, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	out := &networkingv1alpha3.ServiceEntry{}
+	if err := convert(in, out); err != nil {
+		return nil, err
+	}
+	out.SetGroupVersionKind(networkingv1alpha3.ServiceEntryGroupVersionKind)
+
+	return out, nil
+}
+
+// ConvertSidecarV1alpha3ToV1beta1 converts a v1alpha3 Sidecar to v1beta1.
+func ConvertSidecarV1alpha3ToV1beta1(in *networkingv1alpha3.Sidecar) (*networkingv1beta1.Sidecar, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	out := &networkingv1beta1.Sidecar{}
+	if err := convert(in, out); err != nil {
+		return nil, err
+	}
+	out.SetGroupVersionKind(networkingv1beta1.SidecarGroupVersionKind)
+
+	return out, nil
+}
+
+// ConvertSidecarV1beta1ToV1alpha3 converts a v1beta1 Sidecar to v1alpha3.
+func ConvertSidecarV1beta1ToV1alpha3(in *networkingv1beta1.Sidecar) (*networkingv1alpha3.Sidecar, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	out := &networkingv1alpha3.Sidecar{}
+	if err := convert(in, out); err != nil {
+		return nil, err
+	}
+	out.SetGroupVersionKind(networkingv1alpha3.SidecarGroupVersionKind)
+
+	return out, nil
+}
+
+// ConvertVirtualServiceV1alpha3ToV1beta1 converts a v1alpha3 VirtualService to v1beta1.
+func ConvertVirtualServiceV1alpha3ToV1beta1(in *networkingv1alpha3.VirtualService) (*networkingv1beta1.VirtualService, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	out := &networkingv1beta1.VirtualService{}
+	if err := convert(in, out); err != nil {
+		return nil, err
+	}
+	out.SetGroupVersionKind(networkingv1beta1.VirtualServiceGroupVersionKind)
+
+	return out, nil
+}
+
+// ConvertVirtualServiceV1beta1ToV1alpha3 converts a v1beta1 VirtualService to v1alpha3.
+func ConvertVirtualServiceV1beta1ToV1alpha3(in *networkingv1beta1.VirtualService) (*networkingv1alpha3.VirtualService, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	out := &networkingv1alpha3.VirtualService{}
+	if err := convert(in, out); err != nil {
+		return nil, err
+	}
+	out.SetGroupVersionKind(networkingv1alpha3.VirtualServiceGroupVersionKind)
+
+	return out, nil
+}
+
+// ConvertWorkloadEntryV1alpha3ToV1beta1 converts a v1alpha3 WorkloadEntry to v1beta1.
+func ConvertWorkloadEntryV1alpha3ToV1beta1(in *networkingv1alpha3.WorkloadEntry) (*networkingv1beta1.WorkloadEntry, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	out := &networkingv1beta1.WorkloadEntry{}
+	if err := convert(in, out); err != nil {
+		return nil, err
+	}
+	out.SetGroupVersionKind(networkingv1beta1.WorkloadEntryGroupVersionKind)
+
+	return out, nil
+}
+
+// ConvertWorkloadEntryV1beta1ToV1alpha3 converts a v1beta1 WorkloadEntry to v1alpha3.
+func ConvertWorkloadEntryV1beta1ToV1alpha3(in *networkingv1beta1.WorkloadEntry) (*networkingv1alpha3.WorkloadEntry, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	out := &networkingv1alpha3.WorkloadEntry{}
+	if err := convert(in, out); err != nil {
+		return nil, err
+	}
+	out.SetGroupVersionKind(networkingv1alpha3.WorkloadEntryGroupVersionKind)
+
+	return out, nil
+}
+
+// convert copies in into out through their JSON representation, failing on
+// the fields out has no counterpart for.
+func convert(in, out interface{}) error {
+	data, err := json.Marshal(in)
+	if err != nil {
+		return err
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(out); err != nil {
+		return fmt.Errorf("could not convert %T to %T: %w", in, out, err)
+	}
+
+	return nil
+}
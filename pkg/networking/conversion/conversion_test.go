@@ -0,0 +1,104 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conversion
+
+import (
+	"reflect"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/banzaicloud/istio-client-go/pkg/common/v1alpha1"
+	metav1alpha1 "github.com/banzaicloud/istio-client-go/pkg/meta/v1alpha1"
+	networkingv1alpha3 "github.com/banzaicloud/istio-client-go/pkg/networking/v1alpha3"
+	networkingv1beta1 "github.com/banzaicloud/istio-client-go/pkg/networking/v1beta1"
+)
+
+func TestConvertVirtualServiceRoundTrip(t *testing.T) {
+	weight, subset := int32(80), "v2"
+	in := &networkingv1beta1.VirtualService{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "networking.istio.io/v1beta1", Kind: "VirtualService"},
+		ObjectMeta: metav1.ObjectMeta{Name: "reviews", Namespace: "default", Labels: map[string]string{"app": "reviews"}},
+		Spec: networkingv1beta1.VirtualServiceSpec{
+			Hosts: []string{"reviews"},
+			HTTP: []networkingv1beta1.HTTPRoute{
+				{
+					Match: []*networkingv1beta1.HTTPMatchRequest{
+						{URI: &v1alpha1.StringMatch{Prefix: "/api"}},
+					},
+					Route: []*networkingv1beta1.HTTPRouteDestination{
+						{Destination: &networkingv1beta1.Destination{Host: "reviews", Subset: &subset}, Weight: &weight},
+					},
+				},
+			},
+		},
+	}
+
+	out, err := ConvertVirtualServiceV1beta1ToV1alpha3(in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if out.APIVersion != "networking.istio.io/v1alpha3" || out.Kind != "VirtualService" {
+		t.Fatalf("unexpected type meta %v", out.TypeMeta)
+	}
+	if *out.Spec.HTTP[0].Route[0].Weight != weight || out.Spec.HTTP[0].Match[0].URI.Prefix != "/api" {
+		t.Fatalf("unexpected spec %+v", out.Spec)
+	}
+
+	back, err := ConvertVirtualServiceV1alpha3ToV1beta1(out)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(in, back) {
+		t.Fatalf("round trip changed the object:\n%+v\n!=\n%+v", back, in)
+	}
+}
+
+func TestConvertWorkloadEntryStatus(t *testing.T) {
+	in := &networkingv1alpha3.WorkloadEntry{
+		Spec: networkingv1alpha3.WorkloadEntrySpec{Address: "10.0.0.1"},
+	}
+
+	out, err := ConvertWorkloadEntryV1alpha3ToV1beta1(in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if out.Status != nil {
+		t.Fatalf("expected no status, got %+v", out.Status)
+	}
+
+	in.Status = &metav1alpha1.IstioStatus{ObservedGeneration: 2}
+	out, err = ConvertWorkloadEntryV1alpha3ToV1beta1(in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if out.Status == nil || out.Status.ObservedGeneration != 2 {
+		t.Fatalf("unexpected status %+v", out.Status)
+	}
+}
+
+func TestConvert(t *testing.T) {
+	out := &networkingv1alpha3.Gateway{}
+	if err := Convert(&networkingv1beta1.Gateway{ObjectMeta: metav1.ObjectMeta{Name: "gw"}}, out); err != nil {
+		t.Fatal(err)
+	}
+	if out.Name != "gw" || out.Kind != "Gateway" {
+		t.Fatalf("unexpected gateway %+v", out)
+	}
+
+	if err := Convert(&networkingv1beta1.Gateway{}, &networkingv1alpha3.Sidecar{}); err == nil {
+		t.Fatal("expected an error converting a gateway to a sidecar")
+	}
+}
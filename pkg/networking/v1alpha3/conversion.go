@@ -0,0 +1,38 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha3
+
+// v1alpha3 is the storage version of the networking kinds that are served in
+// v1beta1 as well, so it is the hub every other version is converted through.
+// The Hub methods implement the Hub interface of the controller-runtime
+// conversion package.
+
+// Hub marks this type as a conversion hub.
+func (*DestinationRule) Hub() {}
+
+// Hub marks this type as a conversion hub.
+func (*Gateway) Hub() {}
+
+// Hub marks this type as a conversion hub.
+func (*ServiceEntry) Hub() {}
+
+// Hub marks this type as a conversion hub.
+func (*Sidecar) Hub() {}
+
+// Hub marks this type as a conversion hub.
+func (*VirtualService) Hub() {}
+
+// Hub marks this type as a conversion hub.
+func (*WorkloadEntry) Hub() {}
@@ -16,15 +16,24 @@ package v1alpha3
 
 import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/banzaicloud/istio-client-go/pkg/common/v1alpha1"
+	metav1alpha1 "github.com/banzaicloud/istio-client-go/pkg/meta/v1alpha1"
 )
 
 // +genclient
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+// +kubebuilder:resource:shortName=dr,categories=istio-io;networking-istio-io
+// +kubebuilder:printcolumn:name="Host",type="string",JSONPath=".spec.host",description="The name of a service from the service registry"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp",description="CreationTimestamp is a timestamp representing the server time when this object was created. It is not guaranteed to be set in happens-before order across separate operations. Clients may not set this value. It is represented in RFC3339 form and is in UTC. Populated by the system. Read-only. Null for lists. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#metadata"
 // DestinationRule
 type DestinationRule struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
-	Spec              DestinationRuleSpec `json:"spec"`
+	Spec              DestinationRuleSpec       `json:"spec"`
+	Status            *metav1alpha1.IstioStatus `json:"status,omitempty"`
 }
 
 // `DestinationRule` defines policies that apply to traffic intended for a
@@ -223,7 +232,7 @@ type Subset struct {
 
 	// Labels apply a filter over the endpoints of a service in the
 	// service registry. See route rules for examples of usage.
-	Labels map[string]string `json:"labels"`
+	Labels map[string]string `json:"labels,omitempty"`
 
 	// Traffic policies that apply to this subset. Subsets inherit the
 	// traffic policies specified at the DestinationRule level. Settings
@@ -285,6 +294,7 @@ type LoadBalancerSettings struct {
 	ConsistentHash *ConsistentHashLB `json:"consistentHash,omitempty"`
 }
 
+// +kubebuilder:validation:Enum=DEFAULT;DO_NOT_UPGRADE;UPGRADE
 type H2UpgradePolicy string
 
 const (
@@ -301,6 +311,7 @@ const (
 )
 
 // Standard load balancing algorithms that require no tuning.
+// +kubebuilder:validation:Enum=ROUND_ROBIN;LEAST_CONN;RANDOM;PASSTHROUGH
 type SimpleLB string
 
 const (
@@ -362,7 +373,7 @@ type HTTPCookie struct {
 	Path *string `json:"path,omitempty"`
 
 	// REQUIRED. Lifetime of the cookie.
-	TTL string `json:"ttl"`
+	TTL v1alpha1.Duration `json:"ttl"`
 }
 
 // Connection pool settings for an upstream host. The settings apply to
@@ -404,7 +415,7 @@ type TCPSettings struct {
 	MaxConnections *int32 `json:"maxConnections,omitempty"`
 
 	// TCP connection timeout.
-	ConnectTimeout *string `json:"connectTimeout,omitempty"`
+	ConnectTimeout *v1alpha1.Duration `json:"connectTimeout,omitempty"`
 
 	// If set then set SO_KEEPALIVE on the socket to enable TCP Keepalives.
 	TCPKeepalive *TCPKeepalive `json:"tcpKeepalive,omitempty"`
@@ -419,11 +430,11 @@ type TCPKeepalive struct {
 	// The time duration a connection needs to be idle before keep-alive
 	// probes start being sent. Default is to use the OS level configuration
 	// (unless overridden, Linux defaults to 7200s (ie 2 hours.)
-	Time *string `json:"time,omitempty"`
+	Time *v1alpha1.Duration `json:"time,omitempty"`
 	// The time duration between keep-alive probes.
 	// Default is to use the OS level configuration
 	// (unless overridden, Linux defaults to 75s.)
-	Interval *string `json:"interval,omitempty"`
+	Interval *v1alpha1.Duration `json:"interval,omitempty"`
 }
 
 // Settings applicable to HTTP1.1/HTTP2/GRPC connections.
@@ -445,7 +456,7 @@ type HTTPSettings struct {
 	// The idle timeout for upstream connection pool connections. The idle timeout is defined as the period in which there are no active requests.
 	// If not set, there is no idle timeout. When the idle timeout is reached the connection will be closed.
 	// Note that request based timeouts mean that HTTP/2 PINGs will not keep the connection alive. Applies to both HTTP1.1 and HTTP2 connections.
-	IdleTimeout *string `json:"idleTimeout,omitempty"`
+	IdleTimeout *v1alpha1.Duration `json:"idleTimeout,omitempty"`
 
 	// Specify if http1.1 connection should be upgraded to http2 for the associated destination.
 	H2UpgradePolicy *H2UpgradePolicy `json:"h2UpgradePolicy,omitempty"`
@@ -525,17 +536,19 @@ type OutlierDetection struct {
 
 	// Time interval between ejection sweep analysis. format:
 	// 1h/1m/1s/1ms. MUST BE >=1ms. Default is 10s.
-	Interval *string `json:"interval,omitempty"`
+	Interval *v1alpha1.Duration `json:"interval,omitempty"`
 
 	// Minimum ejection duration. A host will remain ejected for a period
 	// equal to the product of minimum ejection duration and the number of
 	// times the host has been ejected. This technique allows the system to
 	// automatically increase the ejection period for unhealthy upstream
 	// servers. format: 1h/1m/1s/1ms. MUST BE >=1ms. Default is 30s.
-	BaseEjectionTime *string `json:"baseEjectionTime,omitempty"`
+	BaseEjectionTime *v1alpha1.Duration `json:"baseEjectionTime,omitempty"`
 
 	// Maximum % of hosts in the load balancing pool for the upstream
 	// service that can be ejected. Defaults to 10%.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
 	MaxEjectionPercent *int32 `json:"maxEjectionPercent,omitempty"`
 
 	// Outlier detection will be enabled as long as the associated load balancing
@@ -545,6 +558,8 @@ type OutlierDetection struct {
 	// across all hosts in the pool (healthy and unhealthy). The threshold can be
 	// disabled by setting it to 0%. The default is 0% as it's not typically
 	// applicable in k8s environments with few pods per service.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
 	MinHealthPercent *int32 `json:"minHealthPercent,omitempty"`
 }
 
@@ -632,6 +647,7 @@ type TLSSettings struct {
 }
 
 // TLS connection mode
+// +kubebuilder:validation:Enum=DISABLE;SIMPLE;MUTUAL;ISTIO_MUTUAL
 type TLSmode string
 
 const (
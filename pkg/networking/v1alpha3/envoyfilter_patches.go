@@ -0,0 +1,187 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha3
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/banzaicloud/istio-client-go/pkg/common/v1alpha1"
+)
+
+// The names of the Envoy filters the patches refer to.
+const (
+	HTTPConnectionManagerFilterName = "envoy.filters.network.http_connection_manager"
+	RouterFilterName                = "envoy.filters.http.router"
+	LuaFilterName                   = "envoy.filters.http.lua"
+	LocalRateLimitFilterName        = "envoy.filters.http.local_ratelimit"
+)
+
+// MinLocalRateLimitFillInterval is the shortest fill interval Envoy accepts
+// for the token bucket of a local rate limit.
+const MinLocalRateLimitFillInterval = 50 * time.Millisecond
+
+// HTTPFilter is an HTTP filter of the Envoy configuration.
+type HTTPFilter struct {
+	// Name of the filter, e.g. envoy.filters.http.lua.
+	Name string
+	// TypedConfig is the configuration of the filter, its @type included.
+	TypedConfig map[string]interface{}
+}
+
+// LocalRateLimit configures the token bucket of a local rate limit: the
+// bucket holds up to MaxTokens, and TokensPerFill are added every
+// FillInterval. Each request takes a token, and the requests finding the
+// bucket empty are answered with 429 Too Many Requests.
+type LocalRateLimit struct {
+	// StatPrefix of the statistics of the filter, local_rate_limiter if empty.
+	StatPrefix    string
+	MaxTokens     uint32
+	TokensPerFill uint32
+	FillInterval  time.Duration
+}
+
+// HTTPFilterBeforeRouterPatch returns a patch inserting the filter right
+// before the router filter of the HTTP connection managers of the listeners
+// in context, e.g. PatchContextSidecarInbound. A zero port patches the
+// listeners of every port. The order matters, since the router filter is
+// the last one of a chain and ends the processing of a request.
+func HTTPFilterBeforeRouterPatch(context PatchContext, port uint32, filter HTTPFilter) (*EnvoyConfigObjectPatch, error) {
+	if filter.Name == "" {
+		return nil, errors.New("the HTTP filter must have a name")
+	}
+	if _, ok := filter.TypedConfig["@type"]; !ok {
+		return nil, fmt.Errorf("the typed config of the HTTP filter %s must have an @type", filter.Name)
+	}
+
+	value, err := v1alpha1.NewJSON(map[string]interface{}{
+		"name":         filter.Name,
+		"typed_config": filter.TypedConfig,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &EnvoyConfigObjectPatch{
+		ApplyTo: ApplyToHTTPFilter,
+		Match: &EnvoyConfigObjectMatch{
+			Context: context,
+			Listener: &ListenerMatch{
+				PortNumber: port,
+				FilterChain: &FilterChainMatch{
+					Filter: &FilterMatch{
+						Name:      HTTPConnectionManagerFilterName,
+						SubFilter: &SubFilterMatch{Name: RouterFilterName},
+					},
+				},
+			},
+		},
+		Patch: &Patch{
+			Operation: PatchOperationInsertBefore,
+			Value:     value,
+		},
+	}, nil
+}
+
+// LuaFilter returns a Lua filter running the inline code, which defines
+// the envoy_on_request and/or envoy_on_response functions.
+func LuaFilter(inlineCode string) HTTPFilter {
+	return HTTPFilter{
+		Name: LuaFilterName,
+		TypedConfig: map[string]interface{}{
+			"@type":       "type.googleapis.com/envoy.extensions.filters.http.lua.v3.Lua",
+			"inline_code": inlineCode,
+		},
+	}
+}
+
+// LuaFilterPatch returns a patch inserting a Lua filter running the inline
+// code before the router filter, see HTTPFilterBeforeRouterPatch.
+func LuaFilterPatch(context PatchContext, port uint32, inlineCode string) (*EnvoyConfigObjectPatch, error) {
+	if inlineCode == "" {
+		return nil, errors.New("the Lua filter must have inline code")
+	}
+
+	return HTTPFilterBeforeRouterPatch(context, port, LuaFilter(inlineCode))
+}
+
+// LocalRateLimitFilter returns a local rate limit filter enforcing the limit
+// on every request. It returns an error if the limit is invalid.
+func LocalRateLimitFilter(limit LocalRateLimit) (HTTPFilter, error) {
+	if limit.MaxTokens == 0 {
+		return HTTPFilter{}, errors.New("the local rate limit must have at least one token")
+	}
+	if limit.FillInterval < MinLocalRateLimitFillInterval {
+		return HTTPFilter{}, fmt.Errorf("the fill interval %s of the local rate limit must be at least %s", limit.FillInterval, MinLocalRateLimitFillInterval)
+	}
+	tokensPerFill := limit.TokensPerFill
+	if tokensPerFill == 0 {
+		tokensPerFill = 1
+	}
+	statPrefix := limit.StatPrefix
+	if statPrefix == "" {
+		statPrefix = "local_rate_limiter"
+	}
+
+	return HTTPFilter{
+		Name: LocalRateLimitFilterName,
+		TypedConfig: map[string]interface{}{
+			"@type":    "type.googleapis.com/udpa.type.v1.TypedStruct",
+			"type_url": "type.googleapis.com/envoy.extensions.filters.http.local_ratelimit.v3.LocalRateLimit",
+			"value": map[string]interface{}{
+				"stat_prefix": statPrefix,
+				"token_bucket": map[string]interface{}{
+					"max_tokens":      limit.MaxTokens,
+					"tokens_per_fill": tokensPerFill,
+					"fill_interval":   protoDuration(limit.FillInterval),
+				},
+				"filter_enabled":  allRequests("local_rate_limit_enabled"),
+				"filter_enforced": allRequests("local_rate_limit_enforced"),
+			},
+		},
+	}, nil
+}
+
+// LocalRateLimitPatch returns a patch inserting a local rate limit filter
+// before the router filter, see HTTPFilterBeforeRouterPatch. The limit
+// applies to each proxy separately.
+func LocalRateLimitPatch(context PatchContext, port uint32, limit LocalRateLimit) (*EnvoyConfigObjectPatch, error) {
+	filter, err := LocalRateLimitFilter(limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return HTTPFilterBeforeRouterPatch(context, port, filter)
+}
+
+// protoDuration formats the duration the way the JSON encoding of the
+// protobuf durations does, in seconds, e.g. 1.5s.
+func protoDuration(d time.Duration) string {
+	return strconv.FormatFloat(d.Seconds(), 'f', -1, 64) + "s"
+}
+
+// allRequests returns a runtime fraction of 100 percent of the requests,
+// which the runtime key may override.
+func allRequests(runtimeKey string) map[string]interface{} {
+	return map[string]interface{}{
+		"runtime_key": runtimeKey,
+		"default_value": map[string]interface{}{
+			"numerator":   100,
+			"denominator": "HUNDRED",
+		},
+	}
+}
@@ -0,0 +1,86 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha3
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestLuaFilterPatch(t *testing.T) {
+	patch, err := LuaFilterPatch(PatchContextSidecarInbound, 8080, "function envoy_on_request(handle) end")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := json.Marshal(patch)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := `{"applyTo":"HTTP_FILTER","match":{"context":"SIDECAR_INBOUND","listener":{"portNumber":8080,` +
+		`"filterChain":{"filter":{"name":"envoy.filters.network.http_connection_manager","subFilter":{"name":"envoy.filters.http.router"}}}}},` +
+		`"patch":{"operation":"INSERT_BEFORE","value":{"name":"envoy.filters.http.lua","typed_config":` +
+		`{"@type":"type.googleapis.com/envoy.extensions.filters.http.lua.v3.Lua","inline_code":"function envoy_on_request(handle) end"}}}}`
+	if string(data) != expected {
+		t.Errorf("unexpected patch %s, expected %s", data, expected)
+	}
+
+	if _, err := LuaFilterPatch(PatchContextGateway, 0, ""); err == nil {
+		t.Error("expected a Lua filter without code to be rejected")
+	}
+}
+
+func TestLocalRateLimitPatch(t *testing.T) {
+	patch, err := LocalRateLimitPatch(PatchContextGateway, 0, LocalRateLimit{MaxTokens: 10, FillInterval: 1500 * time.Millisecond})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var value struct {
+		TypedConfig struct {
+			Value struct {
+				StatPrefix  string `json:"stat_prefix"`
+				TokenBucket struct {
+					MaxTokens     uint32 `json:"max_tokens"`
+					TokensPerFill uint32 `json:"tokens_per_fill"`
+					FillInterval  string `json:"fill_interval"`
+				} `json:"token_bucket"`
+			} `json:"value"`
+		} `json:"typed_config"`
+	}
+	if err := patch.Patch.Value.Unmarshal(&value); err != nil {
+		t.Fatal(err)
+	}
+	config := value.TypedConfig.Value
+	if config.StatPrefix != "local_rate_limiter" || config.TokenBucket.MaxTokens != 10 || config.TokenBucket.TokensPerFill != 1 || config.TokenBucket.FillInterval != "1.5s" {
+		t.Errorf("unexpected configuration %+v", config)
+	}
+
+	for _, limit := range []LocalRateLimit{
+		{FillInterval: time.Second},
+		{MaxTokens: 10, FillInterval: time.Millisecond},
+	} {
+		if _, err := LocalRateLimitPatch(PatchContextGateway, 0, limit); err == nil {
+			t.Errorf("expected %+v to be rejected", limit)
+		}
+	}
+}
+
+func TestHTTPFilterBeforeRouterPatchRequiresType(t *testing.T) {
+	if _, err := HTTPFilterBeforeRouterPatch(PatchContextAny, 0, HTTPFilter{Name: "envoy.filters.http.cors"}); err == nil {
+		t.Error("expected a filter without @type to be rejected")
+	}
+}
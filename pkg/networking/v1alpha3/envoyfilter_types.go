@@ -15,13 +15,17 @@
 package v1alpha3
 
 import (
-	"encoding/json"
-
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/banzaicloud/istio-client-go/pkg/common/v1alpha1"
+
+	metav1alpha1 "github.com/banzaicloud/istio-client-go/pkg/meta/v1alpha1"
 )
 
 // +genclient
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:categories=istio-io;networking-istio-io
 // EnvoyFilter
 type EnvoyFilter struct {
 	v1.TypeMeta `json:",inline"`
@@ -31,6 +35,8 @@ type EnvoyFilter struct {
 	// Spec defines the implementation of this definition.
 	// +optional
 	Spec EnvoyFilterSpec `json:"spec,omitempty"`
+	// +optional
+	Status *metav1alpha1.IstioStatus `json:"status,omitempty"`
 }
 
 // `EnvoyFilter` provides a mechanism to customize the Envoy
@@ -241,7 +247,7 @@ type EnvoyConfigObjectMatch struct {
 	// The specific config generation context to match on. Istio Pilot
 	// generates envoy configuration in the context of a gateway,
 	// inbound traffic to sidecar and outbound traffic from sidecar.
-	Context PatchContext `json:"context"`
+	Context PatchContext `json:"context,omitempty"`
 	// Match on properties associated with a proxy.
 	Proxy *ProxyMatch `json:"proxy,omitempty"`
 	// Types that are valid to be assigned to ObjectTypes:
@@ -325,7 +331,7 @@ type Patch struct {
 	Operation PatchOperation `json:"operation,omitempty"`
 	// The JSON config of the object being patched. This will be merged using
 	// json merge semantics with the existing proto in the path.
-	Value json.RawMessage `json:"value,omitempty"`
+	Value *v1alpha1.JSON `json:"value,omitempty"`
 }
 
 // Conditions specified in RouteConfigurationMatch must be met for
@@ -433,6 +439,7 @@ type SubFilterMatch struct {
 }
 
 // ApplyTo specifies where in the Envoy configuration, the given patch should be applied.
+// +kubebuilder:validation:Enum=INVALID;LISTENER;FILTER_CHAIN;NETWORK_FILTER;HTTP_FILTER;ROUTE_CONFIGURATION;VIRTUAL_HOST;HTTP_ROUTE;CLUSTER
 type ApplyTo string
 
 const (
@@ -465,6 +472,7 @@ const (
 
 // Operation denotes how the patch should be applied to the selected
 // configuration.
+// +kubebuilder:validation:Enum=INVALID;MERGE;ADD;REMOVE;INSERT_BEFORE;INSERT_AFTER;INSERT_FIRST
 type PatchOperation string
 
 const (
@@ -511,6 +519,7 @@ const (
 
 // PatchContext selects a class of configurations based on the
 // traffic flow direction and workload type.
+// +kubebuilder:validation:Enum=ANY;SIDECAR_INBOUND;SIDECAR_OUTBOUND;GATEWAY
 type PatchContext string
 
 const (
@@ -525,6 +534,7 @@ const (
 )
 
 // Action refers to the route action taken by Envoy when a http route matches.
+// +kubebuilder:validation:Enum=ANY;ROUTE;REDIRECT;DIRECT_RESPONSE
 type RouteMatchAction string
 
 const (
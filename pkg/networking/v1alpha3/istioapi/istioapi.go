@@ -0,0 +1,65 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package v1alpha1 is kept for compatibility only: the status of the Istio
+// resources moved to github.com/banzaicloud/istio-client-go/pkg/meta/v1alpha1
+// and the analysis messages to
+// github.com/banzaicloud/istio-client-go/pkg/analysis/v1alpha1, the same
+// packages as in istio.io/api. The declarations below are aliases of theirs.
+//
+// Deprecated: use the meta and analysis packages instead.
+package v1alpha1
+
+import (
+	analysisv1alpha1 "github.com/banzaicloud/istio-client-go/pkg/analysis/v1alpha1"
+	metav1alpha1 "github.com/banzaicloud/istio-client-go/pkg/meta/v1alpha1"
+)
+
+// Deprecated: use metav1alpha1.IstioStatus.
+type IstioStatus = metav1alpha1.IstioStatus
+
+// Deprecated: use metav1alpha1.IstioCondition.
+type IstioCondition = metav1alpha1.IstioCondition
+
+// Deprecated: use the constants of the metav1alpha1 package.
+const (
+	ConditionReconciled    = metav1alpha1.ConditionReconciled
+	ConditionHealthy       = metav1alpha1.ConditionHealthy
+	ConditionStatusTrue    = metav1alpha1.ConditionStatusTrue
+	ConditionStatusFalse   = metav1alpha1.ConditionStatusFalse
+	ConditionStatusUnknown = metav1alpha1.ConditionStatusUnknown
+)
+
+// Deprecated: use analysisv1alpha1.AnalysisMessageBase.
+type AnalysisMessageBase = analysisv1alpha1.AnalysisMessageBase
+
+// Deprecated: use analysisv1alpha1.AnalysisMessageBase_Type.
+type AnalysisMessageBase_Type = analysisv1alpha1.AnalysisMessageBase_Type
+
+// Deprecated: use analysisv1alpha1.AnalysisMessageBase_Level.
+type AnalysisMessageBase_Level = analysisv1alpha1.AnalysisMessageBase_Level
+
+// Deprecated: use the constants of the analysisv1alpha1 package.
+const (
+	AnalysisMessageBase_UNKNOWN = analysisv1alpha1.AnalysisMessageBase_UNKNOWN
+	AnalysisMessageBase_ERROR   = analysisv1alpha1.AnalysisMessageBase_ERROR
+	AnalysisMessageBase_WARNING = analysisv1alpha1.AnalysisMessageBase_WARNING
+	AnalysisMessageBase_INFO    = analysisv1alpha1.AnalysisMessageBase_INFO
+)
+
+// Deprecated: use the variables of the analysisv1alpha1 package.
+var (
+	AnalysisMessageBase_Level_name  = analysisv1alpha1.AnalysisMessageBase_Level_name
+	AnalysisMessageBase_Level_value = analysisv1alpha1.AnalysisMessageBase_Level_value
+)
@@ -1,51 +0,0 @@
-// Code generated by protoc-gen-gogo. DO NOT EDIT.
-// source: meta/v1alpha1/status.proto
-
-package v1alpha1
-
-import (
-	proto "github.com/gogo/protobuf/proto"
-	types "github.com/gogo/protobuf/types"
-)
-
-type IstioStatus struct {
-	// Current service state of pod.
-	// More info: https://istio.io/docs/reference/config/config-status/
-	// +optional
-	// +patchMergeKey=type
-	// +patchStrategy=merge
-	Conditions []*IstioCondition `json:"conditions,omitempty"`
-	// Resource Generation to which the Reconciled Condition refers.
-	// When this value is not equal to the object's metadata generation, reconciled condition  calculation for the current
-	// generation is still in progress.  See https://istio.io/latest/docs/reference/config/config-status/ for more info.
-	// +optional
-	ObservedGeneration   int64    `json:"observedGeneration,omitempty"`
-}
-
-func (m *IstioStatus) Reset()         { *m = IstioStatus{} }
-func (m *IstioStatus) String() string { return proto.CompactTextString(m) }
-func (*IstioStatus) ProtoMessage()    {}
-
-type IstioCondition struct {
-	// Type is the type of the condition.
-	Type string `json:"type,omitempty"`
-	// Status is the status of the condition.
-	// Can be True, False, Unknown.
-	Status string `json:"status,omitempty"`
-	// Last time we probed the condition.
-	// +optional
-	LastProbeTime *types.Timestamp `json:"lastProbeTime,omitempty"`
-	// Last time the condition transitioned from one status to another.
-	// +optional
-	LastTransitionTime *types.Timestamp `json:"lastTransitionTime,omitempty"`
-	// Unique, one-word, CamelCase reason for the condition's last transition.
-	// +optional
-	Reason string `json:"reason,omitempty"`
-	// Human-readable message indicating details about last transition.
-	// +optional
-	Message              string   `json:"message,omitempty"`
-}
-
-func (m *IstioCondition) Reset()         { *m = IstioCondition{} }
-func (m *IstioCondition) String() string { return proto.CompactTextString(m) }
-func (*IstioCondition) ProtoMessage()    {}
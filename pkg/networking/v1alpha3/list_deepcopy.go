@@ -0,0 +1,164 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha3
+
+// The DeepCopyItemsInto methods below copy the items of a list into a slice
+// owned by the caller, which is meant for snapshotting caches: the same
+// snapshot slice can be refilled over and over without allocating the items
+// again.
+
+// DeepCopyItemsInto deep copies the items of the list into dst and returns the
+// result. The backing array of dst is reused when its capacity is large
+// enough; the items it held are overwritten.
+func (in *DestinationRuleList) DeepCopyItemsInto(dst []DestinationRule) []DestinationRule {
+	if in == nil {
+		return dst[:0]
+	}
+	if cap(dst) < len(in.Items) {
+		dst = make([]DestinationRule, len(in.Items))
+	} else {
+		dst = dst[:len(in.Items)]
+	}
+	for i := range in.Items {
+		in.Items[i].DeepCopyInto(&dst[i])
+	}
+	return dst
+}
+
+// DeepCopyItemsInto deep copies the items of the list into dst and returns the
+// result. The backing array of dst is reused when its capacity is large
+// enough; the items it held are overwritten.
+func (in *EnvoyFilterList) DeepCopyItemsInto(dst []EnvoyFilter) []EnvoyFilter {
+	if in == nil {
+		return dst[:0]
+	}
+	if cap(dst) < len(in.Items) {
+		dst = make([]EnvoyFilter, len(in.Items))
+	} else {
+		dst = dst[:len(in.Items)]
+	}
+	for i := range in.Items {
+		in.Items[i].DeepCopyInto(&dst[i])
+	}
+	return dst
+}
+
+// DeepCopyItemsInto deep copies the items of the list into dst and returns the
+// result. The backing array of dst is reused when its capacity is large
+// enough; the items it held are overwritten.
+func (in *GatewayList) DeepCopyItemsInto(dst []Gateway) []Gateway {
+	if in == nil {
+		return dst[:0]
+	}
+	if cap(dst) < len(in.Items) {
+		dst = make([]Gateway, len(in.Items))
+	} else {
+		dst = dst[:len(in.Items)]
+	}
+	for i := range in.Items {
+		in.Items[i].DeepCopyInto(&dst[i])
+	}
+	return dst
+}
+
+// DeepCopyItemsInto deep copies the items of the list into dst and returns the
+// result. The backing array of dst is reused when its capacity is large
+// enough; the items it held are overwritten.
+func (in *ServiceEntryList) DeepCopyItemsInto(dst []ServiceEntry) []ServiceEntry {
+	if in == nil {
+		return dst[:0]
+	}
+	if cap(dst) < len(in.Items) {
+		dst = make([]ServiceEntry, len(in.Items))
+	} else {
+		dst = dst[:len(in.Items)]
+	}
+	for i := range in.Items {
+		in.Items[i].DeepCopyInto(&dst[i])
+	}
+	return dst
+}
+
+// DeepCopyItemsInto deep copies the items of the list into dst and returns the
+// result. The backing array of dst is reused when its capacity is large
+// enough; the items it held are overwritten.
+func (in *SidecarList) DeepCopyItemsInto(dst []Sidecar) []Sidecar {
+	if in == nil {
+		return dst[:0]
+	}
+	if cap(dst) < len(in.Items) {
+		dst = make([]Sidecar, len(in.Items))
+	} else {
+		dst = dst[:len(in.Items)]
+	}
+	for i := range in.Items {
+		in.Items[i].DeepCopyInto(&dst[i])
+	}
+	return dst
+}
+
+// DeepCopyItemsInto deep copies the items of the list into dst and returns the
+// result. The backing array of dst is reused when its capacity is large
+// enough; the items it held are overwritten.
+func (in *VirtualServiceList) DeepCopyItemsInto(dst []VirtualService) []VirtualService {
+	if in == nil {
+		return dst[:0]
+	}
+	if cap(dst) < len(in.Items) {
+		dst = make([]VirtualService, len(in.Items))
+	} else {
+		dst = dst[:len(in.Items)]
+	}
+	for i := range in.Items {
+		in.Items[i].DeepCopyInto(&dst[i])
+	}
+	return dst
+}
+
+// DeepCopyItemsInto deep copies the items of the list into dst and returns the
+// result. The backing array of dst is reused when its capacity is large
+// enough; the items it held are overwritten.
+func (in *WorkloadEntryList) DeepCopyItemsInto(dst []WorkloadEntry) []WorkloadEntry {
+	if in == nil {
+		return dst[:0]
+	}
+	if cap(dst) < len(in.Items) {
+		dst = make([]WorkloadEntry, len(in.Items))
+	} else {
+		dst = dst[:len(in.Items)]
+	}
+	for i := range in.Items {
+		in.Items[i].DeepCopyInto(&dst[i])
+	}
+	return dst
+}
+
+// DeepCopyItemsInto deep copies the items of the list into dst and returns the
+// result. The backing array of dst is reused when its capacity is large
+// enough; the items it held are overwritten.
+func (in *WorkloadGroupList) DeepCopyItemsInto(dst []WorkloadGroup) []WorkloadGroup {
+	if in == nil {
+		return dst[:0]
+	}
+	if cap(dst) < len(in.Items) {
+		dst = make([]WorkloadGroup, len(in.Items))
+	} else {
+		dst = dst[:len(in.Items)]
+	}
+	for i := range in.Items {
+		in.Items[i].DeepCopyInto(&dst[i])
+	}
+	return dst
+}
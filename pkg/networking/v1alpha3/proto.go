@@ -0,0 +1,213 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha3
+
+import (
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/banzaicloud/istio-client-go/pkg/protoconv"
+)
+
+// ToProto converts the spec into out, a *DestinationRule of istio.io/api/networking/v1alpha3.
+func (s *DestinationRuleSpec) ToProto(out proto.Message) error {
+	return protoconv.ToProto(s, out)
+}
+
+// FromProto sets the spec from in, a *DestinationRule of istio.io/api/networking/v1alpha3.
+func (s *DestinationRuleSpec) FromProto(in proto.Message) error {
+	*s = DestinationRuleSpec{}
+	return protoconv.FromProto(in, s)
+}
+
+// MarshalProtoJSON encodes the spec the way Istio's own tooling does, through
+// message, an empty *DestinationRule of istio.io/api/networking/v1alpha3.
+func (s *DestinationRuleSpec) MarshalProtoJSON(message proto.Message) ([]byte, error) {
+	return protoconv.Marshal(s, message)
+}
+
+// UnmarshalProtoJSON sets the spec from data, a *DestinationRule of
+// istio.io/api/networking/v1alpha3 encoded the way Istio's own tooling does.
+func (s *DestinationRuleSpec) UnmarshalProtoJSON(data []byte, message proto.Message) error {
+	*s = DestinationRuleSpec{}
+	return protoconv.Unmarshal(data, message, s)
+}
+
+// ToProto converts the spec into out, a *EnvoyFilter of istio.io/api/networking/v1alpha3.
+func (s *EnvoyFilterSpec) ToProto(out proto.Message) error {
+	return protoconv.ToProto(s, out)
+}
+
+// FromProto sets the spec from in, a *EnvoyFilter of istio.io/api/networking/v1alpha3.
+func (s *EnvoyFilterSpec) FromProto(in proto.Message) error {
+	*s = EnvoyFilterSpec{}
+	return protoconv.FromProto(in, s)
+}
+
+// MarshalProtoJSON encodes the spec the way Istio's own tooling does, through
+// message, an empty *EnvoyFilter of istio.io/api/networking/v1alpha3.
+func (s *EnvoyFilterSpec) MarshalProtoJSON(message proto.Message) ([]byte, error) {
+	return protoconv.Marshal(s, message)
+}
+
+// UnmarshalProtoJSON sets the spec from data, a *EnvoyFilter of
+// istio.io/api/networking/v1alpha3 encoded the way Istio's own tooling does.
+func (s *EnvoyFilterSpec) UnmarshalProtoJSON(data []byte, message proto.Message) error {
+	*s = EnvoyFilterSpec{}
+	return protoconv.Unmarshal(data, message, s)
+}
+
+// ToProto converts the spec into out, a *Gateway of istio.io/api/networking/v1alpha3.
+func (s *GatewaySpec) ToProto(out proto.Message) error {
+	return protoconv.ToProto(s, out)
+}
+
+// FromProto sets the spec from in, a *Gateway of istio.io/api/networking/v1alpha3.
+func (s *GatewaySpec) FromProto(in proto.Message) error {
+	*s = GatewaySpec{}
+	return protoconv.FromProto(in, s)
+}
+
+// MarshalProtoJSON encodes the spec the way Istio's own tooling does, through
+// message, an empty *Gateway of istio.io/api/networking/v1alpha3.
+func (s *GatewaySpec) MarshalProtoJSON(message proto.Message) ([]byte, error) {
+	return protoconv.Marshal(s, message)
+}
+
+// UnmarshalProtoJSON sets the spec from data, a *Gateway of
+// istio.io/api/networking/v1alpha3 encoded the way Istio's own tooling does.
+func (s *GatewaySpec) UnmarshalProtoJSON(data []byte, message proto.Message) error {
+	*s = GatewaySpec{}
+	return protoconv.Unmarshal(data, message, s)
+}
+
+// ToProto converts the spec into out, a *ServiceEntry of istio.io/api/networking/v1alpha3.
+func (s *ServiceEntrySpec) ToProto(out proto.Message) error {
+	return protoconv.ToProto(s, out)
+}
+
+// FromProto sets the spec from in, a *ServiceEntry of istio.io/api/networking/v1alpha3.
+func (s *ServiceEntrySpec) FromProto(in proto.Message) error {
+	*s = ServiceEntrySpec{}
+	return protoconv.FromProto(in, s)
+}
+
+// MarshalProtoJSON encodes the spec the way Istio's own tooling does, through
+// message, an empty *ServiceEntry of istio.io/api/networking/v1alpha3.
+func (s *ServiceEntrySpec) MarshalProtoJSON(message proto.Message) ([]byte, error) {
+	return protoconv.Marshal(s, message)
+}
+
+// UnmarshalProtoJSON sets the spec from data, a *ServiceEntry of
+// istio.io/api/networking/v1alpha3 encoded the way Istio's own tooling does.
+func (s *ServiceEntrySpec) UnmarshalProtoJSON(data []byte, message proto.Message) error {
+	*s = ServiceEntrySpec{}
+	return protoconv.Unmarshal(data, message, s)
+}
+
+// ToProto converts the spec into out, a *Sidecar of istio.io/api/networking/v1alpha3.
+func (s *SidecarSpec) ToProto(out proto.Message) error {
+	return protoconv.ToProto(s, out)
+}
+
+// FromProto sets the spec from in, a *Sidecar of istio.io/api/networking/v1alpha3.
+func (s *SidecarSpec) FromProto(in proto.Message) error {
+	*s = SidecarSpec{}
+	return protoconv.FromProto(in, s)
+}
+
+// MarshalProtoJSON encodes the spec the way Istio's own tooling does, through
+// message, an empty *Sidecar of istio.io/api/networking/v1alpha3.
+func (s *SidecarSpec) MarshalProtoJSON(message proto.Message) ([]byte, error) {
+	return protoconv.Marshal(s, message)
+}
+
+// UnmarshalProtoJSON sets the spec from data, a *Sidecar of
+// istio.io/api/networking/v1alpha3 encoded the way Istio's own tooling does.
+func (s *SidecarSpec) UnmarshalProtoJSON(data []byte, message proto.Message) error {
+	*s = SidecarSpec{}
+	return protoconv.Unmarshal(data, message, s)
+}
+
+// ToProto converts the spec into out, a *VirtualService of istio.io/api/networking/v1alpha3.
+func (s *VirtualServiceSpec) ToProto(out proto.Message) error {
+	return protoconv.ToProto(s, out)
+}
+
+// FromProto sets the spec from in, a *VirtualService of istio.io/api/networking/v1alpha3.
+func (s *VirtualServiceSpec) FromProto(in proto.Message) error {
+	*s = VirtualServiceSpec{}
+	return protoconv.FromProto(in, s)
+}
+
+// MarshalProtoJSON encodes the spec the way Istio's own tooling does, through
+// message, an empty *VirtualService of istio.io/api/networking/v1alpha3.
+func (s *VirtualServiceSpec) MarshalProtoJSON(message proto.Message) ([]byte, error) {
+	return protoconv.Marshal(s, message)
+}
+
+// UnmarshalProtoJSON sets the spec from data, a *VirtualService of
+// istio.io/api/networking/v1alpha3 encoded the way Istio's own tooling does.
+func (s *VirtualServiceSpec) UnmarshalProtoJSON(data []byte, message proto.Message) error {
+	*s = VirtualServiceSpec{}
+	return protoconv.Unmarshal(data, message, s)
+}
+
+// ToProto converts the spec into out, a *WorkloadEntry of istio.io/api/networking/v1alpha3.
+func (s *WorkloadEntrySpec) ToProto(out proto.Message) error {
+	return protoconv.ToProto(s, out)
+}
+
+// FromProto sets the spec from in, a *WorkloadEntry of istio.io/api/networking/v1alpha3.
+func (s *WorkloadEntrySpec) FromProto(in proto.Message) error {
+	*s = WorkloadEntrySpec{}
+	return protoconv.FromProto(in, s)
+}
+
+// MarshalProtoJSON encodes the spec the way Istio's own tooling does, through
+// message, an empty *WorkloadEntry of istio.io/api/networking/v1alpha3.
+func (s *WorkloadEntrySpec) MarshalProtoJSON(message proto.Message) ([]byte, error) {
+	return protoconv.Marshal(s, message)
+}
+
+// UnmarshalProtoJSON sets the spec from data, a *WorkloadEntry of
+// istio.io/api/networking/v1alpha3 encoded the way Istio's own tooling does.
+func (s *WorkloadEntrySpec) UnmarshalProtoJSON(data []byte, message proto.Message) error {
+	*s = WorkloadEntrySpec{}
+	return protoconv.Unmarshal(data, message, s)
+}
+
+// ToProto converts the spec into out, a *WorkloadGroup of istio.io/api/networking/v1alpha3.
+func (s *WorkloadGroupSpec) ToProto(out proto.Message) error {
+	return protoconv.ToProto(s, out)
+}
+
+// FromProto sets the spec from in, a *WorkloadGroup of istio.io/api/networking/v1alpha3.
+func (s *WorkloadGroupSpec) FromProto(in proto.Message) error {
+	*s = WorkloadGroupSpec{}
+	return protoconv.FromProto(in, s)
+}
+
+// MarshalProtoJSON encodes the spec the way Istio's own tooling does, through
+// message, an empty *WorkloadGroup of istio.io/api/networking/v1alpha3.
+func (s *WorkloadGroupSpec) MarshalProtoJSON(message proto.Message) ([]byte, error) {
+	return protoconv.Marshal(s, message)
+}
+
+// UnmarshalProtoJSON sets the spec from data, a *WorkloadGroup of
+// istio.io/api/networking/v1alpha3 encoded the way Istio's own tooling does.
+func (s *WorkloadGroupSpec) UnmarshalProtoJSON(data []byte, message proto.Message) error {
+	*s = WorkloadGroupSpec{}
+	return protoconv.Unmarshal(data, message, s)
+}
@@ -35,6 +35,26 @@ func Resource(resource string) schema.GroupResource {
 	return SchemeGroupVersion.WithResource(resource).GroupResource()
 }
 
+// GroupVersionKinds and GroupVersionResources of the types in this group version
+var (
+	DestinationRuleGroupVersionKind     = SchemeGroupVersion.WithKind("DestinationRule")
+	DestinationRuleGroupVersionResource = SchemeGroupVersion.WithResource("destinationrules")
+	EnvoyFilterGroupVersionKind         = SchemeGroupVersion.WithKind("EnvoyFilter")
+	EnvoyFilterGroupVersionResource     = SchemeGroupVersion.WithResource("envoyfilters")
+	GatewayGroupVersionKind             = SchemeGroupVersion.WithKind("Gateway")
+	GatewayGroupVersionResource         = SchemeGroupVersion.WithResource("gateways")
+	ServiceEntryGroupVersionKind        = SchemeGroupVersion.WithKind("ServiceEntry")
+	ServiceEntryGroupVersionResource    = SchemeGroupVersion.WithResource("serviceentries")
+	SidecarGroupVersionKind             = SchemeGroupVersion.WithKind("Sidecar")
+	SidecarGroupVersionResource         = SchemeGroupVersion.WithResource("sidecars")
+	VirtualServiceGroupVersionKind      = SchemeGroupVersion.WithKind("VirtualService")
+	VirtualServiceGroupVersionResource  = SchemeGroupVersion.WithResource("virtualservices")
+	WorkloadEntryGroupVersionKind       = SchemeGroupVersion.WithKind("WorkloadEntry")
+	WorkloadEntryGroupVersionResource   = SchemeGroupVersion.WithResource("workloadentries")
+	WorkloadGroupGroupVersionKind       = SchemeGroupVersion.WithKind("WorkloadGroup")
+	WorkloadGroupGroupVersionResource   = SchemeGroupVersion.WithResource("workloadgroups")
+)
+
 var (
 	SchemeBuilder = runtime.NewSchemeBuilder(addKnownTypes)
 	AddToScheme   = SchemeBuilder.AddToScheme
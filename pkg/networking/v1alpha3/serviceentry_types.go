@@ -16,6 +16,8 @@ package v1alpha3
 
 import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	metav1alpha1 "github.com/banzaicloud/istio-client-go/pkg/meta/v1alpha1"
 )
 
 // Location specifies whether the service is part of Istio mesh or
@@ -24,6 +26,7 @@ import (
 // enforcement, etc. When communicating with services outside the mesh,
 // Istio's mTLS authentication is disabled, and policy enforcement is
 // performed on the client-side as opposed to server-side.
+// +kubebuilder:validation:Enum=MESH_EXTERNAL;MESH_INTERNAL
 type ServiceEntryLocation string
 
 const (
@@ -47,6 +50,7 @@ const (
 // Proxy. Alternatively, for HTTP services, the application could
 // directly communicate with the proxy (e.g., by setting HTTP_PROXY) to
 // talk to these services.
+// +kubebuilder:validation:Enum=NONE;STATIC;DNS
 type ServiceEntryResolution string
 
 const (
@@ -74,6 +78,13 @@ const (
 
 // +genclient
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+// +kubebuilder:resource:shortName=se,categories=istio-io;networking-istio-io
+// +kubebuilder:printcolumn:name="Hosts",type="string",JSONPath=".spec.hosts",description="The hosts associated with the ServiceEntry"
+// +kubebuilder:printcolumn:name="Location",type="string",JSONPath=".spec.location",description="Whether the service is external to the mesh or part of the mesh (MESH_EXTERNAL or MESH_INTERNAL)"
+// +kubebuilder:printcolumn:name="Resolution",type="string",JSONPath=".spec.resolution",description="Service discovery mode for the hosts (NONE, STATIC, or DNS)"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp",description="CreationTimestamp is a timestamp representing the server time when this object was created. It is not guaranteed to be set in happens-before order across separate operations. Clients may not set this value. It is represented in RFC3339 form and is in UTC. Populated by the system. Read-only. Null for lists. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#metadata"
 
 // `ServiceEntry` enables adding additional entries into Istio's internal
 // service registry, so that auto-discovered services in the mesh can
@@ -389,7 +400,8 @@ const (
 type ServiceEntry struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
-	Spec              ServiceEntrySpec `json:"spec"`
+	Spec              ServiceEntrySpec          `json:"spec"`
+	Status            *metav1alpha1.IstioStatus `json:"status,omitempty"`
 }
 
 type ServiceEntrySpec struct {
@@ -404,6 +416,8 @@ type ServiceEntrySpec struct {
 	// Note that when resolution is set to type DNS
 	// and no endpoints are specified, the host field will be used as the DNS name
 	// of the endpoint to route traffic to.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinItems=1
 	Hosts []string `json:"hosts,omitempty"`
 
 	// The virtual IP addresses associated with the service. Could be CIDR
@@ -467,6 +481,14 @@ type ServiceEntrySpec struct {
 	// If specified, the proxy will verify that the server
 	// certificate's subject alternate name matches one of the specified values.
 	SubjectAltNames []string `json:"subjectAltNames,omitempty"`
+
+	// Applicable only for MESH_INTERNAL services. Only one of
+	// `endpoints` or `workloadSelector` can be specified. Selects one
+	// or more Kubernetes pods or VM workloads (specified using
+	// `WorkloadEntry`) based on their labels. The `WorkloadEntry` object
+	// representing the VMs should be defined in the same namespace as
+	// the `ServiceEntry`.
+	WorkloadSelector *WorkloadSelector `json:"workloadSelector,omitempty"`
 }
 
 // Endpoint defines a network address (IP or hostname) associated with
@@ -0,0 +1,166 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha3
+
+import (
+	metav1alpha1 "github.com/banzaicloud/istio-client-go/pkg/meta/v1alpha1"
+)
+
+var (
+	_ metav1alpha1.ConditionsAccessor = &DestinationRule{}
+	_ metav1alpha1.ConditionsAccessor = &EnvoyFilter{}
+	_ metav1alpha1.ConditionsAccessor = &Gateway{}
+	_ metav1alpha1.ConditionsAccessor = &ServiceEntry{}
+	_ metav1alpha1.ConditionsAccessor = &Sidecar{}
+	_ metav1alpha1.ConditionsAccessor = &VirtualService{}
+	_ metav1alpha1.ConditionsAccessor = &WorkloadEntry{}
+	_ metav1alpha1.ConditionsAccessor = &WorkloadGroup{}
+)
+
+// GetStatusConditions returns the conditions of the status of the destination rule.
+func (d *DestinationRule) GetStatusConditions() []*metav1alpha1.IstioCondition {
+	if d.Status == nil {
+		return nil
+	}
+
+	return d.Status.Conditions
+}
+
+// SetStatusConditions sets the conditions of the status of the destination rule.
+func (d *DestinationRule) SetStatusConditions(conditions []*metav1alpha1.IstioCondition) {
+	if d.Status == nil {
+		d.Status = &metav1alpha1.IstioStatus{}
+	}
+	d.Status.Conditions = conditions
+}
+
+// GetStatusConditions returns the conditions of the status of the envoy filter.
+func (e *EnvoyFilter) GetStatusConditions() []*metav1alpha1.IstioCondition {
+	if e.Status == nil {
+		return nil
+	}
+
+	return e.Status.Conditions
+}
+
+// SetStatusConditions sets the conditions of the status of the envoy filter.
+func (e *EnvoyFilter) SetStatusConditions(conditions []*metav1alpha1.IstioCondition) {
+	if e.Status == nil {
+		e.Status = &metav1alpha1.IstioStatus{}
+	}
+	e.Status.Conditions = conditions
+}
+
+// GetStatusConditions returns the conditions of the status of the gateway.
+func (g *Gateway) GetStatusConditions() []*metav1alpha1.IstioCondition {
+	if g.Status == nil {
+		return nil
+	}
+
+	return g.Status.Conditions
+}
+
+// SetStatusConditions sets the conditions of the status of the gateway.
+func (g *Gateway) SetStatusConditions(conditions []*metav1alpha1.IstioCondition) {
+	if g.Status == nil {
+		g.Status = &metav1alpha1.IstioStatus{}
+	}
+	g.Status.Conditions = conditions
+}
+
+// GetStatusConditions returns the conditions of the status of the service entry.
+func (s *ServiceEntry) GetStatusConditions() []*metav1alpha1.IstioCondition {
+	if s.Status == nil {
+		return nil
+	}
+
+	return s.Status.Conditions
+}
+
+// SetStatusConditions sets the conditions of the status of the service entry.
+func (s *ServiceEntry) SetStatusConditions(conditions []*metav1alpha1.IstioCondition) {
+	if s.Status == nil {
+		s.Status = &metav1alpha1.IstioStatus{}
+	}
+	s.Status.Conditions = conditions
+}
+
+// GetStatusConditions returns the conditions of the status of the sidecar.
+func (s *Sidecar) GetStatusConditions() []*metav1alpha1.IstioCondition {
+	if s.Status == nil {
+		return nil
+	}
+
+	return s.Status.Conditions
+}
+
+// SetStatusConditions sets the conditions of the status of the sidecar.
+func (s *Sidecar) SetStatusConditions(conditions []*metav1alpha1.IstioCondition) {
+	if s.Status == nil {
+		s.Status = &metav1alpha1.IstioStatus{}
+	}
+	s.Status.Conditions = conditions
+}
+
+// GetStatusConditions returns the conditions of the status of the virtual service.
+func (v *VirtualService) GetStatusConditions() []*metav1alpha1.IstioCondition {
+	if v.Status == nil {
+		return nil
+	}
+
+	return v.Status.Conditions
+}
+
+// SetStatusConditions sets the conditions of the status of the virtual service.
+func (v *VirtualService) SetStatusConditions(conditions []*metav1alpha1.IstioCondition) {
+	if v.Status == nil {
+		v.Status = &metav1alpha1.IstioStatus{}
+	}
+	v.Status.Conditions = conditions
+}
+
+// GetStatusConditions returns the conditions of the status of the workload entry.
+func (w *WorkloadEntry) GetStatusConditions() []*metav1alpha1.IstioCondition {
+	if w.Status == nil {
+		return nil
+	}
+
+	return w.Status.Conditions
+}
+
+// SetStatusConditions sets the conditions of the status of the workload entry.
+func (w *WorkloadEntry) SetStatusConditions(conditions []*metav1alpha1.IstioCondition) {
+	if w.Status == nil {
+		w.Status = &metav1alpha1.IstioStatus{}
+	}
+	w.Status.Conditions = conditions
+}
+
+// GetStatusConditions returns the conditions of the status of the workload group.
+func (w *WorkloadGroup) GetStatusConditions() []*metav1alpha1.IstioCondition {
+	if w.Status == nil {
+		return nil
+	}
+
+	return w.Status.Conditions
+}
+
+// SetStatusConditions sets the conditions of the status of the workload group.
+func (w *WorkloadGroup) SetStatusConditions(conditions []*metav1alpha1.IstioCondition) {
+	if w.Status == nil {
+		w.Status = &metav1alpha1.IstioStatus{}
+	}
+	w.Status.Conditions = conditions
+}
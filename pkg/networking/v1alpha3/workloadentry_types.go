@@ -17,19 +17,24 @@ package v1alpha3
 import (
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
-	istioApi "github.com/banzaicloud/istio-client-go/pkg/networking/v1alpha3/istioapi"
+	metav1alpha1 "github.com/banzaicloud/istio-client-go/pkg/meta/v1alpha1"
 )
 
 // +genclient
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+// +kubebuilder:resource:shortName=we,categories=istio-io;networking-istio-io
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp",description="CreationTimestamp is a timestamp representing the server time when this object was created. It is not guaranteed to be set in happens-before order across separate operations. Clients may not set this value. It is represented in RFC3339 form and is in UTC. Populated by the system. Read-only. Null for lists. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#metadata"
+// +kubebuilder:printcolumn:name="Address",type="string",JSONPath=".spec.address",description="Address associated with the network endpoint."
 // WorkloadEntry
 type WorkloadEntry struct {
 	v1.TypeMeta   `json:",inline"`
 	v1.ObjectMeta `json:"metadata,omitempty"`
 
 	// Spec defines the implementation of this definition.
-	Spec   WorkloadEntrySpec    `json:"spec"`
-	Status istioApi.IstioStatus `json:"status"`
+	Spec   WorkloadEntrySpec         `json:"spec"`
+	Status *metav1alpha1.IstioStatus `json:"status,omitempty"`
 }
 
 // `WorkloadEntry` enables operators to describe the properties of a
@@ -142,7 +147,7 @@ type WorkloadEntrySpec struct {
 	// port.  Domain names can be used if and only if the resolution is set
 	// to DNS, and must be fully-qualified without wildcards. Use the form
 	// unix:///absolute/path/to/socket for Unix domain socket endpoints.
-	Address string `json:"address"`
+	Address string `json:"address,omitempty"`
 	// Set of ports associated with the endpoint. The ports must be
 	// associated with a port name that was declared as part of the
 	// service. Do not use for `unix://` addresses.
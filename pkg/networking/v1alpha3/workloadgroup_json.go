@@ -0,0 +1,101 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha3
+
+import "encoding/json"
+
+// legacyReadinessProbe holds the fields of ReadinessProbe under their
+// protobuf names, which older manifests and tools printing the protobuf
+// messages use.
+type legacyReadinessProbe struct {
+	InitialDelaySeconds *int32                 `json:"initial_delay_seconds,omitempty"`
+	TimeoutSeconds      *int32                 `json:"timeout_seconds,omitempty"`
+	PeriodSeconds       *int32                 `json:"period_seconds,omitempty"`
+	SuccessThreshold    *int32                 `json:"success_threshold,omitempty"`
+	FailureThreshold    *int32                 `json:"failure_threshold,omitempty"`
+	HTTPGet             *HTTPHealthCheckConfig `json:"http_get,omitempty"`
+	TCPSocket           *TCPHealthCheckConfig  `json:"tcp_socket,omitempty"`
+}
+
+// DeprecatedFields implements v1alpha1.DeprecatedFields.
+func (*ReadinessProbe) DeprecatedFields() map[string]string {
+	return map[string]string{
+		"initial_delay_seconds": "initialDelaySeconds",
+		"timeout_seconds":       "timeoutSeconds",
+		"period_seconds":        "periodSeconds",
+		"success_threshold":     "successThreshold",
+		"failure_threshold":     "failureThreshold",
+		"http_get":              "httpGet",
+		"tcp_socket":            "tcpSocket",
+	}
+}
+
+// UnmarshalJSON decodes the probe, along with the snake case names of its
+// fields, which Istio accepts too. The camel case fields take precedence.
+func (p *ReadinessProbe) UnmarshalJSON(data []byte) error {
+	type readinessProbe ReadinessProbe
+	probe := struct {
+		*readinessProbe
+		legacyReadinessProbe
+	}{readinessProbe: (*readinessProbe)(p)}
+	if err := json.Unmarshal(data, &probe); err != nil {
+		return err
+	}
+
+	legacy := probe.legacyReadinessProbe
+	mergeLegacyInt32(&p.InitialDelaySeconds, legacy.InitialDelaySeconds)
+	mergeLegacyInt32(&p.TimeoutSeconds, legacy.TimeoutSeconds)
+	mergeLegacyInt32(&p.PeriodSeconds, legacy.PeriodSeconds)
+	mergeLegacyInt32(&p.SuccessThreshold, legacy.SuccessThreshold)
+	mergeLegacyInt32(&p.FailureThreshold, legacy.FailureThreshold)
+	if legacy.HTTPGet != nil && p.HTTPGet == nil {
+		p.HTTPGet = legacy.HTTPGet
+	}
+	if legacy.TCPSocket != nil && p.TCPSocket == nil {
+		p.TCPSocket = legacy.TCPSocket
+	}
+
+	return nil
+}
+
+func mergeLegacyInt32(value *int32, legacy *int32) {
+	if legacy != nil && *value == 0 {
+		*value = *legacy
+	}
+}
+
+// DeprecatedFields implements v1alpha1.DeprecatedFields.
+func (*HTTPHealthCheckConfig) DeprecatedFields() map[string]string {
+	return map[string]string{"http_headers": "httpHeaders"}
+}
+
+// UnmarshalJSON decodes the health check, along with the snake case
+// http_headers, see ReadinessProbe.UnmarshalJSON.
+func (c *HTTPHealthCheckConfig) UnmarshalJSON(data []byte) error {
+	type httpHealthCheckConfig HTTPHealthCheckConfig
+	config := struct {
+		*httpHealthCheckConfig
+		LegacyHTTPHeaders []*HTTPHeader `json:"http_headers,omitempty"`
+	}{httpHealthCheckConfig: (*httpHealthCheckConfig)(c)}
+	if err := json.Unmarshal(data, &config); err != nil {
+		return err
+	}
+
+	if config.LegacyHTTPHeaders != nil && c.HTTPHeaders == nil {
+		c.HTTPHeaders = config.LegacyHTTPHeaders
+	}
+
+	return nil
+}
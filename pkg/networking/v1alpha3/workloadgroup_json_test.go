@@ -0,0 +1,51 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha3
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestReadinessProbeLegacyNames(t *testing.T) {
+	var probe ReadinessProbe
+	data := `{"initial_delay_seconds":5,"periodSeconds":10,"period_seconds":20,` +
+		`"http_get":{"path":"/ready","port":8080,"http_headers":[{"name":"Host","value":"example.com"}]}}`
+	if err := json.Unmarshal([]byte(data), &probe); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := ReadinessProbe{
+		InitialDelaySeconds: 5,
+		PeriodSeconds:       10,
+		HTTPGet: &HTTPHealthCheckConfig{
+			Path:        "/ready",
+			Port:        8080,
+			HTTPHeaders: []*HTTPHeader{{Name: "Host", Value: "example.com"}},
+		},
+	}
+	if !reflect.DeepEqual(probe, expected) {
+		t.Errorf("unexpected probe %+v, expected %+v", probe, expected)
+	}
+
+	encoded, err := json.Marshal(probe)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := `{"initialDelaySeconds":5,"periodSeconds":10,"httpGet":{"path":"/ready","port":8080,"httpHeaders":[{"name":"Host","value":"example.com"}]}}`; string(encoded) != expected {
+		t.Errorf("unexpected encoding %s, expected %s", encoded, expected)
+	}
+}
@@ -17,17 +17,20 @@ package v1alpha3
 import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
-	istioApi "github.com/banzaicloud/istio-client-go/pkg/networking/v1alpha3/istioapi"
+	metav1alpha1 "github.com/banzaicloud/istio-client-go/pkg/meta/v1alpha1"
 )
 
 // +genclient
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:shortName=wg,categories=istio-io;networking-istio-io
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp",description="CreationTimestamp is a timestamp representing the server time when this object was created. It is not guaranteed to be set in happens-before order across separate operations. Clients may not set this value. It is represented in RFC3339 form and is in UTC. Populated by the system. Read-only. Null for lists. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#metadata"
 // WorkloadGroup
 type WorkloadGroup struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
-	Spec              WorkloadGroupSpec    `json:"spec"`
-	Status            istioApi.IstioStatus `json:"status"`
+	Spec              WorkloadGroupSpec         `json:"spec"`
+	Status            *metav1alpha1.IstioStatus `json:"status,omitempty"`
 }
 
 // `WorkloadGroup` describes a collection of workload instances.
@@ -119,12 +122,13 @@ type ReadinessProbe struct {
 	// Defaults to 3 seconds.
 	FailureThreshold int32 `json:"failureThreshold,omitempty"`
 
-	// Users can only provide one configuration for health-checks (tcp, http, exec),
+	// Users can only provide one configuration for health-checks (tcp, http, exec, grpc),
 	// and this is expressed as a one-of. All the other configuration values
 	// hold true for any of the health-check methods.
 	HTTPGet   *HTTPHealthCheckConfig `json:"httpGet,omitempty"`
 	TCPSocket *TCPHealthCheckConfig  `json:"tcpSocket,omitempty"`
 	Exec      *ExecHealthCheckConfig `json:"exec,omitempty"`
+	GRPC      *GRPCHealthCheckConfig `json:"grpc,omitempty"`
 }
 
 type HTTPHealthCheckConfig struct {
@@ -161,6 +165,15 @@ type ExecHealthCheckConfig struct {
 	Command []string `json:"command,omitempty"`
 }
 
+type GRPCHealthCheckConfig struct {
+	// REQUIRED. Port of the gRPC health checking service.
+	Port uint32 `json:"port"`
+	// Service name passed to the gRPC health check request, see
+	// https://github.com/grpc/grpc/blob/master/doc/health-checking.md.
+	// If empty, the overall health of the server is checked.
+	Service string `json:"service,omitempty"`
+}
+
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 // WorkloadGroupList is a list of WorkloadGroup resources
 type WorkloadGroupList struct {
@@ -0,0 +1,252 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1beta1
+
+import (
+	"fmt"
+	"strings"
+
+	hostname "github.com/banzaicloud/istio-client-go/pkg/host"
+)
+
+// weightedDestination is a destination of a route along with its weight,
+// whatever the type of the route is.
+type weightedDestination struct {
+	destination *Destination
+	weight      **int32
+}
+
+// SetCanaryWeight sends the percentage of the traffic of the routes to the
+// host to its subset, and shares the rest among the other destinations of
+// the routes in proportion to their weights. The subset is added to the
+// routes without it, as a copy of their first destination of the host. The
+// weights of each route add up to 100 afterwards. An error is returned if no
+// route sends traffic to the host, or a route only sends traffic to the
+// subset and the weight is less than 100, in which case the virtual service
+// is left unchanged.
+func SetCanaryWeight(vs *VirtualService, host, subset string, weight int) error {
+	if weight < 0 || weight > 100 {
+		return fmt.Errorf("weight %d must be between 0 and 100", weight)
+	}
+
+	host = strings.ToLower(hostname.Resolve(host, vs.Namespace))
+	spec := vs.Spec.DeepCopy()
+	found := false
+	for i := range spec.HTTP {
+		ok, err := setHTTPRouteCanaryWeight(&spec.HTTP[i].Route, vs.Namespace, host, subset, weight)
+		if err != nil {
+			return fmt.Errorf("http route %d: %w", i, err)
+		}
+		found = found || ok
+	}
+	for i := range spec.TLS {
+		ok, err := setRouteCanaryWeight(&spec.TLS[i].Route, vs.Namespace, host, subset, weight)
+		if err != nil {
+			return fmt.Errorf("tls route %d: %w", i, err)
+		}
+		found = found || ok
+	}
+	for i := range spec.TCP {
+		ok, err := setRouteCanaryWeight(&spec.TCP[i].Route, vs.Namespace, host, subset, weight)
+		if err != nil {
+			return fmt.Errorf("tcp route %d: %w", i, err)
+		}
+		found = found || ok
+	}
+
+	if !found {
+		return fmt.Errorf("no route sends traffic to %s", host)
+	}
+	vs.Spec = *spec
+
+	return nil
+}
+
+// CanaryWeight returns the weight of the subset of the host in the first
+// route sending traffic to the host, and false if there is no such route.
+func CanaryWeight(vs *VirtualService, host, subset string) (int, bool) {
+	host = strings.ToLower(hostname.Resolve(host, vs.Namespace))
+
+	var destinations [][]weightedDestination
+	for _, route := range vs.Spec.HTTP {
+		destinations = append(destinations, httpRouteDestinations(route.Route))
+	}
+	for _, route := range vs.Spec.TLS {
+		destinations = append(destinations, routeDestinations(route.Route))
+	}
+	for _, route := range vs.Spec.TCP {
+		destinations = append(destinations, routeDestinations(route.Route))
+	}
+
+	for _, weighted := range destinations {
+		canary, found := -1, false
+		for i, d := range weighted {
+			if isHost(d.destination, vs.Namespace, host) {
+				found = true
+				if isSubset(d.destination, subset) && canary < 0 {
+					canary = i
+				}
+			}
+		}
+		if !found {
+			continue
+		}
+		if canary < 0 {
+			return 0, true
+		}
+
+		return currentWeights(weighted)[canary], true
+	}
+
+	return 0, false
+}
+
+func setHTTPRouteCanaryWeight(route *[]*HTTPRouteDestination, namespace, host, subset string, weight int) (bool, error) {
+	add := func(d *Destination) weightedDestination {
+		*route = append(*route, &HTTPRouteDestination{Destination: d})
+		return weightedDestination{destination: d, weight: &(*route)[len(*route)-1].Weight}
+	}
+
+	return setCanaryWeight(httpRouteDestinations(*route), add, namespace, host, subset, weight)
+}
+
+func httpRouteDestinations(route []*HTTPRouteDestination) []weightedDestination {
+	destinations := make([]weightedDestination, 0, len(route)+1)
+	for _, d := range route {
+		if d != nil {
+			destinations = append(destinations, weightedDestination{destination: d.Destination, weight: &d.Weight})
+		}
+	}
+
+	return destinations
+}
+
+func setRouteCanaryWeight(route *[]*RouteDestination, namespace, host, subset string, weight int) (bool, error) {
+	add := func(d *Destination) weightedDestination {
+		*route = append(*route, &RouteDestination{Destination: d})
+		return weightedDestination{destination: d, weight: &(*route)[len(*route)-1].Weight}
+	}
+
+	return setCanaryWeight(routeDestinations(*route), add, namespace, host, subset, weight)
+}
+
+func routeDestinations(route []*RouteDestination) []weightedDestination {
+	destinations := make([]weightedDestination, 0, len(route)+1)
+	for _, d := range route {
+		if d != nil {
+			destinations = append(destinations, weightedDestination{destination: d.Destination, weight: &d.Weight})
+		}
+	}
+
+	return destinations
+}
+
+// setCanaryWeight sets the weights of the destinations of a route, adding the
+// subset with add if it is missing. It returns false if the route does not
+// send traffic to the host.
+func setCanaryWeight(destinations []weightedDestination, add func(*Destination) weightedDestination, namespace, host, subset string, weight int) (bool, error) {
+	canary, stable := -1, -1
+	for i, d := range destinations {
+		if !isHost(d.destination, namespace, host) {
+			continue
+		}
+		if isSubset(d.destination, subset) {
+			if canary < 0 {
+				canary = i
+			}
+		} else if stable < 0 {
+			stable = i
+		}
+	}
+	if canary < 0 && stable < 0 {
+		return false, nil
+	}
+
+	weights := currentWeights(destinations)
+	if canary < 0 {
+		d := destinations[stable].destination.DeepCopy()
+		d.Subset = &subset
+		destinations = append(destinations, add(d))
+		weights = append(weights, 0)
+		canary = len(destinations) - 1
+	}
+	if len(destinations) == 1 && weight != 100 {
+		return true, fmt.Errorf("subset %s is the only destination, so its weight must be 100", subset)
+	}
+
+	for i, w := range shareWeights(weights, canary, weight) {
+		w := int32(w)
+		*destinations[i].weight = &w
+	}
+
+	return true, nil
+}
+
+// currentWeights returns the weights of the destinations, assuming a weight
+// of 100 for a single destination without one, as Istio does.
+func currentWeights(destinations []weightedDestination) []int {
+	weights := make([]int, len(destinations))
+	for i, d := range destinations {
+		switch {
+		case *d.weight != nil:
+			weights[i] = int(**d.weight)
+		case len(destinations) == 1:
+			weights[i] = defaultWeight
+		}
+	}
+
+	return weights
+}
+
+// shareWeights returns the weights with the one at index set and the rest of
+// 100 shared among the others in proportion to their weights, or equally if
+// they have none. The shares are rounded with the largest remainder method so
+// that they add up to 100.
+func shareWeights(weights []int, index, weight int) []int {
+	shared := make([]int, len(weights))
+	shared[index] = weight
+
+	var others, proportions []int
+	total := 0
+	for i, w := range weights {
+		if i != index {
+			others = append(others, i)
+			proportions = append(proportions, w)
+			total += w
+		}
+	}
+	if len(others) == 0 {
+		return shared
+	}
+	if total == 0 {
+		for i := range proportions {
+			proportions[i] = 1
+		}
+	}
+
+	for i, w := range scaleWeights(proportions, 100-weight) {
+		shared[others[i]] = w
+	}
+
+	return shared
+}
+
+func isHost(d *Destination, namespace, host string) bool {
+	return d != nil && strings.ToLower(hostname.Resolve(d.Host, namespace)) == host
+}
+
+func isSubset(d *Destination, subset string) bool {
+	return d.Subset != nil && *d.Subset == subset
+}
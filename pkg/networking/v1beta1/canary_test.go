@@ -0,0 +1,80 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1beta1
+
+import (
+	"reflect"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestSetCanaryWeight(t *testing.T) {
+	subset := func(name string) *string { return &name }
+	vs := &VirtualService{
+		ObjectMeta: metav1.ObjectMeta{Name: "reviews", Namespace: "prod"},
+		Spec: VirtualServiceSpec{
+			Hosts: []string{"reviews"},
+			HTTP: []HTTPRoute{
+				{Route: []*HTTPRouteDestination{{Destination: &Destination{Host: "reviews", Subset: subset("v1"), Port: &PortSelector{Number: 9080}}}}},
+				{Route: []*HTTPRouteDestination{
+					{Destination: &Destination{Host: "reviews.prod.svc.cluster.local", Subset: subset("v1")}, Weight: int32Ptr(50)},
+					{Destination: &Destination{Host: "reviews", Subset: subset("v3")}, Weight: int32Ptr(25)},
+					{Destination: &Destination{Host: "reviews", Subset: subset("v2")}, Weight: int32Ptr(25)},
+				}},
+				{Route: []*HTTPRouteDestination{{Destination: &Destination{Host: "ratings"}}}},
+			},
+			TCP: []TCPRoute{{Route: []*RouteDestination{{Destination: &Destination{Host: "reviews"}}}}},
+		},
+	}
+
+	if err := SetCanaryWeight(vs, "reviews", "v2", 20); err != nil {
+		t.Fatal(err)
+	}
+	if errs := vs.Validate(); len(errs) > 0 {
+		t.Fatalf("expected a valid virtual service, got %v", errs)
+	}
+
+	first := vs.Spec.HTTP[0].Route
+	if len(first) != 2 || *first[1].Destination.Subset != "v2" || first[1].Destination.Port.Number != 9080 {
+		t.Errorf("expected the subset to be added as a copy of the first destination, got %v", first)
+	}
+	if weights := []int32{*first[0].Weight, *first[1].Weight}; !reflect.DeepEqual(weights, []int32{80, 20}) {
+		t.Errorf("unexpected weights %v", weights)
+	}
+	second := vs.Spec.HTTP[1].Route
+	if weights := []int32{*second[0].Weight, *second[1].Weight, *second[2].Weight}; !reflect.DeepEqual(weights, []int32{53, 27, 20}) {
+		t.Errorf("unexpected weights %v", weights)
+	}
+	if weight := vs.Spec.HTTP[2].Route[0].Weight; weight != nil {
+		t.Errorf("expected the route of another host to be left alone, got %d", *weight)
+	}
+	if tcp := vs.Spec.TCP[0].Route; len(tcp) != 2 || *tcp[1].Weight != 20 {
+		t.Errorf("unexpected tcp route %v", tcp)
+	}
+	if weight, ok := CanaryWeight(vs, "reviews", "v2"); !ok || weight != 20 {
+		t.Errorf("unexpected canary weight %d", weight)
+	}
+
+	before := vs.DeepCopy()
+	vs.Spec.HTTP = append(vs.Spec.HTTP, HTTPRoute{Route: []*HTTPRouteDestination{{Destination: &Destination{Host: "reviews", Subset: subset("v2")}}}})
+	before.Spec.HTTP = append(before.Spec.HTTP, *vs.Spec.HTTP[3].DeepCopy())
+	if err := SetCanaryWeight(vs, "reviews", "v2", 50); err == nil {
+		t.Fatal("expected an error for the route only sending traffic to the subset")
+	}
+	if !reflect.DeepEqual(vs, before) {
+		t.Error("expected the virtual service to be left unchanged on error")
+	}
+}
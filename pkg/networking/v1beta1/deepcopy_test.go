@@ -0,0 +1,125 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1beta1
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/banzaicloud/istio-client-go/pkg/common/v1alpha1"
+)
+
+// largeVirtualService returns a virtual service with many routes, matches
+// and header maps, the kind of which is copied on every reconciliation.
+func largeVirtualService() *VirtualService {
+	vs := &VirtualService{Spec: VirtualServiceSpec{Hosts: []string{"reviews"}}}
+	for i := 0; i < 50; i++ {
+		name := fmt.Sprintf("route-%d", i)
+		route := HTTPRoute{Name: &name}
+		for j := 0; j < 5; j++ {
+			route.Match = append(route.Match, &HTTPMatchRequest{
+				URI: &v1alpha1.StringMatch{Prefix: fmt.Sprintf("/api/%d/%d", i, j)},
+				Headers: map[string]v1alpha1.StringMatch{
+					"x-user":    {Exact: "jason"},
+					"x-version": {Exact: "v2"},
+				},
+				QueryParams: map[string]*v1alpha1.StringMatch{
+					"debug": {Exact: "true"},
+					"trace": {Exact: "true"},
+				},
+			})
+		}
+		for j := 0; j < 3; j++ {
+			weight := int32(100 / 3)
+			route.Route = append(route.Route, &HTTPRouteDestination{
+				Destination: &Destination{Host: "reviews", Subset: &name},
+				Weight:      &weight,
+				Headers: &Headers{
+					Request: &HeaderOperations{
+						Set:    map[string]string{"x-route": name, "x-subset": name},
+						Remove: []string{"x-debug"},
+					},
+				},
+			})
+		}
+		vs.Spec.HTTP = append(vs.Spec.HTTP, route)
+	}
+
+	return vs
+}
+
+func TestVirtualServiceDeepCopy(t *testing.T) {
+	vs := largeVirtualService()
+	out := vs.DeepCopy()
+	if !reflect.DeepEqual(vs, out) {
+		t.Fatal("expected the copy to equal the original")
+	}
+
+	*out.Spec.HTTP[0].Match[0].QueryParams["debug"] = v1alpha1.StringMatch{Exact: "false"}
+	out.Spec.HTTP[0].Route[0].Headers.Request.Set["x-route"] = "changed"
+	*out.Spec.HTTP[0].Route[1].Weight = 0
+	if !reflect.DeepEqual(vs, largeVirtualService()) {
+		t.Error("expected the copy not to share any memory with the original")
+	}
+}
+
+func BenchmarkVirtualServiceDeepCopy(b *testing.B) {
+	vs := largeVirtualService()
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = vs.DeepCopy()
+	}
+}
+
+func TestVirtualServiceListDeepCopyItemsInto(t *testing.T) {
+	list := &VirtualServiceList{Items: []VirtualService{*largeVirtualService(), *largeVirtualService()}}
+	list.Items[1].Name = "second"
+
+	dst := make([]VirtualService, 1, 4)
+	dst[0].Name = "stale"
+	out := list.DeepCopyItemsInto(dst)
+	if len(out) != 2 || &out[0] != &dst[0] {
+		t.Fatal("expected the backing array of the destination to be reused")
+	}
+	if !reflect.DeepEqual(out, list.Items) {
+		t.Fatal("expected the copied items to equal the list items")
+	}
+	*out[0].Spec.HTTP[0].Route[1].Weight = 0
+	if !reflect.DeepEqual(list.Items[0], *largeVirtualService()) {
+		t.Error("expected the copy not to share any memory with the list")
+	}
+
+	if out := (&VirtualServiceList{}).DeepCopyItemsInto(out); len(out) != 0 || cap(out) != 4 {
+		t.Errorf("expected an empty list to truncate the destination, got len %d cap %d", len(out), cap(out))
+	}
+	if out := list.DeepCopyItemsInto(nil); len(out) != 2 {
+		t.Errorf("expected a nil destination to be allocated, got len %d", len(out))
+	}
+}
+
+func BenchmarkVirtualServiceListDeepCopyItemsInto(b *testing.B) {
+	list := &VirtualServiceList{}
+	for i := 0; i < 20; i++ {
+		list.Items = append(list.Items, *largeVirtualService())
+	}
+	var dst []VirtualService
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		dst = list.DeepCopyItemsInto(dst)
+	}
+}
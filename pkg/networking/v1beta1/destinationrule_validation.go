@@ -0,0 +1,260 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1beta1
+
+import (
+	"sort"
+
+	k8svalidation "k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/banzaicloud/istio-client-go/pkg/validation"
+)
+
+// Validate checks the destination rule the way Istio does before accepting it.
+func (dr *DestinationRule) Validate() field.ErrorList {
+	return dr.Spec.Validate(field.NewPath("spec"))
+}
+
+// Validate checks the spec the way Istio does before accepting it.
+func (s *DestinationRuleSpec) Validate(path *field.Path) field.ErrorList {
+	errs := validation.Host(path.Child("host"), s.Host)
+
+	if s.TrafficPolicy != nil {
+		errs = append(errs, s.TrafficPolicy.validate(path.Child("trafficPolicy"))...)
+	}
+
+	names := make(map[string]bool, len(s.Subsets))
+	for i, subset := range s.Subsets {
+		subsetPath := path.Child("subsets").Index(i)
+		errs = append(errs, subset.validate(subsetPath)...)
+		if names[subset.Name] {
+			errs = append(errs, field.Duplicate(subsetPath.Child("name"), subset.Name))
+		}
+		names[subset.Name] = true
+	}
+
+	return errs
+}
+
+func (s *Subset) validate(path *field.Path) field.ErrorList {
+	var errs field.ErrorList
+
+	if s.Name == "" {
+		errs = append(errs, field.Required(path.Child("name"), "subset name must not be empty"))
+	} else {
+		for _, msg := range k8svalidation.IsDNS1123Label(s.Name) {
+			errs = append(errs, field.Invalid(path.Child("name"), s.Name, msg))
+		}
+	}
+
+	errs = append(errs, validateLabels(path.Child("labels"), s.Labels)...)
+
+	if s.TrafficPolicy != nil {
+		errs = append(errs, s.TrafficPolicy.validate(path.Child("trafficPolicy"))...)
+	}
+
+	return errs
+}
+
+func (p *TrafficPolicy) validate(path *field.Path) field.ErrorList {
+	if p.LoadBalancer == nil && p.ConnectionPool == nil && p.OutlierDetection == nil && p.TLS == nil && len(p.PortLevelSettings) == 0 {
+		return field.ErrorList{field.Required(path, "a traffic policy must have at least one field set")}
+	}
+
+	errs := p.TrafficPolicyCommon.validate(path)
+	for i, settings := range p.PortLevelSettings {
+		settingsPath := path.Child("portLevelSettings").Index(i)
+		if settings.Port == nil {
+			errs = append(errs, field.Required(settingsPath.Child("port"), "port level settings must have a port"))
+		} else {
+			errs = append(errs, validation.Port(settingsPath.Child("port", "number"), int64(settings.Port.Number))...)
+		}
+		errs = append(errs, settings.TrafficPolicyCommon.validate(settingsPath)...)
+	}
+
+	return errs
+}
+
+func (p *TrafficPolicyCommon) validate(path *field.Path) field.ErrorList {
+	var errs field.ErrorList
+
+	if p.LoadBalancer != nil {
+		errs = append(errs, p.LoadBalancer.validate(path.Child("loadBalancer"))...)
+	}
+	if p.ConnectionPool != nil {
+		errs = append(errs, p.ConnectionPool.validate(path.Child("connectionPool"))...)
+	}
+	if p.OutlierDetection != nil {
+		errs = append(errs, p.OutlierDetection.validate(path.Child("outlierDetection"))...)
+	}
+	if p.TLS != nil {
+		errs = append(errs, p.TLS.validate(path.Child("tls"))...)
+	}
+
+	return errs
+}
+
+func (lb *LoadBalancerSettings) validate(path *field.Path) field.ErrorList {
+	switch {
+	case lb.Simple == nil && lb.ConsistentHash == nil:
+		return field.ErrorList{field.Required(path, "one of simple or consistentHash is required")}
+	case lb.Simple != nil && lb.ConsistentHash != nil:
+		return field.ErrorList{field.Forbidden(path, "only one of simple or consistentHash may be set")}
+	case lb.Simple != nil:
+		return nil
+	}
+
+	hash, hashPath := lb.ConsistentHash, path.Child("consistentHash")
+	set := 0
+	for _, ok := range []bool{hash.HTTPHeaderName != nil, hash.HTTPCookie != nil, hash.UseSourceIP != nil && *hash.UseSourceIP} {
+		if ok {
+			set++
+		}
+	}
+	if set != 1 {
+		return field.ErrorList{field.Invalid(hashPath, set, "exactly one of httpHeaderName, httpCookie or useSourceIp must be set")}
+	}
+
+	var errs field.ErrorList
+	if hash.HTTPHeaderName != nil && *hash.HTTPHeaderName == "" {
+		errs = append(errs, field.Required(hashPath.Child("httpHeaderName"), "header name must not be empty"))
+	}
+	if hash.HTTPCookie != nil {
+		if hash.HTTPCookie.Name == "" {
+			errs = append(errs, field.Required(hashPath.Child("httpCookie", "name"), "cookie name must not be empty"))
+		}
+		if hash.HTTPCookie.TTL.Duration < 0 {
+			errs = append(errs, field.Invalid(hashPath.Child("httpCookie", "ttl"), hash.HTTPCookie.TTL.String(), "must not be negative"))
+		}
+	}
+
+	return errs
+}
+
+func (cp *ConnectionPoolSettings) validate(path *field.Path) field.ErrorList {
+	if cp.TCP == nil && cp.HTTP == nil {
+		return field.ErrorList{field.Required(path, "one of tcp or http is required")}
+	}
+
+	var errs field.ErrorList
+	if tcp := cp.TCP; tcp != nil {
+		tcpPath := path.Child("tcp")
+		errs = append(errs, validateNonNegative(tcpPath.Child("maxConnections"), tcp.MaxConnections)...)
+		if tcp.ConnectTimeout != nil {
+			errs = append(errs, validation.Duration(tcpPath.Child("connectTimeout"), *tcp.ConnectTimeout)...)
+		}
+		if keepalive := tcp.TCPKeepalive; keepalive != nil {
+			if keepalive.Time != nil {
+				errs = append(errs, validation.Duration(tcpPath.Child("tcpKeepalive", "time"), *keepalive.Time)...)
+			}
+			if keepalive.Interval != nil {
+				errs = append(errs, validation.Duration(tcpPath.Child("tcpKeepalive", "interval"), *keepalive.Interval)...)
+			}
+		}
+	}
+	if http := cp.HTTP; http != nil {
+		httpPath := path.Child("http")
+		errs = append(errs, validateNonNegative(httpPath.Child("http1MaxPendingRequests"), http.HTTP1MaxPendingRequests)...)
+		errs = append(errs, validateNonNegative(httpPath.Child("http2MaxRequests"), http.HTTP2MaxRequests)...)
+		errs = append(errs, validateNonNegative(httpPath.Child("maxRequestsPerConnection"), http.MaxRequestsPerConnection)...)
+		errs = append(errs, validateNonNegative(httpPath.Child("maxRetries"), http.MaxRetries)...)
+		if http.IdleTimeout != nil {
+			errs = append(errs, validation.Duration(httpPath.Child("idleTimeout"), *http.IdleTimeout)...)
+		}
+	}
+
+	return errs
+}
+
+func (od *OutlierDetection) validate(path *field.Path) field.ErrorList {
+	var errs field.ErrorList
+
+	if od.ConsecutiveErrors < 0 {
+		errs = append(errs, field.Invalid(path.Child("consecutiveErrors"), od.ConsecutiveErrors, "must not be negative"))
+	}
+	if od.Interval != nil {
+		errs = append(errs, validation.Duration(path.Child("interval"), *od.Interval)...)
+	}
+	if od.BaseEjectionTime != nil {
+		errs = append(errs, validation.Duration(path.Child("baseEjectionTime"), *od.BaseEjectionTime)...)
+	}
+	if od.MaxEjectionPercent != nil {
+		errs = append(errs, validation.Percentage(path.Child("maxEjectionPercent"), float64(*od.MaxEjectionPercent))...)
+	}
+	if od.MinHealthPercent != nil {
+		errs = append(errs, validation.Percentage(path.Child("minHealthPercent"), float64(*od.MinHealthPercent))...)
+	}
+
+	return errs
+}
+
+func (tls *TLSSettings) validate(path *field.Path) field.ErrorList {
+	var errs field.ErrorList
+
+	switch tls.Mode {
+	case TLSmodeMutual:
+		if tls.ClientCertificate == nil || *tls.ClientCertificate == "" {
+			errs = append(errs, field.Required(path.Child("clientCertificate"), "a client certificate is required in MUTUAL mode"))
+		}
+		if tls.PrivateKey == nil || *tls.PrivateKey == "" {
+			errs = append(errs, field.Required(path.Child("privateKey"), "a private key is required in MUTUAL mode"))
+		}
+	case TLSmodeIstioMutual:
+		if tls.ClientCertificate != nil {
+			errs = append(errs, field.Forbidden(path.Child("clientCertificate"), "Istio provides the certificates in ISTIO_MUTUAL mode"))
+		}
+		if tls.PrivateKey != nil {
+			errs = append(errs, field.Forbidden(path.Child("privateKey"), "Istio provides the certificates in ISTIO_MUTUAL mode"))
+		}
+		if tls.CaCertificates != nil {
+			errs = append(errs, field.Forbidden(path.Child("caCertificates"), "Istio provides the certificates in ISTIO_MUTUAL mode"))
+		}
+	case TLSmodeDisable, TLSmodeSimple, "":
+	default:
+		errs = append(errs, field.NotSupported(path.Child("mode"), tls.Mode, []string{string(TLSmodeDisable), string(TLSmodeSimple), string(TLSmodeMutual), string(TLSmodeIstioMutual)}))
+	}
+
+	return errs
+}
+
+// validateLabels checks the keys and the values of the labels in key order.
+func validateLabels(path *field.Path, labels map[string]string) field.ErrorList {
+	keys := make([]string, 0, len(labels))
+	for key := range labels {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var errs field.ErrorList
+	for _, key := range keys {
+		for _, msg := range k8svalidation.IsQualifiedName(key) {
+			errs = append(errs, field.Invalid(path, key, msg))
+		}
+		for _, msg := range k8svalidation.IsValidLabelValue(labels[key]) {
+			errs = append(errs, field.Invalid(path.Key(key), labels[key], msg))
+		}
+	}
+
+	return errs
+}
+
+func validateNonNegative(path *field.Path, value *int32) field.ErrorList {
+	if value != nil && *value < 0 {
+		return field.ErrorList{field.Invalid(path, *value, "must not be negative")}
+	}
+
+	return nil
+}
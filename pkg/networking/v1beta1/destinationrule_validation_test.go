@@ -0,0 +1,88 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1beta1
+
+import (
+	"testing"
+	"time"
+
+	"github.com/banzaicloud/istio-client-go/pkg/common/v1alpha1"
+)
+
+func TestDestinationRuleValidate(t *testing.T) {
+	simple := SimpleLBLeastConn
+	header := "x-user"
+	maxEjectionPercent := int32(50)
+	cert := "/etc/certs/cert.pem"
+
+	dr := &DestinationRule{
+		Spec: DestinationRuleSpec{
+			Host: "reviews.prod.svc.cluster.local",
+			TrafficPolicy: &TrafficPolicy{
+				TrafficPolicyCommon: TrafficPolicyCommon{
+					LoadBalancer: &LoadBalancerSettings{Simple: &simple},
+					OutlierDetection: &OutlierDetection{
+						Interval:           v1alpha1.NewDuration(10 * time.Second),
+						MaxEjectionPercent: &maxEjectionPercent,
+					},
+					TLS: &TLSSettings{Mode: TLSmodeIstioMutual},
+				},
+			},
+			Subsets: []Subset{
+				{Name: "v1", Labels: map[string]string{"version": "v1"}},
+				{
+					Name:   "v2",
+					Labels: map[string]string{"version": "v2"},
+					TrafficPolicy: &TrafficPolicy{
+						TrafficPolicyCommon: TrafficPolicyCommon{
+							LoadBalancer: &LoadBalancerSettings{ConsistentHash: &ConsistentHashLB{HTTPHeaderName: &header}},
+						},
+					},
+				},
+			},
+		},
+	}
+	if errs := dr.Validate(); len(errs) > 0 {
+		t.Fatalf("unexpected errors %v", errs)
+	}
+
+	dr.Spec.Host = ""
+	dr.Spec.TrafficPolicy.LoadBalancer.ConsistentHash = &ConsistentHashLB{HTTPHeaderName: &header}
+	dr.Spec.TrafficPolicy.OutlierDetection.MaxEjectionPercent = func(p int32) *int32 { return &p }(150)
+	dr.Spec.TrafficPolicy.TLS.ClientCertificate = &cert
+	dr.Spec.Subsets[1].Name = "v1"
+
+	expected := []string{
+		"spec.host",
+		"spec.trafficPolicy.loadBalancer",
+		"spec.trafficPolicy.outlierDetection.maxEjectionPercent",
+		"spec.trafficPolicy.tls.clientCertificate",
+		"spec.subsets[1].name",
+	}
+	errs := dr.Validate()
+	if len(errs) != len(expected) {
+		t.Fatalf("unexpected errors %v", errs)
+	}
+	for i, err := range errs {
+		if err.Field != expected[i] {
+			t.Errorf("unexpected error %v, expected one for %s", err, expected[i])
+		}
+	}
+
+	dr.Spec.TrafficPolicy.TLS = &TLSSettings{Mode: TLSmodeMutual}
+	if errs := dr.Spec.TrafficPolicy.TLS.validate(nil); len(errs) != 2 {
+		t.Fatalf("expected the client certificate and the private key to be required, got %v", errs)
+	}
+}
@@ -0,0 +1,308 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package drbuilder builds v1beta1 destination rules without spelling out the
+// nested literals of their traffic policies, e.g.
+//
+//	dr, err := drbuilder.New("reviews", "reviews").Namespace("prod").
+//		TrafficPolicy(drbuilder.Policy().
+//			LoadBalancer(v1beta1.SimpleLBLeastConn).
+//			TLS(drbuilder.IstioMutual())).
+//		Subset("v1", map[string]string{"version": "v1"}, nil).
+//		Subset("v2", map[string]string{"version": "v2"}, drbuilder.Policy().ConsistentHashHeader("x-user")).
+//		Build()
+//
+// The builders only allow the combinations Istio accepts: setting a load
+// balancer replaces the previous one, and the TLS settings of each mode only
+// take the fields the mode allows. Build validates the destination rule too.
+package drbuilder
+
+import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/banzaicloud/istio-client-go/pkg/common/v1alpha1"
+	"github.com/banzaicloud/istio-client-go/pkg/networking/v1beta1"
+)
+
+// Builder builds a destination rule.
+type Builder struct {
+	dr v1beta1.DestinationRule
+}
+
+// New returns a builder of the destination rule with the name, for the host.
+func New(name, host string) *Builder {
+	return &Builder{
+		dr: v1beta1.DestinationRule{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: v1beta1.DestinationRuleGroupVersionKind.GroupVersion().String(),
+				Kind:       v1beta1.DestinationRuleGroupVersionKind.Kind,
+			},
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec:       v1beta1.DestinationRuleSpec{Host: host},
+		},
+	}
+}
+
+// Namespace sets the namespace of the destination rule.
+func (b *Builder) Namespace(namespace string) *Builder {
+	b.dr.Namespace = namespace
+	return b
+}
+
+// Labels adds labels to the destination rule.
+func (b *Builder) Labels(labels map[string]string) *Builder {
+	if b.dr.Labels == nil {
+		b.dr.Labels = make(map[string]string, len(labels))
+	}
+	for key, value := range labels {
+		b.dr.Labels[key] = value
+	}
+	return b
+}
+
+// ExportTo adds namespaces the destination rule is exported to.
+func (b *Builder) ExportTo(namespaces ...string) *Builder {
+	b.dr.Spec.ExportTo = append(b.dr.Spec.ExportTo, namespaces...)
+	return b
+}
+
+// TrafficPolicy sets the traffic policy of the host.
+func (b *Builder) TrafficPolicy(policy *PolicyBuilder) *Builder {
+	b.dr.Spec.TrafficPolicy = policy.build()
+	return b
+}
+
+// Subset adds a subset of the endpoints of the host with the labels. The
+// traffic policy of the subset overrides the one of the host; it may be nil.
+func (b *Builder) Subset(name string, labels map[string]string, policy *PolicyBuilder) *Builder {
+	subset := v1beta1.Subset{Name: name, Labels: labels}
+	if policy != nil {
+		subset.TrafficPolicy = policy.build()
+	}
+	b.dr.Spec.Subsets = append(b.dr.Spec.Subsets, subset)
+	return b
+}
+
+// Build returns the destination rule, or the errors of its validation as a
+// utilerrors.Aggregate.
+func (b *Builder) Build() (*v1beta1.DestinationRule, error) {
+	dr := b.dr.DeepCopy()
+	if errs := dr.Validate(); len(errs) > 0 {
+		return nil, errs.ToAggregate()
+	}
+
+	return dr, nil
+}
+
+// PolicyBuilder builds a traffic policy.
+type PolicyBuilder struct {
+	policy v1beta1.TrafficPolicy
+}
+
+// Policy returns a builder of a traffic policy.
+func Policy() *PolicyBuilder {
+	return &PolicyBuilder{}
+}
+
+// Port adds settings for the port, which override the ones of the policy.
+// The port settings of the policy of the port are ignored.
+func (p *PolicyBuilder) Port(number uint32, policy *PolicyBuilder) *PolicyBuilder {
+	p.policy.PortLevelSettings = append(p.policy.PortLevelSettings, v1beta1.PortTrafficPolicy{
+		TrafficPolicyCommon: *policy.policy.TrafficPolicyCommon.DeepCopy(),
+		Port:                &v1beta1.PortSelector{Number: number},
+	})
+	return p
+}
+
+// LoadBalancer balances the load with the algorithm, replacing the load
+// balancer set before.
+func (p *PolicyBuilder) LoadBalancer(simple v1beta1.SimpleLB) *PolicyBuilder {
+	p.policy.LoadBalancer = &v1beta1.LoadBalancerSettings{Simple: &simple}
+	return p
+}
+
+// ConsistentHashHeader balances the load by the hash of the HTTP header,
+// replacing the load balancer set before.
+func (p *PolicyBuilder) ConsistentHashHeader(name string) *PolicyBuilder {
+	return p.consistentHash(&v1beta1.ConsistentHashLB{HTTPHeaderName: &name})
+}
+
+// ConsistentHashCookie balances the load by the hash of the HTTP cookie,
+// which is generated with the TTL if it is missing, replacing the load
+// balancer set before.
+func (p *PolicyBuilder) ConsistentHashCookie(name string, ttl time.Duration) *PolicyBuilder {
+	return p.consistentHash(&v1beta1.ConsistentHashLB{HTTPCookie: &v1beta1.HTTPCookie{Name: name, TTL: v1alpha1.Duration{Duration: ttl}}})
+}
+
+// ConsistentHashSourceIP balances the load by the hash of the source IP,
+// replacing the load balancer set before.
+func (p *PolicyBuilder) ConsistentHashSourceIP() *PolicyBuilder {
+	useSourceIP := true
+	return p.consistentHash(&v1beta1.ConsistentHashLB{UseSourceIP: &useSourceIP})
+}
+
+func (p *PolicyBuilder) consistentHash(lb *v1beta1.ConsistentHashLB) *PolicyBuilder {
+	p.policy.LoadBalancer = &v1beta1.LoadBalancerSettings{ConsistentHash: lb}
+	return p
+}
+
+// MaxConnections limits the number of HTTP1 and TCP connections to a host.
+func (p *PolicyBuilder) MaxConnections(n int32) *PolicyBuilder {
+	p.tcp().MaxConnections = &n
+	return p
+}
+
+// ConnectTimeout sets the timeout of the TCP connections.
+func (p *PolicyBuilder) ConnectTimeout(timeout time.Duration) *PolicyBuilder {
+	p.tcp().ConnectTimeout = v1alpha1.NewDuration(timeout)
+	return p
+}
+
+// MaxPendingRequests limits the number of HTTP requests waiting for a
+// connection.
+func (p *PolicyBuilder) MaxPendingRequests(n int32) *PolicyBuilder {
+	p.http().HTTP1MaxPendingRequests = &n
+	return p
+}
+
+// MaxRequests limits the number of concurrent HTTP2 requests to a host.
+func (p *PolicyBuilder) MaxRequests(n int32) *PolicyBuilder {
+	p.http().HTTP2MaxRequests = &n
+	return p
+}
+
+// MaxRetries limits the number of concurrent retries to the hosts.
+func (p *PolicyBuilder) MaxRetries(n int32) *PolicyBuilder {
+	p.http().MaxRetries = &n
+	return p
+}
+
+// IdleTimeout sets the timeout after which idle HTTP connections are closed.
+func (p *PolicyBuilder) IdleTimeout(timeout time.Duration) *PolicyBuilder {
+	p.http().IdleTimeout = v1alpha1.NewDuration(timeout)
+	return p
+}
+
+func (p *PolicyBuilder) tcp() *v1beta1.TCPSettings {
+	if p.policy.ConnectionPool == nil {
+		p.policy.ConnectionPool = &v1beta1.ConnectionPoolSettings{}
+	}
+	if p.policy.ConnectionPool.TCP == nil {
+		p.policy.ConnectionPool.TCP = &v1beta1.TCPSettings{}
+	}
+	return p.policy.ConnectionPool.TCP
+}
+
+func (p *PolicyBuilder) http() *v1beta1.HTTPSettings {
+	if p.policy.ConnectionPool == nil {
+		p.policy.ConnectionPool = &v1beta1.ConnectionPoolSettings{}
+	}
+	if p.policy.ConnectionPool.HTTP == nil {
+		p.policy.ConnectionPool.HTTP = &v1beta1.HTTPSettings{}
+	}
+	return p.policy.ConnectionPool.HTTP
+}
+
+// EjectOn5xx ejects the hosts answering with the number of consecutive 5xx
+// errors, checked at the interval, for the base ejection time multiplied by
+// the number of times the host has been ejected.
+func (p *PolicyBuilder) EjectOn5xx(consecutive uint32, interval, baseEjectionTime time.Duration) *PolicyBuilder {
+	od := p.outlierDetection()
+	od.Consecutive5XxErrors = &consecutive
+	od.Interval = v1alpha1.NewDuration(interval)
+	od.BaseEjectionTime = v1alpha1.NewDuration(baseEjectionTime)
+	return p
+}
+
+// MaxEjectionPercent limits the percentage of the hosts which may be ejected.
+func (p *PolicyBuilder) MaxEjectionPercent(percent int32) *PolicyBuilder {
+	p.outlierDetection().MaxEjectionPercent = &percent
+	return p
+}
+
+func (p *PolicyBuilder) outlierDetection() *v1beta1.OutlierDetection {
+	if p.policy.OutlierDetection == nil {
+		p.policy.OutlierDetection = &v1beta1.OutlierDetection{}
+	}
+	return p.policy.OutlierDetection
+}
+
+// TLS sets the TLS settings of the connections to the hosts, replacing the
+// ones set before.
+func (p *PolicyBuilder) TLS(tls *TLSBuilder) *PolicyBuilder {
+	p.policy.TLS = tls.tls.DeepCopy()
+	return p
+}
+
+func (p *PolicyBuilder) build() *v1beta1.TrafficPolicy {
+	return p.policy.DeepCopy()
+}
+
+// TLSBuilder builds the TLS settings of a mode. The constructors take the
+// fields the mode requires and allows.
+type TLSBuilder struct {
+	tls v1beta1.TLSSettings
+}
+
+// DisableTLS returns the settings of plain text connections.
+func DisableTLS() *TLSBuilder {
+	return &TLSBuilder{tls: v1beta1.TLSSettings{Mode: v1beta1.TLSmodeDisable}}
+}
+
+// IstioMutual returns the settings of mutual TLS connections with the
+// certificates Istio generates.
+func IstioMutual() *TLSBuilder {
+	return &TLSBuilder{tls: v1beta1.TLSSettings{Mode: v1beta1.TLSmodeIstioMutual}}
+}
+
+// Simple returns the settings of TLS connections verifying the certificates
+// of the servers with the CA certificates in the file. The certificates are
+// not verified if the file is empty.
+func Simple(caCertificates string) *TLSBuilder {
+	tls := &TLSBuilder{tls: v1beta1.TLSSettings{Mode: v1beta1.TLSmodeSimple}}
+	if caCertificates != "" {
+		tls.tls.CaCertificates = &caCertificates
+	}
+	return tls
+}
+
+// Mutual returns the settings of mutual TLS connections with the client
+// certificate and private key in the files, verifying the certificates of the
+// servers with the CA certificates in the file, unless it is empty.
+func Mutual(clientCertificate, privateKey, caCertificates string) *TLSBuilder {
+	tls := &TLSBuilder{tls: v1beta1.TLSSettings{
+		Mode:              v1beta1.TLSmodeMutual,
+		ClientCertificate: &clientCertificate,
+		PrivateKey:        &privateKey,
+	}}
+	if caCertificates != "" {
+		tls.tls.CaCertificates = &caCertificates
+	}
+	return tls
+}
+
+// SNI sets the server name sent in the TLS handshake.
+func (t *TLSBuilder) SNI(sni string) *TLSBuilder {
+	t.tls.SNI = &sni
+	return t
+}
+
+// SubjectAltNames adds the names the subject of the server certificate is
+// verified against.
+func (t *TLSBuilder) SubjectAltNames(names ...string) *TLSBuilder {
+	t.tls.SubjectAltNames = append(t.tls.SubjectAltNames, names...)
+	return t
+}
@@ -0,0 +1,61 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package drbuilder
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/banzaicloud/istio-client-go/pkg/networking/v1beta1"
+)
+
+func TestBuild(t *testing.T) {
+	dr, err := New("reviews", "reviews").Namespace("prod").
+		TrafficPolicy(Policy().
+			LoadBalancer(v1beta1.SimpleLBRoundRobin).
+			ConsistentHashHeader("x-user").
+			MaxConnections(100).
+			EjectOn5xx(5, 10*time.Second, 30*time.Second).
+			TLS(IstioMutual().SNI("reviews.prod.svc.cluster.local")).
+			Port(9080, Policy().LoadBalancer(v1beta1.SimpleLBLeastConn))).
+		Subset("v1", map[string]string{"version": "v1"}, nil).
+		Subset("v2", map[string]string{"version": "v2"}, Policy().TLS(Mutual("/etc/certs/cert.pem", "/etc/certs/key.pem", ""))).
+		Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	policy := dr.Spec.TrafficPolicy
+	if policy.LoadBalancer.Simple != nil || *policy.LoadBalancer.ConsistentHash.HTTPHeaderName != "x-user" {
+		t.Errorf("expected the consistent hash to replace the simple load balancer, got %+v", policy.LoadBalancer)
+	}
+	if *policy.ConnectionPool.TCP.MaxConnections != 100 || policy.OutlierDetection.Interval.Duration != 10*time.Second {
+		t.Errorf("unexpected policy %+v", policy)
+	}
+	if port := policy.PortLevelSettings[0]; port.Port.Number != 9080 || *port.LoadBalancer.Simple != v1beta1.SimpleLBLeastConn {
+		t.Errorf("unexpected port level settings %+v", port)
+	}
+	if tls := dr.Spec.Subsets[1].TrafficPolicy.TLS; tls.Mode != v1beta1.TLSmodeMutual || tls.CaCertificates != nil {
+		t.Errorf("unexpected TLS settings %+v", tls)
+	}
+}
+
+func TestBuildInvalid(t *testing.T) {
+	_, err := New("reviews", "reviews").Subset("v1", nil, nil).Subset("v1", nil, nil).Build()
+	if err == nil || !strings.Contains(err.Error(), "spec.subsets[1].name") {
+		t.Fatalf("expected the duplicate subset to be invalid, got %v", err)
+	}
+}
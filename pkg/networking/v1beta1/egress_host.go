@@ -0,0 +1,93 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1beta1
+
+import (
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	hostname "github.com/banzaicloud/istio-client-go/pkg/host"
+)
+
+const (
+	// EgressHostAnyNamespace selects the services of any namespace.
+	EgressHostAnyNamespace = "*"
+	// EgressHostSidecarNamespace selects the services of the namespace of
+	// the sidecar.
+	EgressHostSidecarNamespace = "."
+	// EgressHostNoNamespace selects the services of no namespace.
+	EgressHostNoNamespace = "~"
+)
+
+// EgressHost is a host expression of an egress listener of a sidecar, in
+// the namespace/dnsName format.
+type EgressHost struct {
+	// Namespace is the name of the namespace of the services, or one of
+	// EgressHostAnyNamespace, EgressHostSidecarNamespace and
+	// EgressHostNoNamespace.
+	Namespace string
+	// DNSName is the FQDN of the services, which may start with a wildcard,
+	// e.g. *.example.com, or be * for any of them.
+	DNSName string
+}
+
+// ParseEgressHost parses the host expression of an egress listener, e.g.
+// prod-us1/*.example.com.
+func ParseEgressHost(host string) (EgressHost, error) {
+	if errs := validateEgressHost(field.NewPath("host"), host); len(errs) > 0 {
+		return EgressHost{}, errs.ToAggregate()
+	}
+
+	parts := strings.SplitN(host, "/", 2)
+	return EgressHost{Namespace: parts[0], DNSName: parts[1]}, nil
+}
+
+// String returns the host in the namespace/dnsName format.
+func (h EgressHost) String() string {
+	return h.Namespace + "/" + h.DNSName
+}
+
+// Wildcard returns true if the DNS name matches more than one service.
+func (h EgressHost) Wildcard() bool {
+	return strings.HasPrefix(h.DNSName, "*")
+}
+
+// Resolve returns the host with the namespace of the sidecar substituted
+// for EgressHostSidecarNamespace.
+func (h EgressHost) Resolve(namespace string) EgressHost {
+	if h.Namespace == EgressHostSidecarNamespace {
+		h.Namespace = namespace
+	}
+
+	return h
+}
+
+// MatchesService returns true if the host selects the service with the FQDN
+// in the namespace. A host in EgressHostSidecarNamespace has to be resolved
+// first, otherwise it matches no service.
+func MatchesService(host EgressHost, namespace, fqdn string) bool {
+	switch host.Namespace {
+	case EgressHostAnyNamespace:
+	case EgressHostSidecarNamespace, EgressHostNoNamespace:
+		return false
+	default:
+		if host.Namespace != namespace {
+			return false
+		}
+	}
+
+	return hostname.SubsetOf(fqdn, host.DNSName)
+}
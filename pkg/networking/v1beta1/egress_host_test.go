@@ -0,0 +1,70 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1beta1
+
+import (
+	"testing"
+)
+
+func TestParseEgressHost(t *testing.T) {
+	host, err := ParseEgressHost("prod-us1/*.example.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if host != (EgressHost{Namespace: "prod-us1", DNSName: "*.example.com"}) || !host.Wildcard() {
+		t.Errorf("unexpected host %v", host)
+	}
+	if host.String() != "prod-us1/*.example.com" {
+		t.Errorf("unexpected host %s", host)
+	}
+
+	for _, host := range []string{"*.example.com", "prod/", "Prod/example.com", "prod/example.com/api"} {
+		if _, err := ParseEgressHost(host); err == nil {
+			t.Errorf("expected an error for %s", host)
+		}
+	}
+}
+
+func TestMatchesService(t *testing.T) {
+	tests := []struct {
+		host      string
+		namespace string
+		fqdn      string
+		expected  bool
+	}{
+		{"prod-us1/*.example.com", "prod-us1", "api.example.com", true},
+		{"prod-us1/*.example.com", "prod-eu1", "api.example.com", false},
+		{"prod-us1/*.example.com", "prod-us1", "example.com", false},
+		{"*/reviews.prod.svc.cluster.local", "prod", "reviews.prod.svc.cluster.local", true},
+		{"*/*", "prod", "reviews.prod.svc.cluster.local", true},
+		{"./*", "prod", "reviews.prod.svc.cluster.local", false},
+		{"~/*", "prod", "reviews.prod.svc.cluster.local", false},
+	}
+
+	for _, test := range tests {
+		host, err := ParseEgressHost(test.host)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if matches := MatchesService(host, test.namespace, test.fqdn); matches != test.expected {
+			t.Errorf("expected %s to match %s/%s: %t", test.host, test.namespace, test.fqdn, test.expected)
+		}
+	}
+
+	host, _ := ParseEgressHost("./*")
+	if !MatchesService(host.Resolve("prod"), "prod", "reviews.prod.svc.cluster.local") {
+		t.Error("expected the resolved host to match the service of the namespace of the sidecar")
+	}
+}
@@ -0,0 +1,82 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1beta1
+
+import (
+	"reflect"
+)
+
+// SemanticEqual returns true if the specs are equal once defaulted and
+// normalized, see Default and Normalize, e.g. a single destination without a
+// weight equals one with a weight of 100.
+func (s *VirtualServiceSpec) SemanticEqual(other *VirtualServiceSpec) bool {
+	a, b := s.DeepCopy(), other.DeepCopy()
+	a.Default()
+	b.Default()
+	a.Normalize()
+	b.Normalize()
+
+	return reflect.DeepEqual(a, b)
+}
+
+// SemanticEqual returns true if the specs are equal once normalized, see
+// Normalize.
+func (s *DestinationRuleSpec) SemanticEqual(other *DestinationRuleSpec) bool {
+	a, b := s.DeepCopy(), other.DeepCopy()
+	a.Normalize()
+	b.Normalize()
+
+	return reflect.DeepEqual(a, b)
+}
+
+// SemanticEqual returns true if the specs are equal once normalized, see
+// Normalize.
+func (s *GatewaySpec) SemanticEqual(other *GatewaySpec) bool {
+	a, b := s.DeepCopy(), other.DeepCopy()
+	a.Normalize()
+	b.Normalize()
+
+	return reflect.DeepEqual(a, b)
+}
+
+// SemanticEqual returns true if the specs are equal once normalized, see
+// Normalize.
+func (s *ServiceEntrySpec) SemanticEqual(other *ServiceEntrySpec) bool {
+	a, b := s.DeepCopy(), other.DeepCopy()
+	a.Normalize()
+	b.Normalize()
+
+	return reflect.DeepEqual(a, b)
+}
+
+// SemanticEqual returns true if the specs are equal once normalized, see
+// Normalize.
+func (s *SidecarSpec) SemanticEqual(other *SidecarSpec) bool {
+	a, b := s.DeepCopy(), other.DeepCopy()
+	a.Normalize()
+	b.Normalize()
+
+	return reflect.DeepEqual(a, b)
+}
+
+// SemanticEqual returns true if the specs are equal once normalized, see
+// Normalize.
+func (s *WorkloadEntrySpec) SemanticEqual(other *WorkloadEntrySpec) bool {
+	a, b := s.DeepCopy(), other.DeepCopy()
+	a.Normalize()
+	b.Normalize()
+
+	return reflect.DeepEqual(a, b)
+}
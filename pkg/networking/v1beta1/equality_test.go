@@ -0,0 +1,46 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1beta1
+
+import (
+	"testing"
+)
+
+func TestVirtualServiceSpecSemanticEqual(t *testing.T) {
+	weight := int32(100)
+	a := &VirtualServiceSpec{
+		Hosts: []string{"reviews", "ratings"},
+		HTTP:  []HTTPRoute{{Route: []*HTTPRouteDestination{{Destination: &Destination{Host: "reviews"}}}}},
+	}
+	b := &VirtualServiceSpec{
+		Hosts:    []string{"ratings", "reviews"},
+		Gateways: []string{"mesh"},
+		ExportTo: []string{},
+		HTTP:     []HTTPRoute{{Route: []*HTTPRouteDestination{{Destination: &Destination{Host: "reviews"}, Weight: &weight}}}},
+	}
+	before := a.DeepCopy()
+
+	if !a.SemanticEqual(b) || !b.SemanticEqual(a) {
+		t.Error("expected the specs to be semantically equal")
+	}
+	if a.Gateways != nil || a.HTTP[0].Route[0].Weight != nil || a.Hosts[0] != before.Hosts[0] {
+		t.Error("expected the spec to be left unchanged")
+	}
+
+	b.HTTP[0].Route[0].Destination.Host = "ratings"
+	if a.SemanticEqual(b) {
+		t.Error("expected the specs to differ")
+	}
+}
@@ -0,0 +1,113 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1beta1
+
+import (
+	"errors"
+	"reflect"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/banzaicloud/istio-client-go/pkg/common/v1alpha1"
+)
+
+// HTTPRouteSelector selects the HTTP routes of a virtual service.
+type HTTPRouteSelector func(route *HTTPRoute) bool
+
+// HTTPRouteNamed selects the HTTP routes with the name.
+func HTTPRouteNamed(name string) HTTPRouteSelector {
+	return func(route *HTTPRoute) bool {
+		return route.Name != nil && *route.Name == name
+	}
+}
+
+// HTTPRouteMatching selects the HTTP routes with a match request having the
+// same criteria as the given one, whatever their names are.
+func HTTPRouteMatching(match HTTPMatchRequest) HTTPRouteSelector {
+	match.Name = nil
+	return func(route *HTTPRoute) bool {
+		for _, m := range route.Match {
+			if m == nil {
+				continue
+			}
+			other := *m
+			other.Name = nil
+			if reflect.DeepEqual(other, match) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// DelayFault returns a fault delaying the percentage of the requests.
+func DelayFault(delay time.Duration, percentage float64) *HTTPFaultInjection {
+	return &HTTPFaultInjection{
+		Delay: &Delay{
+			FixedDelay: v1alpha1.Duration{Duration: delay},
+			Percentage: &Percentage{Value: percentage},
+		},
+	}
+}
+
+// AbortFault returns a fault aborting the percentage of the requests with
+// the HTTP status.
+func AbortFault(status int, percentage float64) *HTTPFaultInjection {
+	return &HTTPFaultInjection{
+		Abort: &Abort{
+			HTTPStatus: int32(status),
+			Percentage: &Percentage{Value: percentage},
+		},
+	}
+}
+
+// InjectFault returns a copy of the virtual service with the fault injected
+// into the selected HTTP routes, replacing their faults. An error is
+// returned if the fault is invalid or no route is selected.
+func InjectFault(vs *VirtualService, fault *HTTPFaultInjection, selector HTTPRouteSelector) (*VirtualService, error) {
+	if fault == nil {
+		return nil, errors.New("no fault to inject")
+	}
+	if errs := fault.validate(field.NewPath("fault")); len(errs) > 0 {
+		return nil, errs.ToAggregate()
+	}
+
+	return setFault(vs, fault, selector)
+}
+
+// RemoveFault returns a copy of the virtual service without the faults of
+// the selected HTTP routes. An error is returned if no route is selected.
+func RemoveFault(vs *VirtualService, selector HTTPRouteSelector) (*VirtualService, error) {
+	return setFault(vs, nil, selector)
+}
+
+func setFault(vs *VirtualService, fault *HTTPFaultInjection, selector HTTPRouteSelector) (*VirtualService, error) {
+	out := vs.DeepCopy()
+	found := false
+	for i := range out.Spec.HTTP {
+		route := &out.Spec.HTTP[i]
+		if !selector(route) {
+			continue
+		}
+		route.Fault = fault.DeepCopy()
+		found = true
+	}
+	if !found {
+		return nil, errors.New("no http route is selected")
+	}
+
+	return out, nil
+}
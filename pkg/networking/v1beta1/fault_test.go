@@ -0,0 +1,78 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1beta1
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/banzaicloud/istio-client-go/pkg/common/v1alpha1"
+)
+
+func TestInjectFault(t *testing.T) {
+	name := func(name string) *string { return &name }
+	vs := &VirtualService{
+		Spec: VirtualServiceSpec{
+			Hosts: []string{"ratings"},
+			HTTP: []HTTPRoute{
+				{
+					Name:  name("api"),
+					Match: []*HTTPMatchRequest{{Name: name("v1"), URI: &v1alpha1.StringMatch{Prefix: "/v1"}}},
+					Route: []*HTTPRouteDestination{{Destination: &Destination{Host: "ratings", Subset: name("v1")}}},
+				},
+				{
+					Name:  name("default"),
+					Route: []*HTTPRouteDestination{{Destination: &Destination{Host: "ratings"}}},
+				},
+			},
+		},
+	}
+	before := vs.DeepCopy()
+
+	faulty, err := InjectFault(vs, AbortFault(503, 10), HTTPRouteMatching(HTTPMatchRequest{URI: &v1alpha1.StringMatch{Prefix: "/v1"}}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(faulty.Spec.HTTP[0].Fault, AbortFault(503, 10)) || faulty.Spec.HTTP[1].Fault != nil {
+		t.Errorf("expected the fault to be injected into the matching route only, got %v", faulty.Spec.HTTP)
+	}
+	if !reflect.DeepEqual(vs, before) {
+		t.Error("expected the virtual service to be left unchanged")
+	}
+
+	faulty, err = InjectFault(faulty, DelayFault(5*time.Second, 50), HTTPRouteNamed("default"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if faulty.Spec.HTTP[0].Fault == nil || !reflect.DeepEqual(faulty.Spec.HTTP[1].Fault, DelayFault(5*time.Second, 50)) {
+		t.Errorf("expected the fault to be injected into the named route, got %v", faulty.Spec.HTTP)
+	}
+
+	if _, err := InjectFault(vs, AbortFault(700, 10), HTTPRouteNamed("api")); err == nil {
+		t.Error("expected an error for an invalid fault")
+	}
+	if _, err := InjectFault(vs, AbortFault(503, 10), HTTPRouteNamed("missing")); err == nil {
+		t.Error("expected an error for no selected route")
+	}
+
+	healthy, err := RemoveFault(faulty, HTTPRouteNamed("api"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if healthy.Spec.HTTP[0].Fault != nil || healthy.Spec.HTTP[1].Fault == nil {
+		t.Errorf("expected the fault to be removed from the named route only, got %v", healthy.Spec.HTTP)
+	}
+}
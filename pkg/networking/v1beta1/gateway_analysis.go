@@ -0,0 +1,144 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1beta1
+
+import (
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	hostname "github.com/banzaicloud/istio-client-go/pkg/host"
+)
+
+// GatewayBindingIssueType is the type of the problems reported by
+// AnalyzeGatewayBindings.
+type GatewayBindingIssueType string
+
+const (
+	// A virtual service refers to a gateway which does not exist.
+	GatewayBindingIssueMissingGateway GatewayBindingIssueType = "MissingGateway"
+	// A host of a virtual service is not exposed by any server of a gateway
+	// the virtual service is bound to.
+	GatewayBindingIssueHostNotExposed GatewayBindingIssueType = "HostNotExposed"
+)
+
+// GatewayBindingIssue is a problem reported by AnalyzeGatewayBindings.
+type GatewayBindingIssue struct {
+	Type GatewayBindingIssueType
+	// The virtual service in the namespace/name format.
+	VirtualService string
+	// The path of the gateway or of the host in the virtual service.
+	Field string
+	// The gateway in the namespace/name format.
+	Gateway string
+	// The host not exposed by the gateway.
+	Host string
+}
+
+func (i GatewayBindingIssue) String() string {
+	switch i.Type {
+	case GatewayBindingIssueMissingGateway:
+		return fmt.Sprintf("%s %s: gateway %s does not exist", i.VirtualService, i.Field, i.Gateway)
+	case GatewayBindingIssueHostNotExposed:
+		return fmt.Sprintf("%s %s: host %s is not exposed by gateway %s", i.VirtualService, i.Field, i.Host, i.Gateway)
+	default:
+		return fmt.Sprintf("%s %s: %s gateway %s host %s", i.VirtualService, i.Field, i.Type, i.Gateway, i.Host)
+	}
+}
+
+// AnalyzeGatewayBindings reports the gateways the virtual services are bound
+// to which are not among the given ones, and the hosts of the virtual services
+// not exposed by a gateway they are bound to. Gateway names are resolved in
+// the namespace of the virtual service. A host is exposed by a server of a
+// gateway if one of them matches the other, e.g. *.example.com and
+// a.example.com, and the namespace of the virtual service is selected by the
+// namespace/ prefix of the host of the server, if there is one.
+func AnalyzeGatewayBindings(gateways []*Gateway, virtualServices []*VirtualService) []GatewayBindingIssue {
+	byName := map[string]*Gateway{}
+	for _, gateway := range gateways {
+		if gateway != nil {
+			byName[gateway.Namespace+"/"+gateway.Name] = gateway
+		}
+	}
+
+	var issues []GatewayBindingIssue
+	for _, vs := range virtualServices {
+		if vs == nil {
+			continue
+		}
+
+		name := vs.Namespace + "/" + vs.Name
+		for i, ref := range vs.Spec.Gateways {
+			if ref == MeshGateway {
+				continue
+			}
+
+			gatewayName := ResolveGateway(ref, vs.Namespace)
+			gateway, ok := byName[gatewayName]
+			if !ok {
+				issues = append(issues, GatewayBindingIssue{
+					Type:           GatewayBindingIssueMissingGateway,
+					VirtualService: name,
+					Field:          field.NewPath("spec", "gateways").Index(i).String(),
+					Gateway:        gatewayName,
+				})
+				continue
+			}
+
+			for j, host := range vs.Spec.Hosts {
+				if !gatewayExposes(gateway, hostname.Resolve(host, vs.Namespace), vs.Namespace) {
+					issues = append(issues, GatewayBindingIssue{
+						Type:           GatewayBindingIssueHostNotExposed,
+						VirtualService: name,
+						Field:          field.NewPath("spec", "hosts").Index(j).String(),
+						Gateway:        gatewayName,
+						Host:           host,
+					})
+				}
+			}
+		}
+	}
+
+	return issues
+}
+
+// gatewayExposes returns true if a server of the gateway exposes the host to
+// the virtual services of the namespace.
+func gatewayExposes(gateway *Gateway, host, namespace string) bool {
+	for _, server := range gateway.Spec.Servers {
+		for _, serverHost := range server.Hosts {
+			if parts := strings.SplitN(serverHost, "/", 2); len(parts) == 2 {
+				switch parts[0] {
+				case "*":
+				case ".":
+					if namespace != gateway.Namespace {
+						continue
+					}
+				default:
+					if namespace != parts[0] {
+						continue
+					}
+				}
+				serverHost = parts[1]
+			}
+			if hostname.Matches(host, serverHost) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
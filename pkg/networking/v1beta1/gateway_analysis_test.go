@@ -0,0 +1,71 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1beta1
+
+import (
+	"reflect"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestAnalyzeGatewayBindings(t *testing.T) {
+	gateways := []*Gateway{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "ingress", Namespace: "istio-system"},
+			Spec: GatewaySpec{
+				Servers: []Server{
+					{Hosts: []string{"prod/*.example.com", "./status.example.com"}},
+					{Hosts: []string{"bookinfo.com"}},
+				},
+			},
+		},
+	}
+	virtualServices := []*VirtualService{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "bookinfo", Namespace: "prod"},
+			Spec: VirtualServiceSpec{
+				Hosts:    []string{"bookinfo.example.com", "bookinfo.com", "bookinfo.org"},
+				Gateways: []string{"istio-system/ingress", MeshGateway},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "status", Namespace: "istio-system"},
+			Spec: VirtualServiceSpec{
+				Hosts:    []string{"*"},
+				Gateways: []string{"ingress", "egress"},
+			},
+		},
+	}
+
+	expected := []GatewayBindingIssue{
+		{
+			Type:           GatewayBindingIssueHostNotExposed,
+			VirtualService: "prod/bookinfo",
+			Field:          "spec.hosts[2]",
+			Gateway:        "istio-system/ingress",
+			Host:           "bookinfo.org",
+		},
+		{
+			Type:           GatewayBindingIssueMissingGateway,
+			VirtualService: "istio-system/status",
+			Field:          "spec.gateways[1]",
+			Gateway:        "istio-system/egress",
+		},
+	}
+	if issues := AnalyzeGatewayBindings(gateways, virtualServices); !reflect.DeepEqual(issues, expected) {
+		t.Fatalf("unexpected issues %v", issues)
+	}
+}
@@ -16,10 +16,16 @@ package v1beta1
 
 import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/banzaicloud/istio-client-go/pkg/common/v1alpha1"
+	metav1alpha1 "github.com/banzaicloud/istio-client-go/pkg/meta/v1alpha1"
 )
 
 // +genclient
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:shortName=gw,categories=istio-io;networking-istio-io
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp",description="CreationTimestamp is a timestamp representing the server time when this object was created. It is not guaranteed to be set in happens-before order across separate operations. Clients may not set this value. It is represented in RFC3339 form and is in UTC. Populated by the system. Read-only. Null for lists. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#metadata"
 
 // `Gateway` describes a load balancer operating at the edge of the mesh
 // receiving incoming or outgoing HTTP/TCP connections. The specification
@@ -155,8 +161,7 @@ import (
 //   hosts:
 //   - mongosvr.prod.svc.cluster.local # name of internal Mongo service
 //   gateways:
-//   - some-config-namespace/my-gateway # can omit the namespace if gateway is in same
-//                                        namespace as virtual service.
+//   - some-config-namespace/my-gateway # can omit the namespace if gateway is in same namespace as virtual service.
 //   tcp:
 //   - match:
 //     - port: 27017
@@ -196,11 +201,13 @@ type Gateway struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
 
-	Spec GatewaySpec `json:"spec"`
+	Spec   GatewaySpec               `json:"spec"`
+	Status *metav1alpha1.IstioStatus `json:"status,omitempty"`
 }
 
 type GatewaySpec struct {
 	// REQUIRED: A list of server specifications.
+	// +kubebuilder:validation:MinItems=1
 	Servers []Server `json:"servers"`
 
 	// REQUIRED: One or more labels that indicate a specific set of pods/VMs
@@ -380,37 +387,32 @@ type TLSOptions struct {
 	VerifyCertificateHash []string `json:"verifyCertificateHash,omitempty"`
 
 	// Optional: Minimum TLS protocol version.
-	MinProtocolVersion *TLSProtocol `json:"minProtocolVersion,omitempty"`
+	MinProtocolVersion *v1alpha1.TLSProtocol `json:"minProtocolVersion,omitempty"`
 
 	// Optional: Maximum TLS protocol version.
-	MaxProtocolVersion *TLSProtocol `json:"maxProtocolVersion,omitempty"`
+	MaxProtocolVersion *v1alpha1.TLSProtocol `json:"maxProtocolVersion,omitempty"`
 
 	// Optional: If specified, only support the specified cipher list.
 	// Otherwise default to the default cipher list supported by Envoy.
-	CipherSuites []string `json:"cipherSuites,omitempty"`
+	CipherSuites []v1alpha1.TLSCipherSuite `json:"cipherSuites,omitempty"`
 }
 
 // TLS protocol versions.
-type TLSProtocol string
+//
+// Deprecated: use v1alpha1.TLSProtocol, shared by every TLS setting.
+type TLSProtocol = v1alpha1.TLSProtocol
 
+// Deprecated: use the constants of the v1alpha1 package.
 const (
-	// Automatically choose the optimal TLS version.
-	TLSProtocolAuto TLSProtocol = "TLS_AUTO"
-
-	// TLS version 1.0
-	TLSProtocolV10 TLSProtocol = "TLSV1_0"
-
-	// TLS version 1.1
-	TLSProtocolV11 TLSProtocol = "TLSV1_1"
-
-	// TLS version 1.2
-	TLSProtocolV12 TLSProtocol = "TLSV1_2"
-
-	// TLS version 1.3
-	TLSProtocolV13 TLSProtocol = "TLSV1_3"
+	TLSProtocolAuto = v1alpha1.TLSProtocolAuto
+	TLSProtocolV10  = v1alpha1.TLSProtocolV10
+	TLSProtocolV11  = v1alpha1.TLSProtocolV11
+	TLSProtocolV12  = v1alpha1.TLSProtocolV12
+	TLSProtocolV13  = v1alpha1.TLSProtocolV13
 )
 
 // TLS modes enforced by the proxy
+// +kubebuilder:validation:Enum=PASSTHROUGH;SIMPLE;MUTUAL;AUTO_PASSTHROUGH;ISTIO_MUTUAL
 type TLSMode string
 
 const (
@@ -448,31 +450,25 @@ const (
 )
 
 // Port describes the properties of a specific port of a service.
-type Port struct {
-	// REQUIRED: A valid non-negative integer port number.
-	Number int `json:"number"`
-
-	// REQUIRED: The protocol exposed on the port.
-	// MUST BE one of HTTP|HTTPS|GRPC|HTTP2|MONGO|TCP|TLS.
-	// TLS implies the connection will be routed based on the SNI header to
-	// the destination without terminating the TLS connection.
-	Protocol PortProtocol `json:"protocol"`
-
-	// Label assigned to the port.
-	Name string `json:"name,omitempty"`
-}
+//
+// Deprecated: use v1alpha1.Port, shared by every kind exposing ports.
+type Port = v1alpha1.Port
 
-type PortProtocol string
+// PortProtocol is the protocol exposed on a port.
+//
+// Deprecated: use v1alpha1.PortProtocol.
+type PortProtocol = v1alpha1.PortProtocol
 
+// Deprecated: use the constants of the v1alpha1 package.
 const (
-	ProtocolHTTP    PortProtocol = "HTTP"
-	ProtocolHTTPS   PortProtocol = "HTTPS"
-	ProtocolGRPC    PortProtocol = "GRPC"
-	ProtocolGRPCWeb PortProtocol = "GRPC-Web"
-	ProtocolHTTP2   PortProtocol = "HTTP2"
-	ProtocolMongo   PortProtocol = "Mongo"
-	ProtocolTCP     PortProtocol = "TCP"
-	ProtocolTLS     PortProtocol = "TLS"
+	ProtocolHTTP    = v1alpha1.ProtocolHTTP
+	ProtocolHTTPS   = v1alpha1.ProtocolHTTPS
+	ProtocolGRPC    = v1alpha1.ProtocolGRPC
+	ProtocolGRPCWeb = v1alpha1.ProtocolGRPCWeb
+	ProtocolHTTP2   = v1alpha1.ProtocolHTTP2
+	ProtocolMongo   = v1alpha1.ProtocolMongo
+	ProtocolTCP     = v1alpha1.ProtocolTCP
+	ProtocolTLS     = v1alpha1.ProtocolTLS
 )
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -0,0 +1,155 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1beta1
+
+import (
+	"sort"
+	"strings"
+)
+
+// MergeHeaders merges the header manipulation rules of the overlay into the
+// ones of the base, see MergeHeaderOperations. Neither of them is modified.
+func MergeHeaders(base, overlay *Headers) *Headers {
+	if base == nil && overlay == nil {
+		return nil
+	}
+	if base == nil {
+		base = &Headers{}
+	}
+	if overlay == nil {
+		overlay = &Headers{}
+	}
+
+	return &Headers{
+		Request:  MergeHeaderOperations(base.Request, overlay.Request),
+		Response: MergeHeaderOperations(base.Response, overlay.Response),
+	}
+}
+
+// MergeHeaderOperations merges the header operations of the overlay into the
+// ones of the base. Header names are case insensitive, so both are
+// normalized first. The overlay takes precedence: the operations of the base
+// on a header the overlay sets, adds or removes are dropped. Neither of them
+// is modified.
+func MergeHeaderOperations(base, overlay *HeaderOperations) *HeaderOperations {
+	if base == nil && overlay == nil {
+		return nil
+	}
+	base = NormalizeHeaderOperations(base)
+	overlay = NormalizeHeaderOperations(overlay)
+	if base == nil {
+		return overlay
+	}
+	if overlay == nil {
+		return base
+	}
+
+	overridden := make(map[string]bool)
+	for name := range overlay.Set {
+		overridden[name] = true
+	}
+	for name := range overlay.Add {
+		overridden[name] = true
+	}
+	for _, name := range overlay.Remove {
+		overridden[name] = true
+	}
+
+	for name, value := range base.Set {
+		if !overridden[name] {
+			overlay.Set = setHeader(overlay.Set, name, value)
+		}
+	}
+	for name, value := range base.Add {
+		if !overridden[name] {
+			overlay.Add = setHeader(overlay.Add, name, value)
+		}
+	}
+	for _, name := range base.Remove {
+		if !overridden[name] {
+			overlay.Remove = append(overlay.Remove, name)
+		}
+	}
+	sort.Strings(overlay.Remove)
+
+	return overlay
+}
+
+// NormalizeHeaderOperations returns a copy of the header operations with the
+// header names in lower case. If several names of a map only differ in
+// case, the value of the one already in lower case wins. The removed headers
+// are sorted and deduplicated.
+func NormalizeHeaderOperations(ops *HeaderOperations) *HeaderOperations {
+	if ops == nil {
+		return nil
+	}
+
+	return &HeaderOperations{
+		Set:    normalizeHeaderMap(ops.Set),
+		Add:    normalizeHeaderMap(ops.Add),
+		Remove: normalizeHeaderNames(ops.Remove),
+	}
+}
+
+// normalizeHeaderMap lower cases the names of the headers. The names are
+// visited in order, so that a name in lower case, sorting after the ones in
+// upper case, overrides them.
+func normalizeHeaderMap(headers map[string]string) map[string]string {
+	if headers == nil {
+		return nil
+	}
+
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	out := make(map[string]string, len(headers))
+	for _, name := range names {
+		out[strings.ToLower(name)] = headers[name]
+	}
+
+	return out
+}
+
+func normalizeHeaderNames(names []string) []string {
+	if names == nil {
+		return nil
+	}
+
+	seen := make(map[string]bool, len(names))
+	out := make([]string, 0, len(names))
+	for _, name := range names {
+		name = strings.ToLower(name)
+		if seen[name] {
+			continue
+		}
+		seen[name] = true
+		out = append(out, name)
+	}
+	sort.Strings(out)
+
+	return out
+}
+
+func setHeader(headers map[string]string, name, value string) map[string]string {
+	if headers == nil {
+		headers = make(map[string]string)
+	}
+	headers[name] = value
+
+	return headers
+}
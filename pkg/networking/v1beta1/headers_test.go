@@ -0,0 +1,59 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1beta1
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMergeHeaders(t *testing.T) {
+	base := &Headers{
+		Request: &HeaderOperations{
+			Set:    map[string]string{"X-Version": "v1", "x-version": "v2", "X-Tenant": "foo"},
+			Add:    map[string]string{"X-Trace": "on"},
+			Remove: []string{"X-Debug", "Cookie", "x-debug"},
+		},
+		Response: &HeaderOperations{
+			Remove: []string{"Server"},
+		},
+	}
+	overlay := &Headers{
+		Request: &HeaderOperations{
+			Set:    map[string]string{"x-trace": "off"},
+			Remove: []string{"X-Tenant"},
+		},
+	}
+	before := base.DeepCopy()
+
+	expected := &Headers{
+		Request: &HeaderOperations{
+			Set:    map[string]string{"x-trace": "off", "x-version": "v2"},
+			Remove: []string{"cookie", "x-debug", "x-tenant"},
+		},
+		Response: &HeaderOperations{
+			Remove: []string{"server"},
+		},
+	}
+	if merged := MergeHeaders(base, overlay); !reflect.DeepEqual(merged, expected) {
+		t.Errorf("expected %v, got %v", expected, merged)
+	}
+	if !reflect.DeepEqual(base, before) {
+		t.Error("expected the base to be left unchanged")
+	}
+	if merged := MergeHeaders(nil, nil); merged != nil {
+		t.Errorf("expected no headers, got %v", merged)
+	}
+}
@@ -0,0 +1,147 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1beta1
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	metav1alpha1 "github.com/banzaicloud/istio-client-go/pkg/meta/v1alpha1"
+
+	hostname "github.com/banzaicloud/istio-client-go/pkg/host"
+)
+
+// ConditionHostConflict is the type of the condition reporting that a virtual
+// service defines a host of a gateway another one defines too.
+const ConditionHostConflict = "HostConflict"
+
+// HostConflict is a host of a gateway defined by more than one virtual
+// service, reported by AnalyzeHostConflicts. Istio merges the routes of such
+// virtual services for gateways in no particular order, and picks one of them
+// for the mesh.
+type HostConflict struct {
+	// The gateway in the namespace/name format, or mesh.
+	Gateway string
+	// The fully qualified name of the host.
+	Host string
+	// The conflicting virtual services in the namespace/name format.
+	VirtualServices []string
+}
+
+func (c HostConflict) String() string {
+	return fmt.Sprintf("host %s of gateway %s is defined by the virtual services %s", c.Host, c.Gateway, strings.Join(c.VirtualServices, ", "))
+}
+
+// AnalyzeHostConflicts reports the hosts of gateways defined by more than one
+// of the virtual services, ordered by gateway and host. The virtual services
+// of a conflict are in the order they were given. Short host names are
+// resolved in the namespace of the virtual service, assuming the
+// DefaultDomainSuffix.
+func AnalyzeHostConflicts(virtualServices []*VirtualService) []HostConflict {
+	type gatewayHost struct {
+		gateway string
+		host    string
+	}
+
+	definitions := map[gatewayHost][]string{}
+	for _, vs := range virtualServices {
+		if vs == nil {
+			continue
+		}
+
+		gateways := vs.Spec.Gateways
+		if len(gateways) == 0 {
+			gateways = []string{MeshGateway}
+		}
+		name := vs.Namespace + "/" + vs.Name
+		for _, gateway := range gateways {
+			gateway = ResolveGateway(gateway, vs.Namespace)
+			seen := map[string]bool{}
+			for _, host := range vs.Spec.Hosts {
+				host = strings.ToLower(hostname.Resolve(host, vs.Namespace))
+				if seen[host] {
+					continue
+				}
+				seen[host] = true
+				key := gatewayHost{gateway: gateway, host: host}
+				definitions[key] = append(definitions[key], name)
+			}
+		}
+	}
+
+	var conflicts []HostConflict
+	for key, names := range definitions {
+		if len(names) > 1 {
+			conflicts = append(conflicts, HostConflict{Gateway: key.gateway, Host: key.host, VirtualServices: names})
+		}
+	}
+	sort.Slice(conflicts, func(i, j int) bool {
+		if conflicts[i].Gateway != conflicts[j].Gateway {
+			return conflicts[i].Gateway < conflicts[j].Gateway
+		}
+		return conflicts[i].Host < conflicts[j].Host
+	})
+
+	return conflicts
+}
+
+// HostConflictCondition returns the condition of the virtual service for the
+// conflicts returned by AnalyzeHostConflicts, which is true if the virtual
+// service is one of the conflicting ones. It is meant to be set in the status
+// with SetCondition.
+func HostConflictCondition(vs *VirtualService, conflicts []HostConflict) metav1alpha1.IstioCondition {
+	name := vs.Namespace + "/" + vs.Name
+
+	var messages []string
+	for _, conflict := range conflicts {
+		for _, conflicting := range conflict.VirtualServices {
+			if conflicting == name {
+				messages = append(messages, conflict.String())
+				break
+			}
+		}
+	}
+
+	if len(messages) == 0 {
+		return metav1alpha1.IstioCondition{
+			Type:   ConditionHostConflict,
+			Status: metav1alpha1.ConditionStatusFalse,
+			Reason: "NoConflicts",
+		}
+	}
+
+	return metav1alpha1.IstioCondition{
+		Type:    ConditionHostConflict,
+		Status:  metav1alpha1.ConditionStatusTrue,
+		Reason:  "ConflictingHosts",
+		Message: strings.Join(messages, "; "),
+	}
+}
+
+// ResolveGateway returns the gateway in the namespace/name format the way
+// Istio does: names without a namespace are in the namespace of the virtual
+// service, and the legacy name.namespace.svc.cluster.local format is
+// converted.
+func ResolveGateway(gateway, namespace string) string {
+	if gateway == MeshGateway || strings.Contains(gateway, "/") {
+		return gateway
+	}
+	if parts := strings.Split(gateway, "."); len(parts) > 1 {
+		return parts[1] + "/" + parts[0]
+	}
+
+	return namespace + "/" + gateway
+}
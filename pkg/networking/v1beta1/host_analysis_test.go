@@ -0,0 +1,81 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1beta1
+
+import (
+	"reflect"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	metav1alpha1 "github.com/banzaicloud/istio-client-go/pkg/meta/v1alpha1"
+)
+
+func TestAnalyzeHostConflicts(t *testing.T) {
+	virtualServices := []*VirtualService{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "bookinfo", Namespace: "prod"},
+			Spec: VirtualServiceSpec{
+				Hosts:    []string{"bookinfo.example.com", "reviews"},
+				Gateways: []string{"ingress", MeshGateway},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "bookinfo-api", Namespace: "prod"},
+			Spec: VirtualServiceSpec{
+				Hosts:    []string{"Bookinfo.example.com"},
+				Gateways: []string{"prod/ingress"},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "reviews", Namespace: "prod"},
+			Spec: VirtualServiceSpec{
+				Hosts: []string{"reviews.prod.svc.cluster.local"},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "bookinfo", Namespace: "test"},
+			Spec: VirtualServiceSpec{
+				Hosts:    []string{"bookinfo.example.com"},
+				Gateways: []string{"ingress"},
+			},
+		},
+	}
+
+	expected := []HostConflict{
+		{
+			Gateway:         MeshGateway,
+			Host:            "reviews.prod.svc.cluster.local",
+			VirtualServices: []string{"prod/bookinfo", "prod/reviews"},
+		},
+		{
+			Gateway:         "prod/ingress",
+			Host:            "bookinfo.example.com",
+			VirtualServices: []string{"prod/bookinfo", "prod/bookinfo-api"},
+		},
+	}
+	conflicts := AnalyzeHostConflicts(virtualServices)
+	if !reflect.DeepEqual(conflicts, expected) {
+		t.Fatalf("unexpected conflicts %v", conflicts)
+	}
+
+	condition := HostConflictCondition(virtualServices[1], conflicts)
+	if condition.Status != metav1alpha1.ConditionStatusTrue || condition.Message != expected[1].String() {
+		t.Errorf("unexpected condition %v", condition)
+	}
+	if condition := HostConflictCondition(virtualServices[3], conflicts); condition.Status != metav1alpha1.ConditionStatusFalse {
+		t.Errorf("unexpected condition %v", condition)
+	}
+}
@@ -0,0 +1,209 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1beta1
+
+import (
+	"sort"
+
+	"github.com/banzaicloud/istio-client-go/pkg/normalize"
+)
+
+// Normalize puts the spec of the virtual service into a deterministic form,
+// see VirtualServiceSpec.Normalize.
+func (vs *VirtualService) Normalize() {
+	vs.Spec.Normalize()
+}
+
+// Normalize sorts the lists of the spec the order of which does not matter,
+// such as the hosts, the gateways and the match conditions of the routes,
+// and strips the empty lists and maps. The order of the routes and of their
+// destinations is kept, since it is significant.
+func (s *VirtualServiceSpec) Normalize() {
+	s.Hosts = normalize.Strings(s.Hosts)
+	s.Gateways = normalize.Strings(s.Gateways)
+	s.ExportTo = normalize.Strings(s.ExportTo)
+
+	for i := range s.HTTP {
+		route := &s.HTTP[i]
+		normalize.SortByJSON(route.Match)
+		route.Headers.normalize()
+		for _, destination := range route.Route {
+			if destination != nil {
+				destination.Headers.normalize()
+			}
+		}
+		if cors := route.CorsPolicy; cors != nil {
+			cors.AllowOrigin = normalize.Strings(cors.AllowOrigin)
+			normalize.SortByJSON(cors.AllowOrigins)
+			cors.AllowMethods = normalize.Strings(cors.AllowMethods)
+			cors.AllowHeaders = normalize.Strings(cors.AllowHeaders)
+			cors.ExposeHeaders = normalize.Strings(cors.ExposeHeaders)
+		}
+	}
+	for i := range s.TLS {
+		route := &s.TLS[i]
+		for j := range route.Match {
+			match := &route.Match[j]
+			match.SniHosts = normalize.Strings(match.SniHosts)
+			match.DestinationSubnets = normalize.Strings(match.DestinationSubnets)
+			match.Gateways = normalize.Strings(match.Gateways)
+		}
+		normalize.SortByJSON(route.Match)
+	}
+	for i := range s.TCP {
+		route := &s.TCP[i]
+		for j := range route.Match {
+			match := &route.Match[j]
+			match.DestinationSubnets = normalize.Strings(match.DestinationSubnets)
+			match.Gateways = normalize.Strings(match.Gateways)
+		}
+		normalize.SortByJSON(route.Match)
+	}
+
+	normalize.StripEmpty(s)
+}
+
+func (h *Headers) normalize() {
+	if h == nil {
+		return
+	}
+	if h.Request != nil {
+		h.Request.Remove = normalize.Strings(h.Request.Remove)
+	}
+	if h.Response != nil {
+		h.Response.Remove = normalize.Strings(h.Response.Remove)
+	}
+}
+
+// Normalize puts the spec of the destination rule into a deterministic form,
+// see DestinationRuleSpec.Normalize.
+func (dr *DestinationRule) Normalize() {
+	dr.Spec.Normalize()
+}
+
+// Normalize sorts the subsets by name, the port level settings by port, the
+// exportTo namespaces and the subject alternative names, and strips the empty
+// lists and maps.
+func (s *DestinationRuleSpec) Normalize() {
+	s.ExportTo = normalize.Strings(s.ExportTo)
+	s.TrafficPolicy.normalize()
+	sort.SliceStable(s.Subsets, func(i, j int) bool {
+		return s.Subsets[i].Name < s.Subsets[j].Name
+	})
+	for i := range s.Subsets {
+		s.Subsets[i].TrafficPolicy.normalize()
+	}
+
+	normalize.StripEmpty(s)
+}
+
+func (p *TrafficPolicy) normalize() {
+	if p == nil {
+		return
+	}
+	p.TrafficPolicyCommon.normalize()
+	for i := range p.PortLevelSettings {
+		p.PortLevelSettings[i].TrafficPolicyCommon.normalize()
+	}
+	sort.SliceStable(p.PortLevelSettings, func(i, j int) bool {
+		return portNumber(p.PortLevelSettings[i].Port) < portNumber(p.PortLevelSettings[j].Port)
+	})
+}
+
+func portNumber(port *PortSelector) uint32 {
+	if port == nil {
+		return 0
+	}
+
+	return port.Number
+}
+
+func (p *TrafficPolicyCommon) normalize() {
+	if p.TLS != nil {
+		p.TLS.SubjectAltNames = normalize.Strings(p.TLS.SubjectAltNames)
+	}
+}
+
+// Normalize puts the spec of the gateway into a deterministic form, see
+// GatewaySpec.Normalize.
+func (g *Gateway) Normalize() {
+	g.Spec.Normalize()
+}
+
+// Normalize sorts the hosts and the subject alternative names of the
+// servers, and strips the empty lists and maps. The order of the servers and
+// of the cipher suites is kept, since it is significant.
+func (s *GatewaySpec) Normalize() {
+	for i := range s.Servers {
+		server := &s.Servers[i]
+		server.Hosts = normalize.Strings(server.Hosts)
+		if server.TLS != nil {
+			server.TLS.SubjectAltNames = normalize.Strings(server.TLS.SubjectAltNames)
+			server.TLS.VerifyCertificateSpki = normalize.Strings(server.TLS.VerifyCertificateSpki)
+			server.TLS.VerifyCertificateHash = normalize.Strings(server.TLS.VerifyCertificateHash)
+		}
+	}
+
+	normalize.StripEmpty(s)
+}
+
+// Normalize puts the spec of the service entry into a deterministic form,
+// see ServiceEntrySpec.Normalize.
+func (se *ServiceEntry) Normalize() {
+	se.Spec.Normalize()
+}
+
+// Normalize sorts the hosts, the addresses, the exportTo namespaces, the
+// subject alternative names and the endpoints, and strips the empty lists
+// and maps.
+func (s *ServiceEntrySpec) Normalize() {
+	s.Hosts = normalize.Strings(s.Hosts)
+	s.Addresses = normalize.Strings(s.Addresses)
+	s.ExportTo = normalize.Strings(s.ExportTo)
+	s.SubjectAltNames = normalize.Strings(s.SubjectAltNames)
+	normalize.SortByJSON(s.Endpoints)
+
+	normalize.StripEmpty(s)
+}
+
+// Normalize puts the spec of the sidecar into a deterministic form, see
+// SidecarSpec.Normalize.
+func (s *Sidecar) Normalize() {
+	s.Spec.Normalize()
+}
+
+// Normalize sorts the hosts of the egress listeners, and strips the empty
+// lists and maps. The order of the listeners is kept, since it is
+// significant.
+func (s *SidecarSpec) Normalize() {
+	for _, listener := range s.Egress {
+		if listener != nil {
+			listener.Hosts = normalize.Strings(listener.Hosts)
+		}
+	}
+
+	normalize.StripEmpty(s)
+}
+
+// Normalize puts the spec of the workload entry into a deterministic form,
+// see WorkloadEntrySpec.Normalize.
+func (we *WorkloadEntry) Normalize() {
+	we.Spec.Normalize()
+}
+
+// Normalize strips the empty lists and maps of the spec.
+func (s *WorkloadEntrySpec) Normalize() {
+	normalize.StripEmpty(s)
+}
@@ -0,0 +1,146 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1beta1
+
+import (
+	"reflect"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/banzaicloud/istio-client-go/pkg/common/v1alpha1"
+	"github.com/banzaicloud/istio-client-go/pkg/normalize"
+)
+
+func TestVirtualServiceNormalize(t *testing.T) {
+	vs := &VirtualService{
+		Spec: VirtualServiceSpec{
+			Hosts:    []string{"reviews", "ratings"},
+			Gateways: []string{"mesh", "bookinfo-gateway"},
+			ExportTo: []string{},
+			HTTP: []HTTPRoute{
+				{
+					Match: []*HTTPMatchRequest{
+						{URI: &v1alpha1.StringMatch{Prefix: "/v2"}},
+						{URI: &v1alpha1.StringMatch{Prefix: "/v1"}, Headers: map[string]v1alpha1.StringMatch{}},
+					},
+					Route: []*HTTPRouteDestination{
+						{Destination: &Destination{Host: "reviews"}, Headers: &Headers{Request: &HeaderOperations{Remove: []string{"x-b", "x-a"}, Set: map[string]string{}}}},
+					},
+				},
+				{
+					Route: []*HTTPRouteDestination{{Destination: &Destination{Host: "ratings"}}},
+				},
+			},
+		},
+	}
+	expected := &VirtualService{
+		Spec: VirtualServiceSpec{
+			Hosts:    []string{"ratings", "reviews"},
+			Gateways: []string{"bookinfo-gateway", "mesh"},
+			HTTP: []HTTPRoute{
+				{
+					Match: []*HTTPMatchRequest{
+						{URI: &v1alpha1.StringMatch{Prefix: "/v1"}},
+						{URI: &v1alpha1.StringMatch{Prefix: "/v2"}},
+					},
+					Route: []*HTTPRouteDestination{
+						{Destination: &Destination{Host: "reviews"}, Headers: &Headers{Request: &HeaderOperations{Remove: []string{"x-a", "x-b"}}}},
+					},
+				},
+				{
+					Route: []*HTTPRouteDestination{{Destination: &Destination{Host: "ratings"}}},
+				},
+			},
+		},
+	}
+
+	vs.Normalize()
+	if !reflect.DeepEqual(vs, expected) {
+		t.Errorf("expected %v, got %v", expected.Spec, vs.Spec)
+	}
+}
+
+func TestDestinationRuleNormalize(t *testing.T) {
+	dr := &DestinationRule{
+		Spec: DestinationRuleSpec{
+			Host: "reviews",
+			Subsets: []Subset{
+				{Name: "v2", Labels: map[string]string{"version": "v2"}},
+				{Name: "v1", Labels: map[string]string{"version": "v1"}},
+			},
+			TrafficPolicy: &TrafficPolicy{
+				PortLevelSettings: []PortTrafficPolicy{
+					{Port: &PortSelector{Number: 9443}},
+					{Port: &PortSelector{Number: 8443}, TrafficPolicyCommon: TrafficPolicyCommon{TLS: &TLSSettings{Mode: TLSmodeSimple, SubjectAltNames: []string{}}}},
+				},
+			},
+		},
+	}
+	expected := &DestinationRule{
+		Spec: DestinationRuleSpec{
+			Host: "reviews",
+			Subsets: []Subset{
+				{Name: "v1", Labels: map[string]string{"version": "v1"}},
+				{Name: "v2", Labels: map[string]string{"version": "v2"}},
+			},
+			TrafficPolicy: &TrafficPolicy{
+				PortLevelSettings: []PortTrafficPolicy{
+					{Port: &PortSelector{Number: 8443}, TrafficPolicyCommon: TrafficPolicyCommon{TLS: &TLSSettings{Mode: TLSmodeSimple}}},
+					{Port: &PortSelector{Number: 9443}},
+				},
+			},
+		},
+	}
+
+	dr.Normalize()
+	if !reflect.DeepEqual(dr, expected) {
+		t.Errorf("expected %v, got %v", expected.Spec, dr.Spec)
+	}
+}
+
+func TestSpecHash(t *testing.T) {
+	a := &VirtualService{
+		ObjectMeta: metav1.ObjectMeta{Name: "reviews", ResourceVersion: "1"},
+		Spec: VirtualServiceSpec{
+			Hosts: []string{"reviews", "ratings"},
+			HTTP:  []HTTPRoute{{Route: []*HTTPRouteDestination{{Destination: &Destination{Host: "reviews"}}}}},
+		},
+	}
+	b := a.DeepCopy()
+	b.ResourceVersion = "2"
+	b.Spec.Hosts = []string{"ratings", "reviews"}
+	b.Spec.Gateways = []string{"mesh"}
+
+	hashA, err := normalize.SpecHash(a)
+	if err != nil {
+		t.Fatal(err)
+	}
+	hashB, err := normalize.SpecHash(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if hashA != hashB {
+		t.Errorf("expected the hashes of semantically identical specs to be equal, got %s and %s", hashA, hashB)
+	}
+	if a.Spec.Hosts[0] != "reviews" {
+		t.Error("expected the object to be left unchanged")
+	}
+
+	b.Spec.HTTP[0].Route[0].Destination.Host = "ratings"
+	if hashB, _ = normalize.SpecHash(b); hashA == hashB {
+		t.Error("expected the hashes of different specs to differ")
+	}
+}
@@ -0,0 +1,39 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1beta1
+
+// The weights of the route destinations and the ports of the L4 and TLS match
+// attributes were *int before their types were aligned with istio.io/api.
+// The functions below convert the values of code written against the old
+// types; the other aligned fields only need a plain conversion, e.g.
+// int32(attempts) for HTTPRetry.Attempts.
+
+// IntWeight returns the weight as the *int32 of RouteDestination.Weight and
+// HTTPRouteDestination.Weight.
+//
+// Deprecated: set the weights to an *int32.
+func IntWeight(weight int) *int32 {
+	w := int32(weight)
+	return &w
+}
+
+// IntPort returns the port as the *uint32 of L4MatchAttributes.Port and
+// TLSMatchAttributes.Port.
+//
+// Deprecated: set the ports to an *uint32.
+func IntPort(port int) *uint32 {
+	p := uint32(port)
+	return &p
+}
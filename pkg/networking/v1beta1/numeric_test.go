@@ -0,0 +1,31 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1beta1
+
+import (
+	"testing"
+)
+
+func TestNumericShims(t *testing.T) {
+	route := &HTTPRouteDestination{Weight: IntWeight(25)}
+	if *route.Weight != 25 {
+		t.Errorf("unexpected weight %d", *route.Weight)
+	}
+
+	match := &L4MatchAttributes{Port: IntPort(8080)}
+	if *match.Port != 8080 {
+		t.Errorf("unexpected port %d", *match.Port)
+	}
+}
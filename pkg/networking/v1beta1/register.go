@@ -35,6 +35,22 @@ func Resource(resource string) schema.GroupResource {
 	return SchemeGroupVersion.WithResource(resource).GroupResource()
 }
 
+// GroupVersionKinds and GroupVersionResources of the types in this group version
+var (
+	DestinationRuleGroupVersionKind     = SchemeGroupVersion.WithKind("DestinationRule")
+	DestinationRuleGroupVersionResource = SchemeGroupVersion.WithResource("destinationrules")
+	GatewayGroupVersionKind             = SchemeGroupVersion.WithKind("Gateway")
+	GatewayGroupVersionResource         = SchemeGroupVersion.WithResource("gateways")
+	ServiceEntryGroupVersionKind        = SchemeGroupVersion.WithKind("ServiceEntry")
+	ServiceEntryGroupVersionResource    = SchemeGroupVersion.WithResource("serviceentries")
+	SidecarGroupVersionKind             = SchemeGroupVersion.WithKind("Sidecar")
+	SidecarGroupVersionResource         = SchemeGroupVersion.WithResource("sidecars")
+	VirtualServiceGroupVersionKind      = SchemeGroupVersion.WithKind("VirtualService")
+	VirtualServiceGroupVersionResource  = SchemeGroupVersion.WithResource("virtualservices")
+	WorkloadEntryGroupVersionKind       = SchemeGroupVersion.WithKind("WorkloadEntry")
+	WorkloadEntryGroupVersionResource   = SchemeGroupVersion.WithResource("workloadentries")
+)
+
 var (
 	SchemeBuilder = runtime.NewSchemeBuilder(addKnownTypes)
 	AddToScheme   = SchemeBuilder.AddToScheme
@@ -16,10 +16,14 @@ package v1beta1
 
 import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	metav1alpha1 "github.com/banzaicloud/istio-client-go/pkg/meta/v1alpha1"
 )
 
 // +genclient
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:categories=istio-io;networking-istio-io
 
 // Sidecar describes the configuration of the sidecar proxy that mediates
 // inbound and outbound communication to the workload instance it is attached to. By
@@ -61,7 +65,7 @@ import (
 //
 // ```yaml
 // apiVersion: networking.istio.io/v1beta1
-// kind: SidecarSpec
+// kind: Sidecar
 // metadata:
 //   name: default
 //   namespace: istio-config
@@ -80,7 +84,7 @@ import (
 //
 // ```yaml
 // apiVersion: networking.istio.io/v1beta1
-// kind: SidecarSpec
+// kind: Sidecar
 // metadata:
 //   name: default
 //   namespace: prod-us1
@@ -101,7 +105,7 @@ import (
 //
 // ```yaml
 // apiVersion: networking.istio.io/v1beta1
-// kind: SidecarSpec
+// kind: Sidecar
 // metadata:
 //   name: default
 //   namespace: prod-us1
@@ -138,7 +142,7 @@ import (
 //
 // ```yaml
 // apiVersion: networking.istio.io/v1beta1
-// kind: SidecarSpec
+// kind: Sidecar
 // metadata:
 //   name: no-ip-tables
 //   namespace: prod-us1
@@ -197,7 +201,7 @@ import (
 //
 // ```yaml
 // apiVersion: networking.istio.io/v1beta1
-// kind: SidecarSpec
+// kind: Sidecar
 // metadata:
 //   name: partial-ip-tables
 //   namespace: prod-us1
@@ -226,7 +230,8 @@ type Sidecar struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
 
-	Spec SidecarSpec `json:"spec"`
+	Spec   SidecarSpec               `json:"spec"`
+	Status *metav1alpha1.IstioStatus `json:"status,omitempty"`
 }
 
 // SidecarSpec describes the configuration of the sidecar proxy that mediates
@@ -247,7 +252,7 @@ type SidecarSpec struct {
 	// Egress specifies the configuration of the sidecar for processing
 	// outbound traffic from the attached workload instance to other services in the
 	// mesh.
-	Egress []*IstioEgressListener `json:"egress"`
+	Egress []*IstioEgressListener `json:"egress,omitempty"`
 	// This allows to configure the outbound traffic policy.
 	// If your application uses one or more external
 	// services that are not known apriori, setting the policy to `ALLOW_ANY`
@@ -269,6 +274,7 @@ type OutboundTrafficPolicy struct {
 	Mode *OutboundTrafficPolicyMode `json:"mode,omitempty"`
 }
 
+// +kubebuilder:validation:Enum=REGISTRY_ONLY;ALLOW_ANY
 type OutboundTrafficPolicyMode string
 
 const (
@@ -384,6 +390,7 @@ type WorkloadSelector struct {
 
 // CaptureMode describes how traffic to a listener is expected to be
 // captured. Applicable only when the listener is bound to an IP.
+// +kubebuilder:validation:Enum=DEFAULT;IPTABLES;NONE
 type CaptureMode string
 
 const (
@@ -0,0 +1,218 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1beta1
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+
+	k8svalidation "k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/banzaicloud/istio-client-go/pkg/validation"
+)
+
+const unixSocketPrefix = "unix://"
+
+// Validate checks the sidecar the way Istio does before accepting it.
+func (s *Sidecar) Validate() field.ErrorList {
+	return s.Spec.Validate(field.NewPath("spec"))
+}
+
+// Validate checks the spec the way Istio does before accepting it.
+func (s *SidecarSpec) Validate(path *field.Path) field.ErrorList {
+	var errs field.ErrorList
+
+	if s.WorkloadSelector != nil {
+		errs = append(errs, validateLabels(path.Child("workloadSelector", "labels"), s.WorkloadSelector.Labels)...)
+	}
+	if len(s.Ingress) == 0 && len(s.Egress) == 0 && s.OutboundTrafficPolicy == nil {
+		errs = append(errs, field.Required(path, "at least one of ingress, egress or outboundTrafficPolicy is required"))
+	}
+
+	ingressPorts := map[uint32]bool{}
+	for i, listener := range s.Ingress {
+		listenerPath := path.Child("ingress").Index(i)
+		if listener == nil {
+			errs = append(errs, field.Required(listenerPath, "ingress listener must not be empty"))
+			continue
+		}
+		errs = append(errs, listener.validate(listenerPath)...)
+		if listener.Port != nil {
+			if ingressPorts[listener.Port.Number] {
+				errs = append(errs, field.Duplicate(listenerPath.Child("port", "number"), listener.Port.Number))
+			}
+			ingressPorts[listener.Port.Number] = true
+		}
+	}
+
+	for i, listener := range s.Egress {
+		listenerPath := path.Child("egress").Index(i)
+		if listener == nil {
+			errs = append(errs, field.Required(listenerPath, "egress listener must not be empty"))
+			continue
+		}
+		errs = append(errs, listener.validate(listenerPath)...)
+		if listener.Port == nil && i != len(s.Egress)-1 {
+			errs = append(errs, field.Invalid(listenerPath.Child("port"), nil, "the egress listener without a port must be the last one"))
+		}
+	}
+
+	return errs
+}
+
+// ValidateSidecars checks the constraints which span several sidecars: each
+// namespace may have only one sidecar without a workload selector, as Istio
+// picks one of them arbitrarily otherwise. The errors name the sidecars in the
+// namespace/name format.
+func ValidateSidecars(sidecars []*Sidecar) field.ErrorList {
+	var errs field.ErrorList
+
+	defaults := map[string]string{}
+	for _, sidecar := range sidecars {
+		if sidecar == nil || (sidecar.Spec.WorkloadSelector != nil && len(sidecar.Spec.WorkloadSelector.Labels) > 0) {
+			continue
+		}
+		if name, ok := defaults[sidecar.Namespace]; ok {
+			errs = append(errs, field.Forbidden(field.NewPath("spec", "workloadSelector"), fmt.Sprintf(
+				"sidecar %s/%s: the namespace already has the sidecar %s/%s without a workload selector",
+				sidecar.Namespace, sidecar.Name, sidecar.Namespace, name)))
+			continue
+		}
+		defaults[sidecar.Namespace] = sidecar.Name
+	}
+
+	return errs
+}
+
+func (l *IstioIngressListener) validate(path *field.Path) field.ErrorList {
+	var errs field.ErrorList
+
+	errs = append(errs, validation.ServicePort(path.Child("port"), l.Port)...)
+	switch {
+	case strings.HasPrefix(l.Bind, unixSocketPrefix):
+		errs = append(errs, field.Invalid(path.Child("bind"), l.Bind, "ingress listeners do not support Unix domain sockets"))
+	case l.Bind != "" && net.ParseIP(l.Bind) == nil:
+		errs = append(errs, field.Invalid(path.Child("bind"), l.Bind, "must be an IP address"))
+	}
+	errs = append(errs, validateCaptureMode(path.Child("captureMode"), l.CaptureMode)...)
+	errs = append(errs, validateDefaultEndpoint(path.Child("defaultEndpoint"), l.DefaultEndpoint)...)
+
+	return errs
+}
+
+func (l *IstioEgressListener) validate(path *field.Path) field.ErrorList {
+	errs := validateCaptureMode(path.Child("captureMode"), l.CaptureMode)
+
+	switch {
+	case strings.HasPrefix(l.Bind, unixSocketPrefix):
+		if l.Port == nil {
+			errs = append(errs, field.Required(path.Child("port"), "port is required when bind is set"))
+		} else if l.Port.Number != 0 {
+			errs = append(errs, field.Invalid(path.Child("port", "number"), l.Port.Number, "must be 0 for a Unix domain socket bind"))
+		}
+		if l.CaptureMode == CaptureModeIPTables {
+			errs = append(errs, field.Invalid(path.Child("captureMode"), l.CaptureMode, "must be DEFAULT or NONE for a Unix domain socket bind"))
+		}
+		if len(l.Bind) == len(unixSocketPrefix) {
+			errs = append(errs, field.Invalid(path.Child("bind"), l.Bind, "must contain the path of the socket"))
+		}
+	case l.Bind != "":
+		if net.ParseIP(l.Bind) == nil {
+			errs = append(errs, field.Invalid(path.Child("bind"), l.Bind, "must be an IP address or a Unix domain socket"))
+		}
+		if l.Port == nil {
+			errs = append(errs, field.Required(path.Child("port"), "port is required when bind is set"))
+		}
+		fallthrough
+	default:
+		if l.Port != nil {
+			errs = append(errs, validation.ServicePort(path.Child("port"), l.Port)...)
+		}
+	}
+
+	if len(l.Hosts) == 0 {
+		errs = append(errs, field.Required(path.Child("hosts"), "at least one host is required"))
+	}
+	for i, host := range l.Hosts {
+		errs = append(errs, validateEgressHost(path.Child("hosts").Index(i), host)...)
+	}
+
+	return errs
+}
+
+// validateCaptureMode checks that the capture mode, if set, is one of the
+// modes Istio supports.
+func validateCaptureMode(path *field.Path, mode CaptureMode) field.ErrorList {
+	switch mode {
+	case "", CaptureModeDefault, CaptureModeIPTables, CaptureModeNone:
+		return nil
+	default:
+		return field.ErrorList{field.NotSupported(path, mode, []string{
+			string(CaptureModeDefault), string(CaptureModeIPTables), string(CaptureModeNone),
+		})}
+	}
+}
+
+// validateEgressHost checks that the host is in the namespace/dnsName format,
+// where the namespace may be *, . or ~ too.
+func validateEgressHost(path *field.Path, host string) field.ErrorList {
+	parts := strings.Split(host, "/")
+	if len(parts) != 2 {
+		return field.ErrorList{field.Invalid(path, host, "must be in the namespace/dnsName format")}
+	}
+
+	var errs field.ErrorList
+	switch namespace := parts[0]; namespace {
+	case EgressHostAnyNamespace, EgressHostSidecarNamespace, EgressHostNoNamespace:
+	default:
+		for _, msg := range k8svalidation.IsDNS1123Label(namespace) {
+			errs = append(errs, field.Invalid(path, host, msg))
+		}
+	}
+	for _, err := range validation.Host(path, parts[1]) {
+		err.BadValue = host
+		errs = append(errs, err)
+	}
+
+	return errs
+}
+
+// validateDefaultEndpoint checks that the endpoint is in the 127.0.0.1:PORT
+// or the unix:///path/to/socket format.
+func validateDefaultEndpoint(path *field.Path, endpoint string) field.ErrorList {
+	if endpoint == "" {
+		return field.ErrorList{field.Required(path, "defaultEndpoint is required")}
+	}
+	if strings.HasPrefix(endpoint, unixSocketPrefix) {
+		if len(endpoint) == len(unixSocketPrefix) {
+			return field.ErrorList{field.Invalid(path, endpoint, "must contain the path of the socket")}
+		}
+		return nil
+	}
+
+	host, port, err := net.SplitHostPort(endpoint)
+	if err != nil || host != "127.0.0.1" {
+		return field.ErrorList{field.Invalid(path, endpoint, "must be in the 127.0.0.1:PORT or the unix:///path/to/socket format")}
+	}
+	number, err := strconv.ParseInt(port, 10, 32)
+	if err != nil {
+		return field.ErrorList{field.Invalid(path, endpoint, "must be in the 127.0.0.1:PORT or the unix:///path/to/socket format")}
+	}
+
+	return validation.Port(path, number)
+}
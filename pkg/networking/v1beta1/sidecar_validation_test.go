@@ -0,0 +1,108 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1beta1
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestSidecarValidate(t *testing.T) {
+	sidecar := &Sidecar{
+		Spec: SidecarSpec{
+			WorkloadSelector: &WorkloadSelector{Labels: map[string]string{"app": "ratings"}},
+			Ingress: []*IstioIngressListener{
+				{
+					Port:            &Port{Number: 9080, Protocol: "HTTP", Name: "somename"},
+					DefaultEndpoint: "127.0.0.1:8080",
+					CaptureMode:     CaptureModeNone,
+				},
+				{
+					Port:            &Port{Number: 9081, Protocol: "HTTP", Name: "uds"},
+					DefaultEndpoint: "unix:///var/run/someuds.sock",
+				},
+			},
+			Egress: []*IstioEgressListener{
+				{
+					Port:  &Port{Number: 0, Protocol: "HTTP"},
+					Bind:  "unix:///var/run/someuds.sock",
+					Hosts: []string{"*/*.example.com"},
+				},
+				{
+					Hosts: []string{"./*", "~/*", "istio-system/istio-telemetry.istio-system.svc.cluster.local"},
+				},
+			},
+		},
+	}
+	if errs := sidecar.Validate(); len(errs) > 0 {
+		t.Fatalf("unexpected errors %v", errs)
+	}
+
+	sidecar.Spec.Ingress[0].DefaultEndpoint = "localhost:8080"
+	sidecar.Spec.Ingress[1].Port.Number = 9080
+	sidecar.Spec.Ingress[1].Bind = "unix:///var/run/ingress.sock"
+	sidecar.Spec.Egress[0].CaptureMode = CaptureModeIPTables
+	sidecar.Spec.Egress[0].Port.Number = 9090
+	sidecar.Spec.Egress[1].Hosts = append(sidecar.Spec.Egress[1].Hosts, "example.com", "Prod/*")
+	sidecar.Spec.Egress = append(sidecar.Spec.Egress, &IstioEgressListener{
+		Port:        &Port{Number: 8080, Protocol: "SMTP"},
+		Bind:        "0.0.0.0",
+		CaptureMode: "REDIRECT",
+		Hosts:       []string{"*/*"},
+	})
+
+	expected := []string{
+		"spec.ingress[0].defaultEndpoint",
+		"spec.ingress[1].bind",
+		"spec.ingress[1].port.number",
+		"spec.egress[0].port.number",
+		"spec.egress[0].captureMode",
+		"spec.egress[1].hosts[3]",
+		"spec.egress[1].hosts[4]",
+		"spec.egress[1].port",
+		"spec.egress[2].captureMode",
+		"spec.egress[2].port.protocol",
+	}
+	errs := sidecar.Validate()
+	if len(errs) != len(expected) {
+		t.Fatalf("unexpected errors %v", errs)
+	}
+	for i, err := range errs {
+		if err.Field != expected[i] {
+			t.Errorf("unexpected error %v, expected one for %s", err, expected[i])
+		}
+	}
+}
+
+func TestValidateSidecars(t *testing.T) {
+	sidecars := []*Sidecar{
+		{ObjectMeta: metav1.ObjectMeta{Name: "default", Namespace: "prod"}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "default", Namespace: "test"}},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "ratings", Namespace: "prod"},
+			Spec:       SidecarSpec{WorkloadSelector: &WorkloadSelector{Labels: map[string]string{"app": "ratings"}}},
+		},
+	}
+	if errs := ValidateSidecars(sidecars); len(errs) > 0 {
+		t.Fatalf("unexpected errors %v", errs)
+	}
+
+	sidecars = append(sidecars, &Sidecar{ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: "prod"}})
+	errs := ValidateSidecars(sidecars)
+	if len(errs) != 1 || errs[0].Field != "spec.workloadSelector" {
+		t.Fatalf("expected the second sidecar without a workload selector to be reported, got %v", errs)
+	}
+}
@@ -0,0 +1,38 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1beta1
+
+import (
+	"reflect"
+	"testing"
+
+	metav1alpha1 "github.com/banzaicloud/istio-client-go/pkg/meta/v1alpha1"
+)
+
+func TestStatusConditions(t *testing.T) {
+	var accessor metav1alpha1.ConditionsAccessor = &VirtualService{}
+	if conditions := accessor.GetStatusConditions(); conditions != nil {
+		t.Errorf("unexpected conditions %v without a status", conditions)
+	}
+
+	conditions := []*metav1alpha1.IstioCondition{{Type: metav1alpha1.ConditionReconciled, Status: metav1alpha1.ConditionStatusTrue}}
+	accessor.SetStatusConditions(conditions)
+	if !reflect.DeepEqual(accessor.GetStatusConditions(), conditions) {
+		t.Errorf("unexpected conditions %v", accessor.GetStatusConditions())
+	}
+	if !accessor.(*VirtualService).Status.IsReady() {
+		t.Error("expected the virtual service to be ready")
+	}
+}
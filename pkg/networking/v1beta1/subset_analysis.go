@@ -0,0 +1,169 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1beta1
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	hostname "github.com/banzaicloud/istio-client-go/pkg/host"
+)
+
+// DefaultDomainSuffix is the domain suffix of the cluster, which Istio
+// appends to the short names of the hosts.
+const DefaultDomainSuffix = hostname.DefaultDomainSuffix
+
+// SubsetIssueType is the type of the problems reported by AnalyzeSubsets.
+type SubsetIssueType string
+
+const (
+	// A route refers to a subset no destination rule of the host defines.
+	// Istio answers the requests on such routes with 503.
+	SubsetIssueUndefined SubsetIssueType = "UndefinedSubset"
+	// No route refers to a subset of a destination rule.
+	SubsetIssueUnused SubsetIssueType = "UnusedSubset"
+)
+
+// SubsetIssue is a problem reported by AnalyzeSubsets.
+type SubsetIssue struct {
+	Type SubsetIssueType
+	// The virtual service of an undefined subset, or the destination rule of
+	// an unused one, in the namespace/name format.
+	Resource string
+	// The path of the destination or of the subset in the resource.
+	Field string
+	// The fully qualified name of the host of the destination or of the
+	// destination rule.
+	Host   string
+	Subset string
+}
+
+func (i SubsetIssue) String() string {
+	switch i.Type {
+	case SubsetIssueUndefined:
+		return fmt.Sprintf("%s %s: subset %s of host %s is not defined by any destination rule", i.Resource, i.Field, i.Subset, i.Host)
+	case SubsetIssueUnused:
+		return fmt.Sprintf("%s %s: subset %s of host %s is not used by any virtual service", i.Resource, i.Field, i.Subset, i.Host)
+	default:
+		return fmt.Sprintf("%s %s: %s subset %s of host %s", i.Resource, i.Field, i.Type, i.Subset, i.Host)
+	}
+}
+
+// AnalyzeSubsets reports the routes of the virtual services referring to
+// subsets no destination rule defines, followed by the subsets of the
+// destination rules no route refers to. A subset is defined for a host by the
+// destination rules of the host, or of a wildcard host matching it, in any
+// namespace; the exportTo settings are not taken into account. Short host
+// names are resolved in the namespace of the resource, assuming the
+// DefaultDomainSuffix.
+func AnalyzeSubsets(virtualServices []*VirtualService, destinationRules []*DestinationRule) []SubsetIssue {
+	type definedSubset struct {
+		host string
+		name string
+		used bool
+	}
+
+	defined := make([][]definedSubset, len(destinationRules))
+	for i, dr := range destinationRules {
+		if dr == nil {
+			continue
+		}
+		host := hostname.Resolve(dr.Spec.Host, dr.Namespace)
+		for _, subset := range dr.Spec.Subsets {
+			defined[i] = append(defined[i], definedSubset{host: host, name: subset.Name})
+		}
+	}
+
+	var issues []SubsetIssue
+	for _, vs := range virtualServices {
+		if vs == nil {
+			continue
+		}
+		forEachDestination(&vs.Spec, func(path *field.Path, d *Destination) {
+			if d.Subset == nil || *d.Subset == "" {
+				return
+			}
+
+			host := hostname.Resolve(d.Host, vs.Namespace)
+			found := false
+			for i := range defined {
+				for j := range defined[i] {
+					subset := &defined[i][j]
+					if subset.name == *d.Subset && hostname.SubsetOf(host, subset.host) {
+						subset.used = true
+						found = true
+					}
+				}
+			}
+			if !found {
+				issues = append(issues, SubsetIssue{
+					Type:     SubsetIssueUndefined,
+					Resource: vs.Namespace + "/" + vs.Name,
+					Field:    path.String(),
+					Host:     host,
+					Subset:   *d.Subset,
+				})
+			}
+		})
+	}
+
+	for i, dr := range destinationRules {
+		for j, subset := range defined[i] {
+			if !subset.used {
+				issues = append(issues, SubsetIssue{
+					Type:     SubsetIssueUnused,
+					Resource: dr.Namespace + "/" + dr.Name,
+					Field:    field.NewPath("spec", "subsets").Index(j).String(),
+					Host:     subset.host,
+					Subset:   subset.name,
+				})
+			}
+		}
+	}
+
+	return issues
+}
+
+// forEachDestination calls fn with the destinations of the routes and the
+// mirrors of the spec.
+func forEachDestination(s *VirtualServiceSpec, fn func(path *field.Path, d *Destination)) {
+	path := field.NewPath("spec")
+	for i, route := range s.HTTP {
+		routePath := path.Child("http").Index(i)
+		for j, destination := range route.Route {
+			if destination != nil && destination.Destination != nil {
+				fn(routePath.Child("route").Index(j).Child("destination"), destination.Destination)
+			}
+		}
+		if route.Mirror != nil {
+			fn(routePath.Child("mirror"), route.Mirror)
+		}
+	}
+	for i, route := range s.TLS {
+		for j, destination := range route.Route {
+			if destination != nil && destination.Destination != nil {
+				fn(path.Child("tls").Index(i).Child("route").Index(j).Child("destination"), destination.Destination)
+			}
+		}
+	}
+	for i, route := range s.TCP {
+		for j, destination := range route.Route {
+			if destination != nil && destination.Destination != nil {
+				fn(path.Child("tcp").Index(i).Child("route").Index(j).Child("destination"), destination.Destination)
+			}
+		}
+	}
+}
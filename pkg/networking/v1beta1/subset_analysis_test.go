@@ -0,0 +1,83 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1beta1
+
+import (
+	"reflect"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestAnalyzeSubsets(t *testing.T) {
+	subset := func(name string) *string { return &name }
+
+	virtualServices := []*VirtualService{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "reviews", Namespace: "prod"},
+			Spec: VirtualServiceSpec{
+				Hosts: []string{"reviews"},
+				HTTP: []HTTPRoute{
+					{
+						Route: []*HTTPRouteDestination{
+							{Destination: &Destination{Host: "reviews", Subset: subset("v1")}},
+							{Destination: &Destination{Host: "reviews.prod.svc.cluster.local", Subset: subset("v3")}},
+						},
+						Mirror: &Destination{Host: "ratings.example.com", Subset: subset("canary")},
+					},
+				},
+				TCP: []TCPRoute{
+					{Route: []*RouteDestination{{Destination: &Destination{Host: "reviews"}}}},
+				},
+			},
+		},
+	}
+	destinationRules := []*DestinationRule{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "reviews", Namespace: "prod"},
+			Spec: DestinationRuleSpec{
+				Host:    "reviews",
+				Subsets: []Subset{{Name: "v1"}, {Name: "v2"}},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "example", Namespace: "istio-system"},
+			Spec: DestinationRuleSpec{
+				Host:    "*.example.com",
+				Subsets: []Subset{{Name: "canary"}},
+			},
+		},
+	}
+
+	expected := []SubsetIssue{
+		{
+			Type:     SubsetIssueUndefined,
+			Resource: "prod/reviews",
+			Field:    "spec.http[0].route[1].destination",
+			Host:     "reviews.prod.svc.cluster.local",
+			Subset:   "v3",
+		},
+		{
+			Type:     SubsetIssueUnused,
+			Resource: "prod/reviews",
+			Field:    "spec.subsets[1]",
+			Host:     "reviews.prod.svc.cluster.local",
+			Subset:   "v2",
+		},
+	}
+	if issues := AnalyzeSubsets(virtualServices, destinationRules); !reflect.DeepEqual(issues, expected) {
+		t.Fatalf("unexpected issues %v", issues)
+	}
+}
@@ -0,0 +1,75 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1beta1
+
+import (
+	"sort"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	hostname "github.com/banzaicloud/istio-client-go/pkg/host"
+)
+
+// SubsetReference is a subset of a host a virtual service routes or mirrors
+// traffic to.
+type SubsetReference struct {
+	// Host is the fully qualified name of the host, see host.Resolve.
+	Host string
+	// Subset is the name of the subset.
+	Subset string
+}
+
+// ReferencedSubsets returns the subsets the routes of the virtual service
+// send or mirror traffic to, sorted by host and subset. Destinations without
+// a subset are left out.
+func (vs *VirtualService) ReferencedSubsets() []SubsetReference {
+	seen := make(map[SubsetReference]bool)
+	var refs []SubsetReference
+	forEachDestination(&vs.Spec, func(_ *field.Path, d *Destination) {
+		if d.Subset == nil || *d.Subset == "" {
+			return
+		}
+
+		ref := SubsetReference{
+			Host:   strings.ToLower(hostname.Resolve(d.Host, vs.Namespace)),
+			Subset: *d.Subset,
+		}
+		if !seen[ref] {
+			seen[ref] = true
+			refs = append(refs, ref)
+		}
+	})
+
+	sort.Slice(refs, func(i, j int) bool {
+		if refs[i].Host != refs[j].Host {
+			return refs[i].Host < refs[j].Host
+		}
+		return refs[i].Subset < refs[j].Subset
+	})
+
+	return refs
+}
+
+// HasSubset returns true if the destination rule defines the subset.
+func (dr *DestinationRule) HasSubset(name string) bool {
+	for _, subset := range dr.Spec.Subsets {
+		if subset.Name == name {
+			return true
+		}
+	}
+
+	return false
+}
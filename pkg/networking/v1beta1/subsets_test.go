@@ -0,0 +1,58 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1beta1
+
+import (
+	"reflect"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestReferencedSubsets(t *testing.T) {
+	subset := func(name string) *string { return &name }
+	vs := &VirtualService{
+		ObjectMeta: metav1.ObjectMeta{Name: "reviews", Namespace: "prod"},
+		Spec: VirtualServiceSpec{
+			HTTP: []HTTPRoute{{
+				Route: []*HTTPRouteDestination{
+					{Destination: &Destination{Host: "reviews", Subset: subset("v2")}},
+					{Destination: &Destination{Host: "reviews.prod.svc.cluster.local", Subset: subset("v1")}},
+					{Destination: &Destination{Host: "ratings"}},
+				},
+				Mirror: &Destination{Host: "reviews", Subset: subset("v1")},
+			}},
+			TCP: []TCPRoute{{
+				Route: []*RouteDestination{{Destination: &Destination{Host: "mongo.backend.svc.cluster.local", Subset: subset("primary")}}},
+			}},
+		},
+	}
+
+	expected := []SubsetReference{
+		{Host: "mongo.backend.svc.cluster.local", Subset: "primary"},
+		{Host: "reviews.prod.svc.cluster.local", Subset: "v1"},
+		{Host: "reviews.prod.svc.cluster.local", Subset: "v2"},
+	}
+	if refs := vs.ReferencedSubsets(); !reflect.DeepEqual(refs, expected) {
+		t.Errorf("expected %v, got %v", expected, refs)
+	}
+}
+
+func TestHasSubset(t *testing.T) {
+	dr := &DestinationRule{Spec: DestinationRuleSpec{Host: "reviews", Subsets: []Subset{{Name: "v1"}, {Name: "v2"}}}}
+	if !dr.HasSubset("v2") || dr.HasSubset("v3") {
+		t.Error("unexpected subsets")
+	}
+}
@@ -0,0 +1,204 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1beta1
+
+import (
+	"github.com/banzaicloud/istio-client-go/pkg/common/v1alpha1"
+)
+
+// The deepcopy functions of the types below are not generated: they allocate
+// the items of their lists of pointers, and the values of their maps of
+// pointers, at once, rather than one by one, since virtual services with many
+// routes are copied on every reconciliation. The items of a copied list share
+// their backing array as a consequence.
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *HTTPMatchRequest) DeepCopyInto(out *HTTPMatchRequest) {
+	*out = *in
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.URI != nil {
+		in, out := &in.URI, &out.URI
+		*out = new(v1alpha1.StringMatch)
+		**out = **in
+	}
+	if in.Scheme != nil {
+		in, out := &in.Scheme, &out.Scheme
+		*out = new(v1alpha1.StringMatch)
+		**out = **in
+	}
+	if in.Method != nil {
+		in, out := &in.Method, &out.Method
+		*out = new(v1alpha1.StringMatch)
+		**out = **in
+	}
+	if in.Authority != nil {
+		in, out := &in.Authority, &out.Authority
+		*out = new(v1alpha1.StringMatch)
+		**out = **in
+	}
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make(map[string]v1alpha1.StringMatch, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Port != nil {
+		in, out := &in.Port, &out.Port
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.SourceLabels != nil {
+		in, out := &in.SourceLabels, &out.SourceLabels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Gateways != nil {
+		in, out := &in.Gateways, &out.Gateways
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.QueryParams != nil {
+		in, out := &in.QueryParams, &out.QueryParams
+		*out = make(map[string]*v1alpha1.StringMatch, len(*in))
+		vals := make([]v1alpha1.StringMatch, 0, len(*in))
+		for key, val := range *in {
+			if val == nil {
+				(*out)[key] = nil
+				continue
+			}
+			vals = append(vals, *val)
+			(*out)[key] = &vals[len(vals)-1]
+		}
+	}
+	if in.IgnoreURICase != nil {
+		in, out := &in.IgnoreURICase, &out.IgnoreURICase
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy copies the receiver, creating a new HTTPMatchRequest.
+func (in *HTTPMatchRequest) DeepCopy() *HTTPMatchRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPMatchRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *HTTPRoute) DeepCopyInto(out *HTTPRoute) {
+	*out = *in
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.Match != nil {
+		in, out := &in.Match, &out.Match
+		*out = make([]*HTTPMatchRequest, len(*in))
+		items := make([]HTTPMatchRequest, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				(*in)[i].DeepCopyInto(&items[i])
+				(*out)[i] = &items[i]
+			}
+		}
+	}
+	if in.Route != nil {
+		in, out := &in.Route, &out.Route
+		*out = make([]*HTTPRouteDestination, len(*in))
+		items := make([]HTTPRouteDestination, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				(*in)[i].DeepCopyInto(&items[i])
+				(*out)[i] = &items[i]
+			}
+		}
+	}
+	if in.Redirect != nil {
+		in, out := &in.Redirect, &out.Redirect
+		*out = new(HTTPRedirect)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Delegate != nil {
+		in, out := &in.Delegate, &out.Delegate
+		*out = new(Delegate)
+		**out = **in
+	}
+	if in.Rewrite != nil {
+		in, out := &in.Rewrite, &out.Rewrite
+		*out = new(HTTPRewrite)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(v1alpha1.Duration)
+		**out = **in
+	}
+	if in.Retries != nil {
+		in, out := &in.Retries, &out.Retries
+		*out = new(HTTPRetry)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Fault != nil {
+		in, out := &in.Fault, &out.Fault
+		*out = new(HTTPFaultInjection)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Mirror != nil {
+		in, out := &in.Mirror, &out.Mirror
+		*out = new(Destination)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MirrorPercent != nil {
+		in, out := &in.MirrorPercent, &out.MirrorPercent
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.MirrorPercentage != nil {
+		in, out := &in.MirrorPercentage, &out.MirrorPercentage
+		*out = new(Percentage)
+		**out = **in
+	}
+	if in.CorsPolicy != nil {
+		in, out := &in.CorsPolicy, &out.CorsPolicy
+		*out = new(CorsPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = new(Headers)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy copies the receiver, creating a new HTTPRoute.
+func (in *HTTPRoute) DeepCopy() *HTTPRoute {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPRoute)
+	in.DeepCopyInto(out)
+	return out
+}
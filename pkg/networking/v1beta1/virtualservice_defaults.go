@@ -0,0 +1,90 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1beta1
+
+// defaultWeight is the weight Istio assumes for the single destination of a
+// route.
+const defaultWeight = 100
+
+// Default sets the values Istio assumes for the unset fields of the virtual
+// service.
+func (vs *VirtualService) Default() {
+	vs.Spec.Default()
+}
+
+// Default sets the values Istio assumes for the unset fields of the spec: the
+// mesh gateway if there are no gateways, and a weight of 100 for the single
+// destination of a route. Delegates, the virtual services without hosts, get
+// no gateways, as they only apply through the routes delegating to them.
+func (s *VirtualServiceSpec) Default() {
+	if len(s.Gateways) == 0 && len(s.Hosts) > 0 {
+		s.Gateways = []string{MeshGateway}
+	}
+
+	for i := range s.HTTP {
+		if route := s.HTTP[i].Route; len(route) == 1 && route[0] != nil && route[0].Weight == nil {
+			route[0].Weight = int32Ptr(defaultWeight)
+		}
+	}
+	for i := range s.TLS {
+		defaultRouteDestinations(s.TLS[i].Route)
+	}
+	for i := range s.TCP {
+		defaultRouteDestinations(s.TCP[i].Route)
+	}
+}
+
+// MigrateDeprecatedFields implements v1alpha1.Migrator, moving the mirror
+// percentages of the HTTP routes, see MigrateMirrorPercent.
+func (vs *VirtualService) MigrateDeprecatedFields() bool {
+	return vs.Spec.MigrateMirrorPercent()
+}
+
+// MigrateMirrorPercent moves the deprecated integer mirror percentages of
+// the HTTP routes to their mirrorPercentage, see
+// HTTPRoute.MigrateMirrorPercent. It returns true if any route was changed.
+func (s *VirtualServiceSpec) MigrateMirrorPercent() bool {
+	migrated := false
+	for i := range s.HTTP {
+		migrated = s.HTTP[i].MigrateMirrorPercent() || migrated
+	}
+
+	return migrated
+}
+
+// MigrateMirrorPercent moves the deprecated integer mirror percentage of the
+// route to its mirrorPercentage, unless that is set already, which Istio
+// prefers. It returns true if the route had a mirror percentage.
+func (r *HTTPRoute) MigrateMirrorPercent() bool {
+	if r.MirrorPercent == nil {
+		return false
+	}
+	if r.MirrorPercentage == nil {
+		r.MirrorPercentage = &Percentage{Value: float64(*r.MirrorPercent)}
+	}
+	r.MirrorPercent = nil
+
+	return true
+}
+
+func defaultRouteDestinations(route []*RouteDestination) {
+	if len(route) == 1 && route[0] != nil && route[0].Weight == nil {
+		route[0].Weight = int32Ptr(defaultWeight)
+	}
+}
+
+func int32Ptr(i int32) *int32 {
+	return &i
+}
@@ -0,0 +1,73 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1beta1
+
+import (
+	"testing"
+
+	"github.com/banzaicloud/istio-client-go/pkg/common/v1alpha1"
+)
+
+func TestVirtualServiceDefault(t *testing.T) {
+	vs := &VirtualService{
+		Spec: VirtualServiceSpec{
+			Hosts: []string{"reviews"},
+			HTTP: []HTTPRoute{
+				{Route: []*HTTPRouteDestination{{Destination: &Destination{Host: "reviews"}}}},
+				{Route: []*HTTPRouteDestination{
+					{Destination: &Destination{Host: "reviews"}, Weight: int32Ptr(80)},
+					{Destination: &Destination{Host: "ratings"}, Weight: int32Ptr(20)},
+				}},
+			},
+			TCP: []TCPRoute{{Route: []*RouteDestination{{Destination: &Destination{Host: "reviews"}}}}},
+		},
+	}
+	vs.Default()
+
+	if len(vs.Spec.Gateways) != 1 || vs.Spec.Gateways[0] != MeshGateway {
+		t.Errorf("expected the mesh gateway, got %v", vs.Spec.Gateways)
+	}
+	if weight := vs.Spec.HTTP[0].Route[0].Weight; weight == nil || *weight != 100 {
+		t.Errorf("expected a weight of 100 for the single destination, got %v", weight)
+	}
+	if weight := vs.Spec.HTTP[1].Route[0].Weight; *weight != 80 {
+		t.Errorf("expected the weight to be kept, got %d", *weight)
+	}
+	if weight := vs.Spec.TCP[0].Route[0].Weight; weight == nil || *weight != 100 {
+		t.Errorf("expected a weight of 100 for the single destination, got %v", weight)
+	}
+	if errs := vs.Validate(); len(errs) > 0 {
+		t.Errorf("expected the defaulted virtual service to be valid, got %v", errs)
+	}
+}
+
+func TestVirtualServiceDefaultDelegate(t *testing.T) {
+	vs := &VirtualService{
+		Spec: VirtualServiceSpec{
+			HTTP: []HTTPRoute{{
+				Match: []*HTTPMatchRequest{{URI: &v1alpha1.StringMatch{Prefix: "/reviews"}}},
+				Route: []*HTTPRouteDestination{{Destination: &Destination{Host: "reviews"}}},
+			}},
+		},
+	}
+	vs.Default()
+
+	if len(vs.Spec.Gateways) != 0 {
+		t.Errorf("expected no gateways for a delegate, got %v", vs.Spec.Gateways)
+	}
+	if errs := vs.Validate(); len(errs) > 0 {
+		t.Errorf("expected the defaulted delegate to be valid, got %v", errs)
+	}
+}
@@ -0,0 +1,158 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1beta1
+
+import (
+	"encoding/json"
+
+	"github.com/banzaicloud/istio-client-go/pkg/common/v1alpha1"
+)
+
+// legacyHTTPRoute holds the fields of HTTPRoute that were removed from Istio,
+// or spelled differently by older manifests.
+type legacyHTTPRoute struct {
+	MirrorPercent         *uint32           `json:"mirror_percent,omitempty"`
+	AppendHeaders         map[string]string `json:"appendHeaders,omitempty"`
+	AppendRequestHeaders  map[string]string `json:"appendRequestHeaders,omitempty"`
+	RemoveRequestHeaders  []string          `json:"removeRequestHeaders,omitempty"`
+	AppendResponseHeaders map[string]string `json:"appendResponseHeaders,omitempty"`
+	RemoveResponseHeaders []string          `json:"removeResponseHeaders,omitempty"`
+}
+
+// DeprecatedFields implements v1alpha1.DeprecatedFields.
+func (*HTTPRoute) DeprecatedFields() map[string]string {
+	return map[string]string{
+		"mirrorPercent":         "mirrorPercentage",
+		"mirror_percent":        "mirrorPercentage",
+		"appendHeaders":         "headers.request.add",
+		"appendRequestHeaders":  "headers.request.add",
+		"removeRequestHeaders":  "headers.request.remove",
+		"appendResponseHeaders": "headers.response.add",
+		"removeResponseHeaders": "headers.response.remove",
+	}
+}
+
+// UnmarshalJSON decodes the route, along with the removed header manipulation
+// fields of older manifests, which are merged into Headers, and the snake case
+// mirror_percent, which is decoded into MirrorPercent.
+func (r *HTTPRoute) UnmarshalJSON(data []byte) error {
+	type httpRoute HTTPRoute
+	route := struct {
+		*httpRoute
+		legacyHTTPRoute
+	}{httpRoute: (*httpRoute)(r)}
+	if err := json.Unmarshal(data, &route); err != nil {
+		return err
+	}
+
+	legacy := route.legacyHTTPRoute
+	if legacy.MirrorPercent != nil && r.MirrorPercent == nil {
+		r.MirrorPercent = legacy.MirrorPercent
+	}
+
+	if legacy.AppendHeaders != nil {
+		r.requestHeaders().Add = mergeHeaders(r.requestHeaders().Add, legacy.AppendHeaders)
+	}
+	if legacy.AppendRequestHeaders != nil {
+		r.requestHeaders().Add = mergeHeaders(r.requestHeaders().Add, legacy.AppendRequestHeaders)
+	}
+	if legacy.RemoveRequestHeaders != nil {
+		r.requestHeaders().Remove = append(r.requestHeaders().Remove, legacy.RemoveRequestHeaders...)
+	}
+	if legacy.AppendResponseHeaders != nil {
+		r.responseHeaders().Add = mergeHeaders(r.responseHeaders().Add, legacy.AppendResponseHeaders)
+	}
+	if legacy.RemoveResponseHeaders != nil {
+		r.responseHeaders().Remove = append(r.responseHeaders().Remove, legacy.RemoveResponseHeaders...)
+	}
+
+	return nil
+}
+
+func (r *HTTPRoute) requestHeaders() *HeaderOperations {
+	if r.Headers == nil {
+		r.Headers = &Headers{}
+	}
+	if r.Headers.Request == nil {
+		r.Headers.Request = &HeaderOperations{}
+	}
+
+	return r.Headers.Request
+}
+
+func (r *HTTPRoute) responseHeaders() *HeaderOperations {
+	if r.Headers == nil {
+		r.Headers = &Headers{}
+	}
+	if r.Headers.Response == nil {
+		r.Headers.Response = &HeaderOperations{}
+	}
+
+	return r.Headers.Response
+}
+
+// mergeHeaders adds the legacy headers to the current ones, which take
+// precedence.
+func mergeHeaders(headers, legacy map[string]string) map[string]string {
+	if headers == nil {
+		headers = make(map[string]string, len(legacy))
+	}
+	for name, value := range legacy {
+		if _, ok := headers[name]; !ok {
+			headers[name] = value
+		}
+	}
+
+	return headers
+}
+
+// DeprecatedFields implements v1alpha1.DeprecatedFields.
+func (*CorsPolicy) DeprecatedFields() map[string]string {
+	return map[string]string{"allowOrigin": "allowOrigins"}
+}
+
+// UnmarshalJSON decodes the policy, adding the origins of the deprecated
+// allowOrigin to AllowOrigins as exact matches, as Istio does.
+func (p *CorsPolicy) UnmarshalJSON(data []byte) error {
+	type corsPolicy CorsPolicy
+	if err := json.Unmarshal(data, (*corsPolicy)(p)); err != nil {
+		return err
+	}
+
+	if p.AllowOrigin != nil {
+		p.AllowOrigins = mergeOrigins(p.AllowOrigins, p.AllowOrigin)
+	}
+
+	return nil
+}
+
+// mergeOrigins adds the legacy origins to the current matches as exact
+// matches, unless they are empty or matched exactly already.
+func mergeOrigins(origins []*v1alpha1.StringMatch, legacy []string) []*v1alpha1.StringMatch {
+	exact := make(map[string]bool, len(origins))
+	for _, origin := range origins {
+		if origin != nil && origin.Exact != "" {
+			exact[origin.Exact] = true
+		}
+	}
+	for _, origin := range legacy {
+		if origin != "" && !exact[origin] {
+			exact[origin] = true
+			origins = append(origins, v1alpha1.Exact(origin))
+		}
+	}
+
+	return origins
+}
@@ -0,0 +1,98 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1beta1
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/banzaicloud/istio-client-go/pkg/common/v1alpha1"
+)
+
+func TestHTTPRouteLegacyFields(t *testing.T) {
+	route := &HTTPRoute{}
+	err := json.Unmarshal([]byte(`{
+		"mirror_percent": 50,
+		"appendHeaders": {"x-a": "legacy", "x-b": "b"},
+		"removeResponseHeaders": ["server"],
+		"headers": {"request": {"add": {"x-a": "a"}}},
+		"corsPolicy": {"allowOrigin": ["https://example.com", "https://example.org"], "allowOrigins": [{"exact": "https://example.org"}, {"prefix": "https://"}]}
+	}`), route)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := &HTTPRoute{
+		MirrorPercent: func(p uint32) *uint32 { return &p }(50),
+		Headers: &Headers{
+			Request:  &HeaderOperations{Add: map[string]string{"x-a": "a", "x-b": "b"}},
+			Response: &HeaderOperations{Remove: []string{"server"}},
+		},
+		CorsPolicy: &CorsPolicy{
+			AllowOrigin:  []string{"https://example.com", "https://example.org"},
+			AllowOrigins: []*v1alpha1.StringMatch{v1alpha1.Exact("https://example.org"), v1alpha1.Prefix("https://"), v1alpha1.Exact("https://example.com")},
+		},
+	}
+	if !reflect.DeepEqual(route, expected) {
+		t.Fatalf("unexpected route %+v", route)
+	}
+}
+
+func TestMigrateMirrorPercent(t *testing.T) {
+	vs := &VirtualService{}
+	if err := json.Unmarshal([]byte(`{"spec": {"http": [
+		{"mirror_percent": 50},
+		{"mirrorPercent": 20, "mirrorPercentage": {"value": 12.5}}
+	]}}`), vs); err != nil {
+		t.Fatal(err)
+	}
+	if !vs.MigrateDeprecatedFields() {
+		t.Error("expected the mirror percentages to be migrated")
+	}
+
+	expected := []HTTPRoute{
+		{MirrorPercentage: &Percentage{Value: 50}},
+		{MirrorPercentage: &Percentage{Value: 12.5}},
+	}
+	if !reflect.DeepEqual(vs.Spec.HTTP, expected) {
+		t.Fatalf("unexpected routes %+v", vs.Spec.HTTP)
+	}
+	if vs.Spec.MigrateMirrorPercent() {
+		t.Error("expected nothing left to migrate")
+	}
+}
+
+// BenchmarkVirtualServiceUnmarshalJSON measures the decoding of virtual
+// services, with the hand written decoders if run with -tags fastjson.
+func BenchmarkVirtualServiceUnmarshalJSON(b *testing.B) {
+	vs := largeVirtualService()
+	for i := range vs.Spec.HTTP {
+		vs.Spec.HTTP[i].Timeout = v1alpha1.NewDuration(5 * time.Second)
+	}
+	data, err := json.Marshal(vs)
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	b.SetBytes(int64(len(data)))
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if err := json.Unmarshal(data, &VirtualService{}); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -18,10 +18,17 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/banzaicloud/istio-client-go/pkg/common/v1alpha1"
+
+	metav1alpha1 "github.com/banzaicloud/istio-client-go/pkg/meta/v1alpha1"
 )
 
 // +genclient
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:shortName=vs,categories=istio-io;networking-istio-io
+// +kubebuilder:printcolumn:name="Gateways",type="string",JSONPath=".spec.gateways",description="The names of gateways and sidecars that should apply these routes"
+// +kubebuilder:printcolumn:name="Hosts",type="string",JSONPath=".spec.hosts",description="The destination hosts to which traffic is being sent"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp",description="CreationTimestamp is a timestamp representing the server time when this object was created. It is not guaranteed to be set in happens-before order across separate operations. Clients may not set this value. It is represented in RFC3339 form and is in UTC. Populated by the system. Read-only. Null for lists. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#metadata"
 
 // Configuration affecting traffic routing. Here are a few terms useful to define
 // in the context of traffic routing.
@@ -117,7 +124,8 @@ type VirtualService struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
 
-	Spec VirtualServiceSpec `json:"spec"`
+	Spec   VirtualServiceSpec        `json:"spec"`
+	Status *metav1alpha1.IstioStatus `json:"status,omitempty"`
 }
 
 // Configuration affecting traffic routing.
@@ -149,7 +157,7 @@ type VirtualServiceSpec struct {
 	// the mesh, i.e., those found in the service registry, must always be
 	// referred to using their alphanumeric names. IP addresses are allowed
 	// only for services defined via the Gateway.
-	Hosts []string `json:"hosts"`
+	Hosts []string `json:"hosts,omitempty"`
 
 	// The names of gateways and sidecars that should apply these routes. A
 	// single VirtualService is used for sidecars inside the mesh as well as
@@ -206,6 +214,7 @@ type VirtualServiceSpec struct {
 
 // Describes match conditions and actions for routing HTTP/1.1, HTTP2, and
 // gRPC traffic. See VirtualService for usage examples.
+// +kubebuilder:object:generate=false
 type HTTPRoute struct {
 	// The name assigned to the route for debugging purposes. The
 	// route's name will be concatenated with the match's name and will
@@ -231,12 +240,26 @@ type HTTPRoute struct {
 	// send a HTTP 301 redirect to a different URI or Authority.
 	Redirect *HTTPRedirect `json:"redirect,omitempty"`
 
+	// Delegate is used to specify the particular VirtualService which
+	// can be used to define delegate HTTPRoute.
+	//
+	// It can be set only when `Route` and `Redirect` are empty, and the route
+	// rules of the delegate VirtualService will be merged with that in the
+	// current one.
+	//
+	// **NOTE**:
+	//
+	// 1. Only one level delegation is supported.
+	// 2. The delegate's HTTPMatchRequest must be a strict subset of the root's,
+	//    otherwise there is a conflict and the HTTPRoute will not take effect.
+	Delegate *Delegate `json:"delegate,omitempty"`
+
 	// Rewrite HTTP URIs and Authority headers. Rewrite cannot be used with
 	// Redirect primitive. Rewrite will be performed before forwarding.
 	Rewrite *HTTPRewrite `json:"rewrite,omitempty"`
 
 	// Timeout for HTTP requests.
-	Timeout *string `json:"timeout,omitempty"`
+	Timeout *v1alpha1.Duration `json:"timeout,omitempty"`
 
 	// Retry policy for HTTP requests.
 	Retries *HTTPRetry `json:"retries,omitempty"`
@@ -257,6 +280,7 @@ type HTTPRoute struct {
 	// Percentage of the traffic to be mirrored by the `mirror` field.
 	// Use of integer `mirror_percent` value is deprecated. Use the
 	// double `mirror_percentage` field instead
+	// +kubebuilder:validation:Maximum=100
 	MirrorPercent *uint32 `json:"mirrorPercent,omitempty"`
 
 	// Percentage of the traffic to be mirrored by the `mirror` field.
@@ -273,6 +297,74 @@ type HTTPRoute struct {
 	Headers *Headers `json:"headers,omitempty"`
 }
 
+// Describes the delegate VirtualService.
+// The following routing rules forward the traffic to `/productpage` by a delegate VirtualService named `productpage`,
+// forward the traffic to `/reviews` by a delegate VirtualService named `reviews`.
+//
+// ```yaml
+// apiVersion: networking.istio.io/v1beta1
+// kind: VirtualService
+// metadata:
+//   name: bookinfo
+// spec:
+//   hosts:
+//   - "bookinfo.com"
+//   gateways:
+//   - mygateway
+//   http:
+//   - match:
+//     - uri:
+//         prefix: "/productpage"
+//     delegate:
+//       name: productpage
+//       namespace: nsA
+//   - match:
+//     - uri:
+//         prefix: "/reviews"
+//     delegate:
+//       name: reviews
+//       namespace: nsB
+// ```
+//
+// ```yaml
+// apiVersion: networking.istio.io/v1beta1
+// kind: VirtualService
+// metadata:
+//   name: productpage
+//   namespace: nsA
+// spec:
+//   http:
+//   - match:
+//     - uri:
+//         prefix: "/productpage/v1/"
+//     route:
+//     - destination:
+//         host: productpage-v1.nsA.svc.cluster.local
+//   - route:
+//     - destination:
+//         host: productpage.nsA.svc.cluster.local
+// ```
+//
+// ```yaml
+// apiVersion: networking.istio.io/v1beta1
+// kind: VirtualService
+// metadata:
+//   name: reviews
+//   namespace: nsB
+// spec:
+//   http:
+//   - route:
+//     - destination:
+//         host: reviews.nsB.svc.cluster.local
+// ```
+type Delegate struct {
+	// Name specifies the name of the delegate VirtualService.
+	Name string `json:"name,omitempty"`
+	// Namespace specifies the namespace where the delegate VirtualService resides.
+	// By default, it is same to the root's.
+	Namespace string `json:"namespace,omitempty"`
+}
+
 // Message headers can be manipulated when Envoy forwards requests to,
 // or responses from, a destination service. Header manipulation rules can
 // be specified for a specific route destination or for all destinations.
@@ -293,7 +385,7 @@ type HTTPRoute struct {
 //   - headers:
 //       request:
 //         set:
-//           test: true
+//           test: "true"
 //     route:
 //     - destination:
 //         host: reviews.prod.svc.cluster.local
@@ -359,6 +451,7 @@ type HeaderOperations struct {
 // ```
 //
 // HTTPMatchRequest CANNOT be empty.
+// +kubebuilder:object:generate=false
 type HTTPMatchRequest struct {
 	// The name assigned to a match. The match's name will be
 	// concatenated with the parent route's name and will be logged in
@@ -436,6 +529,11 @@ type HTTPMatchRequest struct {
 	// `mesh` for this field to be applicable.
 	SourceLabels map[string]string `json:"sourceLabels,omitempty"`
 
+	// Names of gateways where the rule should be applied. Gateway names
+	// in the top-level `gateways` field of the VirtualService (if any)
+	// are overridden. The gateway match is independent of sourceLabels.
+	Gateways []string `json:"gateways,omitempty"`
+
 	// Query parameters for matching.
 	//
 	// Ex:
@@ -532,7 +630,9 @@ type HTTPRouteDestination struct {
 	// version. (0-100). Sum of weights across destinations SHOULD BE == 100.
 	// If there is only one destination in a rule, the weight value is assumed to
 	// be 100.
-	Weight *int `json:"weight,omitempty"`
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	Weight *int32 `json:"weight,omitempty"`
 
 	// Header manipulation rules
 	Headers *Headers `json:"headers,omitempty"`
@@ -548,7 +648,9 @@ type RouteDestination struct {
 	// version. (0-100). Sum of weights across destinations SHOULD BE == 100.
 	// If there is only one destination in a rule, the weight value is assumed to
 	// be 100.
-	Weight *int `json:"weight,omitempty"`
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	Weight *int32 `json:"weight,omitempty"`
 }
 
 // Destination indicates the network addressable service to which the
@@ -710,6 +812,7 @@ type Destination struct {
 // matching or selection for final routing.
 type PortSelector struct {
 	// Valid port number
+	// +kubebuilder:validation:Maximum=65535
 	Number uint32 `json:"number"`
 }
 
@@ -739,10 +842,10 @@ type TCPRoute struct {
 	// activated. All conditions inside a single match block have AND
 	// semantics, while the list of match blocks have OR semantics. The rule
 	// is matched if any one of the match blocks succeed.
-	Match []L4MatchAttributes `json:"match"`
+	Match []L4MatchAttributes `json:"match,omitempty"`
 
 	// The destination to which the connection should be forwarded to.
-	Route []*RouteDestination `json:"route"`
+	Route []*RouteDestination `json:"route,omitempty"`
 }
 
 // Describes match conditions and actions for routing unterminated TLS
@@ -751,6 +854,7 @@ type TCPRoute struct {
 // services in the mesh based on the SNI value.
 //
 // ```yaml
+// apiVersion: networking.istio.io/v1beta1
 // kind: VirtualService
 // metadata:
 //   name: bookinfo-sni
@@ -783,7 +887,7 @@ type TLSRoute struct {
 	Match []TLSMatchAttributes `json:"match"`
 
 	// The destination to which the connection should be forwarded to.
-	Route []*RouteDestination `json:"route"`
+	Route []*RouteDestination `json:"route,omitempty"`
 }
 
 // L4 connection match attributes. Note that L4 connection matching support
@@ -796,7 +900,7 @@ type L4MatchAttributes struct {
 	// Specifies the port on the host that is being addressed. Many services
 	// only expose a single port or label ports with the protocols they support,
 	// in these cases it is not required to explicitly select the port.
-	Port *int `json:"port,omitempty"`
+	Port *uint32 `json:"port,omitempty"`
 
 	// One or more labels that constrain the applicability of a rule to
 	// workloads with the given labels. If the VirtualService has a list of
@@ -825,7 +929,7 @@ type TLSMatchAttributes struct {
 	// Specifies the port on the host that is being addressed. Many services
 	// only expose a single port or label ports with the protocols they support,
 	// in these cases it is not required to explicitly select the port.
-	Port *int `json:"port,omitempty"`
+	Port *uint32 `json:"port,omitempty"`
 
 	// One or more labels that constrain the applicability of a rule to
 	// workloads with the given labels. If the VirtualService has a list of
@@ -860,7 +964,6 @@ type TLSMatchAttributes struct {
 //     redirect:
 //       uri: /v1/bookRatings
 //       authority: newratings.default.svc.cluster.local
-//   ...
 // ```
 type HTTPRedirect struct {
 	// On a redirect, overwrite the Path portion of the URL with this
@@ -938,10 +1041,11 @@ type HTTPRetry struct {
 	// REQUIRED. Number of retries for a given request. The interval
 	// between retries will be determined automatically (25ms+). Actual
 	// number of retries attempted depends on the httpReqTimeout.
-	Attempts int `json:"attempts"`
+	// +kubebuilder:validation:Minimum=0
+	Attempts int32 `json:"attempts"`
 
 	// Timeout per retry attempt for a given request. format: 1h/1m/1s/1ms. MUST BE >=1ms.
-	PerTryTimeout string `json:"perTryTimeout"`
+	PerTryTimeout *v1alpha1.Duration `json:"perTryTimeout,omitempty"`
 
 	// Specifies the conditions under which retry takes place.
 	// One or more policies can be specified using a ‘,’ delimited list.
@@ -986,8 +1090,15 @@ type CorsPolicy struct {
 	// The list of origins that are allowed to perform CORS requests. The
 	// content will be serialized into the Access-Control-Allow-Origin
 	// header. Wildcard * will allow all origins.
+	// Deprecated: Use AllowOrigins instead.
 	AllowOrigin []string `json:"allowOrigin,omitempty"`
 
+	// String patterns that match allowed origins. An origin is allowed if
+	// any of the string matchers match. If a match is found, then the
+	// outgoing Access-Control-Allow-Origin would be set to the origin as
+	// provided by the client.
+	AllowOrigins []*v1alpha1.StringMatch `json:"allowOrigins,omitempty"`
+
 	// List of HTTP methods allowed to access the resource. The content will
 	// be serialized into the Access-Control-Allow-Methods header.
 	AllowMethods []string `json:"allowMethods,omitempty"`
@@ -1002,7 +1113,7 @@ type CorsPolicy struct {
 
 	// Specifies how long the results of a preflight request can be
 	// cached. Translates to the `Access-Control-Max-Age` header.
-	MaxAge *string `json:"maxAge,omitempty"`
+	MaxAge *v1alpha1.Duration `json:"maxAge,omitempty"`
 
 	// Indicates whether the caller is allowed to send the actual request
 	// (not the preflight) using credentials. Translates to
@@ -1062,7 +1173,7 @@ type HTTPFaultInjection struct {
 type Delay struct {
 	// REQUIRED. Add a fixed delay before forwarding the request. Format:
 	// 1h/1m/1s/1ms. MUST be >=1ms.
-	FixedDelay string `json:"fixedDelay"`
+	FixedDelay v1alpha1.Duration `json:"fixedDelay"`
 
 	// Percentage of requests on which the delay will be injected.
 	Percentage *Percentage `json:"percentage,omitempty"`
@@ -1098,7 +1209,9 @@ type Delay struct {
 // aborted.
 type Abort struct {
 	// REQUIRED. HTTP status code to use to abort the Http request.
-	HTTPStatus int `json:"httpStatus"`
+	// +kubebuilder:validation:Minimum=200
+	// +kubebuilder:validation:Maximum=599
+	HTTPStatus int32 `json:"httpStatus"`
 
 	// Percentage of requests on which the delay will be injected.
 	Percentage *Percentage `json:"percentage,omitempty"`
@@ -1106,7 +1219,9 @@ type Abort struct {
 
 // Percent specifies a percentage in the range of [0.0, 100.0].
 type Percentage struct {
-	Value float32 `json:"value"`
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	Value float64 `json:"value"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
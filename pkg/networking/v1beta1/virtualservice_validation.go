@@ -0,0 +1,318 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1beta1
+
+import (
+	"sort"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/banzaicloud/istio-client-go/pkg/common/v1alpha1"
+	hostname "github.com/banzaicloud/istio-client-go/pkg/host"
+	"github.com/banzaicloud/istio-client-go/pkg/validation"
+)
+
+// MeshGateway is the reserved gateway name of the sidecars of the mesh.
+const MeshGateway = "mesh"
+
+// Validate checks the virtual service the way Istio does before accepting it.
+func (vs *VirtualService) Validate() field.ErrorList {
+	return vs.Spec.Validate(field.NewPath("spec"))
+}
+
+// Validate checks the spec the way Istio does before accepting it.
+func (s *VirtualServiceSpec) Validate(path *field.Path) field.ErrorList {
+	var errs field.ErrorList
+
+	// the virtual services without hosts are delegates, whose HTTP routes
+	// are merged into those of the virtual services delegating to them
+	delegate := len(s.Hosts) == 0
+	for i, host := range s.Hosts {
+		errs = append(errs, validation.Host(path.Child("hosts").Index(i), host)...)
+	}
+	if delegate {
+		if len(s.Gateways) > 0 {
+			errs = append(errs, field.Forbidden(path.Child("gateways"), "a delegate virtual service must not have gateways"))
+		}
+		if len(s.TLS) > 0 {
+			errs = append(errs, field.Forbidden(path.Child("tls"), "a delegate virtual service must not have TLS routes"))
+		}
+		if len(s.TCP) > 0 {
+			errs = append(errs, field.Forbidden(path.Child("tcp"), "a delegate virtual service must not have TCP routes"))
+		}
+	}
+
+	for i, gateway := range s.Gateways {
+		errs = append(errs, validateGatewayName(path.Child("gateways").Index(i), gateway)...)
+	}
+
+	if len(s.HTTP) == 0 && len(s.TLS) == 0 && len(s.TCP) == 0 {
+		errs = append(errs, field.Required(path, "at least one of http, tls or tcp routes is required"))
+	}
+	for i := range s.HTTP {
+		errs = append(errs, s.HTTP[i].validate(path.Child("http").Index(i), delegate)...)
+	}
+	for i := range s.TLS {
+		errs = append(errs, s.TLS[i].validate(path.Child("tls").Index(i), s.Hosts)...)
+	}
+	for i := range s.TCP {
+		errs = append(errs, s.TCP[i].validate(path.Child("tcp").Index(i))...)
+	}
+
+	return errs
+}
+
+// validateGatewayName checks a gateway reference, which is either mesh, or
+// the name of a gateway, optionally prefixed with its namespace.
+func validateGatewayName(path *field.Path, gateway string) field.ErrorList {
+	if gateway == MeshGateway {
+		return nil
+	}
+
+	parts := strings.Split(gateway, "/")
+	if len(parts) > 2 {
+		return field.ErrorList{field.Invalid(path, gateway, "must be a gateway name, optionally prefixed with its namespace and a /")}
+	}
+
+	var errs field.ErrorList
+	for _, part := range parts {
+		if part == "" {
+			errs = append(errs, field.Invalid(path, gateway, "the namespace and the name of the gateway must not be empty"))
+			break
+		}
+	}
+
+	return errs
+}
+
+func (r *HTTPRoute) validate(path *field.Path, delegate bool) field.ErrorList {
+	var errs field.ErrorList
+
+	for i, match := range r.Match {
+		errs = append(errs, match.validate(path.Child("match").Index(i))...)
+	}
+
+	switch {
+	case r.Delegate != nil && delegate:
+		errs = append(errs, field.Forbidden(path.Child("delegate"), "a delegate virtual service cannot delegate further"))
+	case r.Delegate != nil && r.Delegate.Name == "":
+		errs = append(errs, field.Required(path.Child("delegate", "name"), "the name of the delegate virtual service is required"))
+	}
+
+	switch {
+	case r.Delegate != nil && len(r.Route) > 0:
+		errs = append(errs, field.Forbidden(path.Child("route"), "a route cannot both delegate and route to destinations"))
+	case r.Delegate != nil && r.Redirect != nil:
+		errs = append(errs, field.Forbidden(path.Child("redirect"), "a route cannot both delegate and redirect"))
+	case r.Delegate != nil:
+	case r.Redirect != nil && len(r.Route) > 0:
+		errs = append(errs, field.Forbidden(path.Child("redirect"), "a route cannot both redirect and route to destinations"))
+	case r.Redirect != nil && r.Rewrite != nil:
+		errs = append(errs, field.Forbidden(path.Child("rewrite"), "a route cannot both redirect and rewrite"))
+	case r.Redirect == nil && len(r.Route) == 0:
+		errs = append(errs, field.Required(path.Child("route"), "a route must either redirect or route to destinations"))
+	}
+
+	weights := make([]*int32, 0, len(r.Route))
+	for i, destination := range r.Route {
+		destinationPath := path.Child("route").Index(i)
+		if destination == nil {
+			errs = append(errs, field.Required(destinationPath, "destination must not be empty"))
+			continue
+		}
+		errs = append(errs, destination.Destination.validate(destinationPath.Child("destination"))...)
+		weights = append(weights, destination.Weight)
+	}
+	errs = append(errs, validation.Weights(path.Child("route"), weights)...)
+
+	if r.Redirect != nil {
+		if r.Redirect.URI == nil && r.Redirect.Authority == nil {
+			errs = append(errs, field.Required(path.Child("redirect"), "a redirect must set the uri or the authority"))
+		}
+	}
+	if r.Rewrite != nil {
+		if r.Rewrite.URI == nil && r.Rewrite.Authority == nil {
+			errs = append(errs, field.Required(path.Child("rewrite"), "a rewrite must set the uri or the authority"))
+		}
+	}
+
+	if r.Timeout != nil {
+		errs = append(errs, validation.Duration(path.Child("timeout"), *r.Timeout)...)
+	}
+	if r.Retries != nil {
+		if r.Retries.Attempts < 0 {
+			errs = append(errs, field.Invalid(path.Child("retries", "attempts"), r.Retries.Attempts, "must not be negative"))
+		}
+		if r.Retries.PerTryTimeout != nil {
+			errs = append(errs, validation.Duration(path.Child("retries", "perTryTimeout"), *r.Retries.PerTryTimeout)...)
+		}
+	}
+	if r.Fault != nil {
+		errs = append(errs, r.Fault.validate(path.Child("fault"))...)
+	}
+
+	if r.Mirror != nil {
+		errs = append(errs, r.Mirror.validate(path.Child("mirror"))...)
+	}
+	if r.MirrorPercentage != nil {
+		errs = append(errs, validation.Percentage(path.Child("mirrorPercentage", "value"), r.MirrorPercentage.Value)...)
+	}
+
+	if r.CorsPolicy != nil {
+		if r.CorsPolicy.MaxAge != nil {
+			errs = append(errs, validation.Duration(path.Child("corsPolicy", "maxAge"), *r.CorsPolicy.MaxAge)...)
+		}
+		for i, origin := range r.CorsPolicy.AllowOrigins {
+			errs = append(errs, validation.StringMatch(path.Child("corsPolicy", "allowOrigins").Index(i), origin)...)
+		}
+	}
+
+	return errs
+}
+
+func (m *HTTPMatchRequest) validate(path *field.Path) field.ErrorList {
+	if m == nil || (m.URI == nil && m.Scheme == nil && m.Method == nil && m.Authority == nil &&
+		len(m.Headers) == 0 && m.Port == nil && len(m.SourceLabels) == 0 && len(m.QueryParams) == 0) {
+		return field.ErrorList{field.Required(path, "a match must have at least one condition")}
+	}
+
+	var errs field.ErrorList
+	errs = append(errs, validation.StringMatch(path.Child("uri"), m.URI)...)
+	errs = append(errs, validation.StringMatch(path.Child("scheme"), m.Scheme)...)
+	errs = append(errs, validation.StringMatch(path.Child("method"), m.Method)...)
+	errs = append(errs, validation.StringMatch(path.Child("authority"), m.Authority)...)
+	names := make([]string, 0, len(m.Headers))
+	for name := range m.Headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if name == "" {
+			errs = append(errs, field.Invalid(path.Child("headers"), name, "header name must not be empty"))
+		}
+		// an empty match only checks that the header is present
+		if match := m.Headers[name]; match != (v1alpha1.StringMatch{}) {
+			errs = append(errs, validation.StringMatch(path.Child("headers").Key(name), &match)...)
+		}
+	}
+	names = names[:0]
+	for name := range m.QueryParams {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		errs = append(errs, validation.StringMatch(path.Child("queryParams").Key(name), m.QueryParams[name])...)
+	}
+	if m.Port != nil {
+		errs = append(errs, validation.Port(path.Child("port"), int64(*m.Port))...)
+	}
+
+	return errs
+}
+
+func (d *Destination) validate(path *field.Path) field.ErrorList {
+	if d == nil {
+		return field.ErrorList{field.Required(path, "destination must not be empty")}
+	}
+
+	errs := validation.Host(path.Child("host"), d.Host)
+	if d.Port != nil {
+		errs = append(errs, validation.Port(path.Child("port", "number"), int64(d.Port.Number))...)
+	}
+
+	return errs
+}
+
+func (f *HTTPFaultInjection) validate(path *field.Path) field.ErrorList {
+	if f.Delay == nil && f.Abort == nil {
+		return field.ErrorList{field.Required(path, "a fault must delay or abort")}
+	}
+
+	var errs field.ErrorList
+	if f.Delay != nil {
+		errs = append(errs, validation.Duration(path.Child("delay", "fixedDelay"), f.Delay.FixedDelay)...)
+		if f.Delay.Percentage != nil {
+			errs = append(errs, validation.Percentage(path.Child("delay", "percentage", "value"), f.Delay.Percentage.Value)...)
+		}
+	}
+	if f.Abort != nil {
+		if f.Abort.HTTPStatus < 200 || f.Abort.HTTPStatus > 599 {
+			errs = append(errs, field.Invalid(path.Child("abort", "httpStatus"), f.Abort.HTTPStatus, "must be an HTTP status code between 200 and 599"))
+		}
+		if f.Abort.Percentage != nil {
+			errs = append(errs, validation.Percentage(path.Child("abort", "percentage", "value"), f.Abort.Percentage.Value)...)
+		}
+	}
+
+	return errs
+}
+
+func (r *TLSRoute) validate(path *field.Path, hosts []string) field.ErrorList {
+	var errs field.ErrorList
+
+	if len(r.Match) == 0 {
+		errs = append(errs, field.Required(path.Child("match"), "a TLS route must have at least one match"))
+	}
+	for i, match := range r.Match {
+		matchPath := path.Child("match").Index(i)
+		if len(match.SniHosts) == 0 {
+			errs = append(errs, field.Required(matchPath.Child("sniHosts"), "a TLS match must have at least one SNI host"))
+		}
+		for j, sniHost := range match.SniHosts {
+			sniHostPath := matchPath.Child("sniHosts").Index(j)
+			errs = append(errs, validation.Host(sniHostPath, sniHost)...)
+			if !hostname.SubsetOfAny(sniHost, hosts) {
+				errs = append(errs, field.Invalid(sniHostPath, sniHost, "the SNI host is not matched by any host of the virtual service"))
+			}
+		}
+		if match.Port != nil {
+			errs = append(errs, validation.Port(matchPath.Child("port"), int64(*match.Port))...)
+		}
+	}
+
+	return append(errs, validateRouteDestinations(path.Child("route"), r.Route)...)
+}
+
+func (r *TCPRoute) validate(path *field.Path) field.ErrorList {
+	var errs field.ErrorList
+
+	for i, match := range r.Match {
+		if match.Port != nil {
+			errs = append(errs, validation.Port(path.Child("match").Index(i).Child("port"), int64(*match.Port))...)
+		}
+	}
+
+	return append(errs, validateRouteDestinations(path.Child("route"), r.Route)...)
+}
+
+func validateRouteDestinations(path *field.Path, route []*RouteDestination) field.ErrorList {
+	if len(route) == 0 {
+		return field.ErrorList{field.Required(path, "at least one destination is required")}
+	}
+
+	var errs field.ErrorList
+	weights := make([]*int32, 0, len(route))
+	for i, destination := range route {
+		if destination == nil {
+			errs = append(errs, field.Required(path.Index(i), "destination must not be empty"))
+			continue
+		}
+		errs = append(errs, destination.Destination.validate(path.Index(i).Child("destination"))...)
+		weights = append(weights, destination.Weight)
+	}
+
+	return append(errs, validation.Weights(path, weights)...)
+}
@@ -0,0 +1,124 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1beta1
+
+import (
+	"testing"
+	"time"
+
+	"github.com/banzaicloud/istio-client-go/pkg/common/v1alpha1"
+)
+
+func TestVirtualServiceValidate(t *testing.T) {
+	weight := func(w int32) *int32 { return &w }
+	uri := "/v2"
+
+	vs := &VirtualService{
+		Spec: VirtualServiceSpec{
+			Hosts:    []string{"reviews.example.com", "*.example.org"},
+			Gateways: []string{"istio-system/ingress", "mesh"},
+			HTTP: []HTTPRoute{
+				{
+					Match: []*HTTPMatchRequest{{URI: v1alpha1.Prefix("/api")}},
+					Route: []*HTTPRouteDestination{
+						{Destination: &Destination{Host: "reviews"}, Weight: weight(80)},
+						{Destination: &Destination{Host: "reviews-canary"}, Weight: weight(20)},
+					},
+					Timeout: v1alpha1.NewDuration(5 * time.Second),
+				},
+			},
+			TLS: []TLSRoute{
+				{
+					Match: []TLSMatchAttributes{{SniHosts: []string{"a.example.org"}}},
+					Route: []*RouteDestination{{Destination: &Destination{Host: "reviews"}}},
+				},
+			},
+		},
+	}
+	if errs := vs.Validate(); len(errs) > 0 {
+		t.Fatalf("unexpected errors %v", errs)
+	}
+
+	vs.Spec.HTTP[0].Route[1].Weight = weight(30)
+	vs.Spec.HTTP[0].Match = append(vs.Spec.HTTP[0].Match, &HTTPMatchRequest{})
+	vs.Spec.HTTP[0].Redirect = &HTTPRedirect{URI: &uri}
+	vs.Spec.HTTP[0].Timeout = v1alpha1.NewDuration(0)
+	vs.Spec.TLS[0].Match[0].SniHosts = []string{"a.example.com"}
+
+	expected := []string{
+		"spec.http[0].match[1]",
+		"spec.http[0].redirect",
+		"spec.http[0].route",
+		"spec.http[0].timeout",
+		"spec.tls[0].match[0].sniHosts[0]",
+	}
+	errs := vs.Validate()
+	if len(errs) != len(expected) {
+		t.Fatalf("unexpected errors %v", errs)
+	}
+	for i, err := range errs {
+		if err.Field != expected[i] {
+			t.Errorf("unexpected error %v, expected one for %s", err, expected[i])
+		}
+	}
+}
+
+func TestVirtualServiceValidateDelegate(t *testing.T) {
+	root := &VirtualService{
+		Spec: VirtualServiceSpec{
+			Hosts: []string{"bookinfo.com"},
+			HTTP: []HTTPRoute{
+				{
+					Match:    []*HTTPMatchRequest{{URI: v1alpha1.Prefix("/productpage")}},
+					Delegate: &Delegate{Name: "productpage", Namespace: "nsA"},
+				},
+			},
+		},
+	}
+	if errs := root.Validate(); len(errs) > 0 {
+		t.Fatalf("unexpected errors %v", errs)
+	}
+	delegate := &VirtualService{
+		Spec: VirtualServiceSpec{
+			HTTP: []HTTPRoute{{Route: []*HTTPRouteDestination{{Destination: &Destination{Host: "productpage"}}}}},
+		},
+	}
+	if errs := delegate.Validate(); len(errs) > 0 {
+		t.Fatalf("unexpected errors of the delegate %v", errs)
+	}
+
+	root.Spec.HTTP[0].Delegate.Name = ""
+	root.Spec.HTTP[0].Route = delegate.Spec.HTTP[0].Route
+	delegate.Spec.Gateways = []string{"mesh"}
+	delegate.Spec.HTTP[0].Delegate = &Delegate{Name: "reviews"}
+
+	for _, test := range []struct {
+		vs       *VirtualService
+		expected []string
+	}{
+		{root, []string{"spec.http[0].delegate.name", "spec.http[0].route"}},
+		{delegate, []string{"spec.gateways", "spec.http[0].delegate", "spec.http[0].route"}},
+	} {
+		errs := test.vs.Validate()
+		if len(errs) != len(test.expected) {
+			t.Fatalf("unexpected errors %v", errs)
+		}
+		for i, err := range errs {
+			if err.Field != test.expected[i] {
+				t.Errorf("unexpected error %v, expected one for %s", err, test.expected[i])
+			}
+		}
+	}
+}
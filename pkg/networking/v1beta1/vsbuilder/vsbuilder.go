@@ -0,0 +1,229 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package vsbuilder builds v1beta1 virtual services without spelling out the
+// nested literals of their routes, e.g.
+//
+//	vs, err := vsbuilder.New("reviews").Namespace("prod").Host("reviews").
+//		HTTP(vsbuilder.Route().MatchPrefix("/api").To(
+//			vsbuilder.Destination("reviews", "v1").Weight(75),
+//			vsbuilder.Destination("reviews", "v2").Weight(25),
+//		)).
+//		Build()
+//
+// Build validates the virtual service, so the ones it returns are accepted by
+// Istio.
+package vsbuilder
+
+import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/banzaicloud/istio-client-go/pkg/common/v1alpha1"
+	"github.com/banzaicloud/istio-client-go/pkg/networking/v1beta1"
+)
+
+// Builder builds a virtual service.
+type Builder struct {
+	vs v1beta1.VirtualService
+}
+
+// New returns a builder of the virtual service with the name.
+func New(name string) *Builder {
+	return &Builder{
+		vs: v1beta1.VirtualService{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: v1beta1.VirtualServiceGroupVersionKind.GroupVersion().String(),
+				Kind:       v1beta1.VirtualServiceGroupVersionKind.Kind,
+			},
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+		},
+	}
+}
+
+// Namespace sets the namespace of the virtual service.
+func (b *Builder) Namespace(namespace string) *Builder {
+	b.vs.Namespace = namespace
+	return b
+}
+
+// Labels adds labels to the virtual service.
+func (b *Builder) Labels(labels map[string]string) *Builder {
+	if b.vs.Labels == nil {
+		b.vs.Labels = make(map[string]string, len(labels))
+	}
+	for key, value := range labels {
+		b.vs.Labels[key] = value
+	}
+	return b
+}
+
+// Host adds hosts to the virtual service.
+func (b *Builder) Host(hosts ...string) *Builder {
+	b.vs.Spec.Hosts = append(b.vs.Spec.Hosts, hosts...)
+	return b
+}
+
+// Gateway adds gateways to the virtual service.
+func (b *Builder) Gateway(gateways ...string) *Builder {
+	b.vs.Spec.Gateways = append(b.vs.Spec.Gateways, gateways...)
+	return b
+}
+
+// HTTP adds HTTP routes to the virtual service, which are evaluated in order.
+func (b *Builder) HTTP(routes ...*RouteBuilder) *Builder {
+	for _, route := range routes {
+		b.vs.Spec.HTTP = append(b.vs.Spec.HTTP, route.route)
+	}
+	return b
+}
+
+// Build returns the virtual service, or the errors of its validation as a
+// utilerrors.Aggregate.
+func (b *Builder) Build() (*v1beta1.VirtualService, error) {
+	vs := b.vs.DeepCopy()
+	if errs := vs.Validate(); len(errs) > 0 {
+		return nil, errs.ToAggregate()
+	}
+
+	return vs, nil
+}
+
+// RouteBuilder builds an HTTP route.
+type RouteBuilder struct {
+	route v1beta1.HTTPRoute
+}
+
+// Route returns a builder of an HTTP route.
+func Route() *RouteBuilder {
+	return &RouteBuilder{}
+}
+
+// Name sets the name of the route.
+func (r *RouteBuilder) Name(name string) *RouteBuilder {
+	r.route.Name = &name
+	return r
+}
+
+// MatchPrefix adds a match of the URIs with the prefix. The route is taken if
+// any of its matches match.
+func (r *RouteBuilder) MatchPrefix(prefix string) *RouteBuilder {
+	return r.Match(&v1beta1.HTTPMatchRequest{URI: v1alpha1.Prefix(prefix)})
+}
+
+// MatchExact adds a match of the URI. The route is taken if any of its
+// matches match.
+func (r *RouteBuilder) MatchExact(uri string) *RouteBuilder {
+	return r.Match(&v1beta1.HTTPMatchRequest{URI: v1alpha1.Exact(uri)})
+}
+
+// MatchRegex adds a match of the URIs with the RE2 regular expression. The
+// route is taken if any of its matches match.
+func (r *RouteBuilder) MatchRegex(regex string) *RouteBuilder {
+	return r.Match(&v1beta1.HTTPMatchRequest{URI: v1alpha1.Regex(regex)})
+}
+
+// Match adds matches of any kind. The route is taken if any of its matches
+// match.
+func (r *RouteBuilder) Match(matches ...*v1beta1.HTTPMatchRequest) *RouteBuilder {
+	r.route.Match = append(r.route.Match, matches...)
+	return r
+}
+
+// To adds destinations to the route. The weights of multiple destinations
+// must add up to 100.
+func (r *RouteBuilder) To(destinations ...*DestinationBuilder) *RouteBuilder {
+	for _, destination := range destinations {
+		r.route.Route = append(r.route.Route, &v1beta1.HTTPRouteDestination{
+			Destination: destination.destination(),
+			Weight:      destination.weight,
+		})
+	}
+	return r
+}
+
+// Mirror mirrors the traffic of the route to the destination. Its weight is
+// ignored.
+func (r *RouteBuilder) Mirror(destination *DestinationBuilder) *RouteBuilder {
+	r.route.Mirror = destination.destination()
+	return r
+}
+
+// Timeout sets the timeout of the requests of the route.
+func (r *RouteBuilder) Timeout(timeout time.Duration) *RouteBuilder {
+	r.route.Timeout = v1alpha1.NewDuration(timeout)
+	return r
+}
+
+// Retries sets the number of retries of the requests of the route and the
+// timeout of each try.
+func (r *RouteBuilder) Retries(attempts int, perTryTimeout time.Duration) *RouteBuilder {
+	r.route.Retries = &v1beta1.HTTPRetry{
+		Attempts:      int32(attempts),
+		PerTryTimeout: v1alpha1.NewDuration(perTryTimeout),
+	}
+	return r
+}
+
+// RewriteURI rewrites the matched part of the URI of the requests of the
+// route.
+func (r *RouteBuilder) RewriteURI(uri string) *RouteBuilder {
+	if r.route.Rewrite == nil {
+		r.route.Rewrite = &v1beta1.HTTPRewrite{}
+	}
+	r.route.Rewrite.URI = &uri
+	return r
+}
+
+// DestinationBuilder builds a destination of a route.
+type DestinationBuilder struct {
+	host   string
+	subset string
+	port   *uint32
+	weight *int32
+}
+
+// Destination returns a builder of a destination with the host and the subset
+// of a destination rule of the host. The subset is not set if it is empty.
+func Destination(host, subset string) *DestinationBuilder {
+	return &DestinationBuilder{host: host, subset: subset}
+}
+
+// Port sets the port of the host.
+func (d *DestinationBuilder) Port(number uint32) *DestinationBuilder {
+	d.port = &number
+	return d
+}
+
+// Weight sets the percentage of the traffic of the route sent to the
+// destination.
+func (d *DestinationBuilder) Weight(weight int) *DestinationBuilder {
+	w := int32(weight)
+	d.weight = &w
+	return d
+}
+
+func (d *DestinationBuilder) destination() *v1beta1.Destination {
+	destination := &v1beta1.Destination{Host: d.host}
+	if d.subset != "" {
+		subset := d.subset
+		destination.Subset = &subset
+	}
+	if d.port != nil {
+		destination.Port = &v1beta1.PortSelector{Number: *d.port}
+	}
+
+	return destination
+}
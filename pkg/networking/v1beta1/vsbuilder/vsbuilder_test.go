@@ -0,0 +1,62 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vsbuilder
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestBuild(t *testing.T) {
+	vs, err := New("reviews").Namespace("prod").Host("reviews").
+		HTTP(
+			Route().Name("api").MatchPrefix("/api").Timeout(5*time.Second).To(
+				Destination("reviews", "v1").Weight(75),
+				Destination("reviews", "v2").Weight(25),
+			),
+			Route().To(Destination("reviews", "v1").Port(9080)),
+		).
+		Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if vs.Kind != "VirtualService" || vs.APIVersion != "networking.istio.io/v1beta1" || vs.Namespace != "prod" {
+		t.Errorf("unexpected object meta %v %v", vs.TypeMeta, vs.ObjectMeta)
+	}
+	route := vs.Spec.HTTP[0]
+	if *route.Name != "api" || route.Match[0].URI.Prefix != "/api" || route.Timeout.Duration != 5*time.Second {
+		t.Errorf("unexpected route %+v", route)
+	}
+	if *route.Route[1].Destination.Subset != "v2" || *route.Route[1].Weight != 25 {
+		t.Errorf("unexpected destination %+v", route.Route[1])
+	}
+	if port := vs.Spec.HTTP[1].Route[0].Destination.Port; port == nil || port.Number != 9080 {
+		t.Errorf("unexpected port %v", port)
+	}
+}
+
+func TestBuildInvalid(t *testing.T) {
+	_, err := New("reviews").Host("reviews").
+		HTTP(Route().To(
+			Destination("reviews", "v1").Weight(75),
+			Destination("reviews", "v2").Weight(20),
+		)).
+		Build()
+	if err == nil || !strings.Contains(err.Error(), "spec.http[0].route") {
+		t.Fatalf("expected the weights to be invalid, got %v", err)
+	}
+}
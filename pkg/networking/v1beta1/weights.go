@@ -0,0 +1,129 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1beta1
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+)
+
+// NormalizeWeights scales the weights of the destinations of every route of
+// the virtual service so that they add up to 100, see
+// NormalizeHTTPRouteWeights. An error is returned if the weights of a route
+// cannot be scaled, in which case the virtual service is left unchanged.
+func NormalizeWeights(vs *VirtualService) error {
+	spec := vs.Spec.DeepCopy()
+	for i := range spec.HTTP {
+		if err := NormalizeHTTPRouteWeights(spec.HTTP[i].Route); err != nil {
+			return fmt.Errorf("http route %d: %w", i, err)
+		}
+	}
+	for i := range spec.TLS {
+		if err := NormalizeRouteWeights(spec.TLS[i].Route); err != nil {
+			return fmt.Errorf("tls route %d: %w", i, err)
+		}
+	}
+	for i := range spec.TCP {
+		if err := NormalizeRouteWeights(spec.TCP[i].Route); err != nil {
+			return fmt.Errorf("tcp route %d: %w", i, err)
+		}
+	}
+	vs.Spec = *spec
+
+	return nil
+}
+
+// NormalizeHTTPRouteWeights scales the weights of the destinations of the
+// route so that they add up to 100, keeping their proportions and rounding
+// them with the largest remainder method, e.g. 1 and 2 become 33 and 67.
+// Several destinations without weights receive no traffic, as in Istio, while
+// a single destination without a weight keeps receiving all of it. An error
+// is returned if a weight is negative or none of several destinations has a
+// weight, in which case the route is left unchanged.
+func NormalizeHTTPRouteWeights(route []*HTTPRouteDestination) error {
+	return normalizeWeights(httpRouteDestinations(route))
+}
+
+// NormalizeRouteWeights scales the weights of the destinations of the TLS or
+// TCP route the way NormalizeHTTPRouteWeights does.
+func NormalizeRouteWeights(route []*RouteDestination) error {
+	return normalizeWeights(routeDestinations(route))
+}
+
+func normalizeWeights(destinations []weightedDestination) error {
+	switch len(destinations) {
+	case 0:
+		return nil
+	case 1:
+		if *destinations[0].weight != nil {
+			w := int32(defaultWeight)
+			*destinations[0].weight = &w
+		}
+		return nil
+	}
+
+	weights := make([]int, len(destinations))
+	total := 0
+	for i, d := range destinations {
+		if *d.weight == nil {
+			continue
+		}
+		if **d.weight < 0 {
+			return fmt.Errorf("destination %d has the negative weight %d", i, **d.weight)
+		}
+		weights[i] = int(**d.weight)
+		total += weights[i]
+	}
+	if total == 0 {
+		return errors.New("none of the destinations has a weight, so no traffic can be routed")
+	}
+
+	for i, w := range scaleWeights(weights, defaultWeight) {
+		w := int32(w)
+		*destinations[i].weight = &w
+	}
+
+	return nil
+}
+
+// scaleWeights returns the weights scaled to add up to sum, rounded with the
+// largest remainder method. The weights must add up to more than zero.
+func scaleWeights(weights []int, sum int) []int {
+	total := 0
+	for _, w := range weights {
+		total += w
+	}
+
+	scaled := make([]int, len(weights))
+	remainders := make([]int, len(weights))
+	order := make([]int, len(weights))
+	assigned := 0
+	for i, w := range weights {
+		scaled[i] = sum * w / total
+		remainders[i] = sum * w % total
+		assigned += scaled[i]
+		order[i] = i
+	}
+	sort.SliceStable(order, func(a, b int) bool {
+		return remainders[order[a]] > remainders[order[b]]
+	})
+	for i := 0; assigned < sum; i++ {
+		scaled[order[i]]++
+		assigned++
+	}
+
+	return scaled
+}
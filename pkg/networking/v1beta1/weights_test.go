@@ -0,0 +1,60 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1beta1
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestNormalizeWeights(t *testing.T) {
+	vs := &VirtualService{
+		Spec: VirtualServiceSpec{
+			HTTP: []HTTPRoute{
+				{Route: []*HTTPRouteDestination{{Destination: &Destination{Host: "reviews"}}}},
+				{Route: []*HTTPRouteDestination{
+					{Destination: &Destination{Host: "reviews"}, Weight: int32Ptr(1)},
+					{Destination: &Destination{Host: "ratings"}, Weight: int32Ptr(2)},
+					{Destination: &Destination{Host: "details"}},
+				}},
+			},
+			TCP: []TCPRoute{{Route: []*RouteDestination{{Destination: &Destination{Host: "reviews"}, Weight: int32Ptr(30)}}}},
+		},
+	}
+	if err := NormalizeWeights(vs); err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	if weight := vs.Spec.HTTP[0].Route[0].Weight; weight != nil {
+		t.Errorf("expected the single destination to keep its implicit weight, got %d", *weight)
+	}
+	route := vs.Spec.HTTP[1].Route
+	if weights := []int32{*route[0].Weight, *route[1].Weight, *route[2].Weight}; !reflect.DeepEqual(weights, []int32{33, 67, 0}) {
+		t.Errorf("unexpected weights %v", weights)
+	}
+	if weight := *vs.Spec.TCP[0].Route[0].Weight; weight != 100 {
+		t.Errorf("expected the single destination to receive all the traffic, got %d", weight)
+	}
+
+	vs.Spec.HTTP[1].Route[0].Weight = int32Ptr(0)
+	vs.Spec.HTTP[1].Route[1].Weight = nil
+	before := vs.DeepCopy()
+	if err := NormalizeWeights(vs); err == nil {
+		t.Error("expected routes without weights to be rejected")
+	}
+	if !reflect.DeepEqual(vs, before) {
+		t.Error("expected the virtual service to be left unchanged")
+	}
+}
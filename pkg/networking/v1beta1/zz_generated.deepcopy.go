@@ -21,6 +21,7 @@ package v1beta1
 
 import (
 	"github.com/banzaicloud/istio-client-go/pkg/common/v1alpha1"
+	metav1alpha1 "github.com/banzaicloud/istio-client-go/pkg/meta/v1alpha1"
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -112,6 +113,17 @@ func (in *CorsPolicy) DeepCopyInto(out *CorsPolicy) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.AllowOrigins != nil {
+		in, out := &in.AllowOrigins, &out.AllowOrigins
+		*out = make([]*v1alpha1.StringMatch, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(v1alpha1.StringMatch)
+				**out = **in
+			}
+		}
+	}
 	if in.AllowMethods != nil {
 		in, out := &in.AllowMethods, &out.AllowMethods
 		*out = make([]string, len(*in))
@@ -129,7 +141,7 @@ func (in *CorsPolicy) DeepCopyInto(out *CorsPolicy) {
 	}
 	if in.MaxAge != nil {
 		in, out := &in.MaxAge, &out.MaxAge
-		*out = new(string)
+		*out = new(v1alpha1.Duration)
 		**out = **in
 	}
 	if in.AllowCredentials != nil {
@@ -169,6 +181,21 @@ func (in *Delay) DeepCopy() *Delay {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Delegate) DeepCopyInto(out *Delegate) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Delegate.
+func (in *Delegate) DeepCopy() *Delegate {
+	if in == nil {
+		return nil
+	}
+	out := new(Delegate)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Destination) DeepCopyInto(out *Destination) {
 	*out = *in
@@ -200,6 +227,11 @@ func (in *DestinationRule) DeepCopyInto(out *DestinationRule) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(metav1alpha1.IstioStatus)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DestinationRule.
@@ -290,6 +322,11 @@ func (in *Gateway) DeepCopyInto(out *Gateway) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(metav1alpha1.IstioStatus)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Gateway.
@@ -416,85 +453,6 @@ func (in *HTTPFaultInjection) DeepCopy() *HTTPFaultInjection {
 	return out
 }
 
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *HTTPMatchRequest) DeepCopyInto(out *HTTPMatchRequest) {
-	*out = *in
-	if in.Name != nil {
-		in, out := &in.Name, &out.Name
-		*out = new(string)
-		**out = **in
-	}
-	if in.URI != nil {
-		in, out := &in.URI, &out.URI
-		*out = new(v1alpha1.StringMatch)
-		**out = **in
-	}
-	if in.Scheme != nil {
-		in, out := &in.Scheme, &out.Scheme
-		*out = new(v1alpha1.StringMatch)
-		**out = **in
-	}
-	if in.Method != nil {
-		in, out := &in.Method, &out.Method
-		*out = new(v1alpha1.StringMatch)
-		**out = **in
-	}
-	if in.Authority != nil {
-		in, out := &in.Authority, &out.Authority
-		*out = new(v1alpha1.StringMatch)
-		**out = **in
-	}
-	if in.Headers != nil {
-		in, out := &in.Headers, &out.Headers
-		*out = make(map[string]v1alpha1.StringMatch, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
-	}
-	if in.Port != nil {
-		in, out := &in.Port, &out.Port
-		*out = new(uint32)
-		**out = **in
-	}
-	if in.SourceLabels != nil {
-		in, out := &in.SourceLabels, &out.SourceLabels
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
-	}
-	if in.QueryParams != nil {
-		in, out := &in.QueryParams, &out.QueryParams
-		*out = make(map[string]*v1alpha1.StringMatch, len(*in))
-		for key, val := range *in {
-			var outVal *v1alpha1.StringMatch
-			if val == nil {
-				(*out)[key] = nil
-			} else {
-				in, out := &val, &outVal
-				*out = new(v1alpha1.StringMatch)
-				**out = **in
-			}
-			(*out)[key] = outVal
-		}
-	}
-	if in.IgnoreURICase != nil {
-		in, out := &in.IgnoreURICase, &out.IgnoreURICase
-		*out = new(bool)
-		**out = **in
-	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPMatchRequest.
-func (in *HTTPMatchRequest) DeepCopy() *HTTPMatchRequest {
-	if in == nil {
-		return nil
-	}
-	out := new(HTTPMatchRequest)
-	in.DeepCopyInto(out)
-	return out
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HTTPRedirect) DeepCopyInto(out *HTTPRedirect) {
 	*out = *in
@@ -528,6 +486,11 @@ func (in *HTTPRedirect) DeepCopy() *HTTPRedirect {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HTTPRetry) DeepCopyInto(out *HTTPRetry) {
 	*out = *in
+	if in.PerTryTimeout != nil {
+		in, out := &in.PerTryTimeout, &out.PerTryTimeout
+		*out = new(v1alpha1.Duration)
+		**out = **in
+	}
 	if in.RetryOn != nil {
 		in, out := &in.RetryOn, &out.RetryOn
 		*out = new(string)
@@ -570,98 +533,6 @@ func (in *HTTPRewrite) DeepCopy() *HTTPRewrite {
 	return out
 }
 
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *HTTPRoute) DeepCopyInto(out *HTTPRoute) {
-	*out = *in
-	if in.Name != nil {
-		in, out := &in.Name, &out.Name
-		*out = new(string)
-		**out = **in
-	}
-	if in.Match != nil {
-		in, out := &in.Match, &out.Match
-		*out = make([]*HTTPMatchRequest, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(HTTPMatchRequest)
-				(*in).DeepCopyInto(*out)
-			}
-		}
-	}
-	if in.Route != nil {
-		in, out := &in.Route, &out.Route
-		*out = make([]*HTTPRouteDestination, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(HTTPRouteDestination)
-				(*in).DeepCopyInto(*out)
-			}
-		}
-	}
-	if in.Redirect != nil {
-		in, out := &in.Redirect, &out.Redirect
-		*out = new(HTTPRedirect)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Rewrite != nil {
-		in, out := &in.Rewrite, &out.Rewrite
-		*out = new(HTTPRewrite)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Timeout != nil {
-		in, out := &in.Timeout, &out.Timeout
-		*out = new(string)
-		**out = **in
-	}
-	if in.Retries != nil {
-		in, out := &in.Retries, &out.Retries
-		*out = new(HTTPRetry)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Fault != nil {
-		in, out := &in.Fault, &out.Fault
-		*out = new(HTTPFaultInjection)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Mirror != nil {
-		in, out := &in.Mirror, &out.Mirror
-		*out = new(Destination)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.MirrorPercent != nil {
-		in, out := &in.MirrorPercent, &out.MirrorPercent
-		*out = new(uint32)
-		**out = **in
-	}
-	if in.MirrorPercentage != nil {
-		in, out := &in.MirrorPercentage, &out.MirrorPercentage
-		*out = new(Percentage)
-		**out = **in
-	}
-	if in.CorsPolicy != nil {
-		in, out := &in.CorsPolicy, &out.CorsPolicy
-		*out = new(CorsPolicy)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Headers != nil {
-		in, out := &in.Headers, &out.Headers
-		*out = new(Headers)
-		(*in).DeepCopyInto(*out)
-	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPRoute.
-func (in *HTTPRoute) DeepCopy() *HTTPRoute {
-	if in == nil {
-		return nil
-	}
-	out := new(HTTPRoute)
-	in.DeepCopyInto(out)
-	return out
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HTTPRouteDestination) DeepCopyInto(out *HTTPRouteDestination) {
 	*out = *in
@@ -672,7 +543,7 @@ func (in *HTTPRouteDestination) DeepCopyInto(out *HTTPRouteDestination) {
 	}
 	if in.Weight != nil {
 		in, out := &in.Weight, &out.Weight
-		*out = new(int)
+		*out = new(int32)
 		**out = **in
 	}
 	if in.Headers != nil {
@@ -717,7 +588,7 @@ func (in *HTTPSettings) DeepCopyInto(out *HTTPSettings) {
 	}
 	if in.IdleTimeout != nil {
 		in, out := &in.IdleTimeout, &out.IdleTimeout
-		*out = new(string)
+		*out = new(v1alpha1.Duration)
 		**out = **in
 	}
 	if in.H2UpgradePolicy != nil {
@@ -851,7 +722,7 @@ func (in *L4MatchAttributes) DeepCopyInto(out *L4MatchAttributes) {
 	}
 	if in.Port != nil {
 		in, out := &in.Port, &out.Port
-		*out = new(int)
+		*out = new(uint32)
 		**out = **in
 	}
 	if in.SourceLabels != nil {
@@ -938,12 +809,12 @@ func (in *OutlierDetection) DeepCopyInto(out *OutlierDetection) {
 	}
 	if in.Interval != nil {
 		in, out := &in.Interval, &out.Interval
-		*out = new(string)
+		*out = new(v1alpha1.Duration)
 		**out = **in
 	}
 	if in.BaseEjectionTime != nil {
 		in, out := &in.BaseEjectionTime, &out.BaseEjectionTime
-		*out = new(string)
+		*out = new(v1alpha1.Duration)
 		**out = **in
 	}
 	if in.MaxEjectionPercent != nil {
@@ -983,21 +854,6 @@ func (in *Percentage) DeepCopy() *Percentage {
 	return out
 }
 
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Port) DeepCopyInto(out *Port) {
-	*out = *in
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Port.
-func (in *Port) DeepCopy() *Port {
-	if in == nil {
-		return nil
-	}
-	out := new(Port)
-	in.DeepCopyInto(out)
-	return out
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PortSelector) DeepCopyInto(out *PortSelector) {
 	*out = *in
@@ -1044,7 +900,7 @@ func (in *RouteDestination) DeepCopyInto(out *RouteDestination) {
 	}
 	if in.Weight != nil {
 		in, out := &in.Weight, &out.Weight
-		*out = new(int)
+		*out = new(int32)
 		**out = **in
 	}
 }
@@ -1100,6 +956,11 @@ func (in *ServiceEntry) DeepCopyInto(out *ServiceEntry) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(metav1alpha1.IstioStatus)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceEntry.
@@ -1256,6 +1117,11 @@ func (in *ServiceEntrySpec) DeepCopyInto(out *ServiceEntrySpec) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.WorkloadSelector != nil {
+		in, out := &in.WorkloadSelector, &out.WorkloadSelector
+		*out = new(WorkloadSelector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceEntrySpec.
@@ -1274,6 +1140,11 @@ func (in *Sidecar) DeepCopyInto(out *Sidecar) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(metav1alpha1.IstioStatus)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Sidecar.
@@ -1410,12 +1281,12 @@ func (in *TCPKeepalive) DeepCopyInto(out *TCPKeepalive) {
 	}
 	if in.Time != nil {
 		in, out := &in.Time, &out.Time
-		*out = new(string)
+		*out = new(v1alpha1.Duration)
 		**out = **in
 	}
 	if in.Interval != nil {
 		in, out := &in.Interval, &out.Interval
-		*out = new(string)
+		*out = new(v1alpha1.Duration)
 		**out = **in
 	}
 }
@@ -1473,7 +1344,7 @@ func (in *TCPSettings) DeepCopyInto(out *TCPSettings) {
 	}
 	if in.ConnectTimeout != nil {
 		in, out := &in.ConnectTimeout, &out.ConnectTimeout
-		*out = new(string)
+		*out = new(v1alpha1.Duration)
 		**out = **in
 	}
 	if in.TCPKeepalive != nil {
@@ -1508,7 +1379,7 @@ func (in *TLSMatchAttributes) DeepCopyInto(out *TLSMatchAttributes) {
 	}
 	if in.Port != nil {
 		in, out := &in.Port, &out.Port
-		*out = new(int)
+		*out = new(uint32)
 		**out = **in
 	}
 	if in.SourceLabels != nil {
@@ -1580,17 +1451,17 @@ func (in *TLSOptions) DeepCopyInto(out *TLSOptions) {
 	}
 	if in.MinProtocolVersion != nil {
 		in, out := &in.MinProtocolVersion, &out.MinProtocolVersion
-		*out = new(TLSProtocol)
+		*out = new(v1alpha1.TLSProtocol)
 		**out = **in
 	}
 	if in.MaxProtocolVersion != nil {
 		in, out := &in.MaxProtocolVersion, &out.MaxProtocolVersion
-		*out = new(TLSProtocol)
+		*out = new(v1alpha1.TLSProtocol)
 		**out = **in
 	}
 	if in.CipherSuites != nil {
 		in, out := &in.CipherSuites, &out.CipherSuites
-		*out = make([]string, len(*in))
+		*out = make([]v1alpha1.TLSCipherSuite, len(*in))
 		copy(*out, *in)
 	}
 }
@@ -1742,6 +1613,11 @@ func (in *VirtualService) DeepCopyInto(out *VirtualService) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(metav1alpha1.IstioStatus)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualService.
@@ -1851,6 +1727,11 @@ func (in *WorkloadEntry) DeepCopyInto(out *WorkloadEntry) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(metav1alpha1.IstioStatus)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkloadEntry.
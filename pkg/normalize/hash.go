@@ -0,0 +1,68 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package normalize
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// defaulter is implemented by the kinds setting the values Istio assumes
+// for their unset fields.
+type defaulter interface {
+	Default()
+}
+
+// normalizer is implemented by the kinds putting their specs into a
+// deterministic form.
+type normalizer interface {
+	Normalize()
+}
+
+// SpecHash returns a hash of the spec of the object, which is defaulted and
+// normalized first if the kind supports it, so that semantically identical
+// specs have the same hash. The metadata and the status of the object are
+// left out, which makes the hash suitable for an annotation detecting the
+// changes of the spec. The object is not modified.
+func SpecHash(obj runtime.Object) (string, error) {
+	obj = obj.DeepCopyObject()
+	if d, ok := obj.(defaulter); ok {
+		d.Default()
+	}
+	if n, ok := obj.(normalizer); ok {
+		n.Normalize()
+	}
+
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return "", err
+	}
+	var fields struct {
+		Spec json.RawMessage `json:"spec"`
+	}
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return "", err
+	}
+	if fields.Spec == nil {
+		return "", errors.New("the object has no spec")
+	}
+
+	sum := sha256.Sum256(fields.Spec)
+	return hex.EncodeToString(sum[:]), nil
+}
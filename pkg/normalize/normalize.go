@@ -0,0 +1,110 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package normalize holds the helpers shared by the Normalize methods of the
+// Istio kinds, which put semantically identical specs into the same form.
+package normalize
+
+import (
+	"encoding/json"
+	"reflect"
+	"sort"
+)
+
+// Strings sorts the strings in place. An empty slice is returned as nil.
+func Strings(s []string) []string {
+	if len(s) == 0 {
+		return nil
+	}
+	sort.Strings(s)
+
+	return s
+}
+
+// SortByJSON sorts the elements of the slice in place by their JSON
+// representation, for the lists of structs the order of which does not
+// matter. It panics if the argument is not a slice.
+func SortByJSON(slice interface{}) {
+	v := reflect.ValueOf(slice)
+	keys := make([]string, v.Len())
+	for i := range keys {
+		data, err := json.Marshal(v.Index(i).Interface())
+		if err != nil {
+			// the kinds are always encodable, keep the element in place
+			// if one is not
+			continue
+		}
+		keys[i] = string(data)
+	}
+
+	sort.Stable(byKey{keys: keys, swap: reflect.Swapper(slice)})
+}
+
+type byKey struct {
+	keys []string
+	swap func(i, j int)
+}
+
+func (s byKey) Len() int           { return len(s.keys) }
+func (s byKey) Less(i, j int) bool { return s.keys[i] < s.keys[j] }
+func (s byKey) Swap(i, j int) {
+	s.keys[i], s.keys[j] = s.keys[j], s.keys[i]
+	s.swap(i, j)
+}
+
+// StripEmpty replaces the empty slices and maps reachable from the pointer
+// with nil, so that they are left out of the JSON representation the same
+// way as the unset ones.
+func StripEmpty(ptr interface{}) {
+	stripEmpty(reflect.ValueOf(ptr))
+}
+
+func stripEmpty(v reflect.Value) {
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if !v.IsNil() {
+			stripEmpty(v.Elem())
+		}
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			if field := v.Field(i); field.CanSet() {
+				stripEmpty(field)
+			}
+		}
+	case reflect.Slice:
+		if v.Len() == 0 {
+			if !v.IsNil() && v.CanSet() {
+				v.Set(reflect.Zero(v.Type()))
+			}
+			return
+		}
+		for i := 0; i < v.Len(); i++ {
+			stripEmpty(v.Index(i))
+		}
+	case reflect.Map:
+		if v.Len() == 0 {
+			if !v.IsNil() && v.CanSet() {
+				v.Set(reflect.Zero(v.Type()))
+			}
+			return
+		}
+		// the values of maps are not addressable, only the ones behind
+		// pointers are stripped
+		for _, key := range v.MapKeys() {
+			if value := v.MapIndex(key); value.Kind() == reflect.Ptr {
+				stripEmpty(value)
+			}
+		}
+	}
+}
@@ -0,0 +1,106 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package patch builds the JSON patches of common edits of Istio resources,
+// so that controllers change only the fields they own instead of updating
+// whole objects and fighting the other writers of the resources, e.g.
+//
+//	p, err := patch.RouteWeights(vs, "reviews", 90, 10)
+//	data, err := p.Data()
+//	vs, err = client.NetworkingV1beta1().VirtualServices(vs.Namespace).Patch(ctx, vs.Name, types.JSONPatchType, data, metav1.PatchOptions{})
+//
+// The patches are built from the object as last seen, and test the values the
+// edit depends on, so they fail instead of applying the edit to an object
+// which has been changed in the meantime. Since both API versions of the
+// networking kinds share their schema, the patches of the v1beta1 types apply
+// to the v1alpha3 resources as well.
+package patch
+
+import (
+	"fmt"
+
+	"github.com/banzaicloud/istio-client-go/pkg/jsonpatch"
+	networkingv1beta1 "github.com/banzaicloud/istio-client-go/pkg/networking/v1beta1"
+	securityv1beta1 "github.com/banzaicloud/istio-client-go/pkg/security/v1beta1"
+)
+
+// RouteWeights returns the patch setting the weights of the destinations of
+// the HTTP route of the virtual service with the given name, in the order of
+// the destinations of the route. The patch fails unless the route is at the
+// same index and has the same destinations as in the virtual service.
+func RouteWeights(vs *networkingv1beta1.VirtualService, route string, weights ...int32) (jsonpatch.Patch, error) {
+	index := -1
+	for i, r := range vs.Spec.HTTP {
+		if r.Name != nil && *r.Name == route {
+			index = i
+			break
+		}
+	}
+	if index < 0 {
+		return nil, fmt.Errorf("HTTP route %q of virtual service %s/%s not found", route, vs.Namespace, vs.Name)
+	}
+	destinations := vs.Spec.HTTP[index].Route
+	if len(destinations) != len(weights) {
+		return nil, fmt.Errorf("HTTP route %q of virtual service %s/%s has %d destinations, got %d weights", route, vs.Namespace, vs.Name, len(destinations), len(weights))
+	}
+
+	p := jsonpatch.Patch{}.
+		Test(jsonpatch.Path("spec", "http", index, "name"), route).
+		Test(jsonpatch.Path("spec", "http", index, "route"), destinations)
+	for i, weight := range weights {
+		p = p.Add(jsonpatch.Path("spec", "http", index, "route", i, "weight"), weight)
+	}
+
+	return p, nil
+}
+
+// AppendGateway returns the patch appending the gateway to the gateways of
+// the virtual service, or an empty patch if the virtual service has it
+// already. The patch fails unless the gateways are the ones of the virtual
+// service, so that a gateway appended concurrently is not overwritten. If the
+// virtual service has no gateways, the patch tests that they are still absent
+// (a null value, which the API server's JSON patch implementation matches with
+// a missing field) or empty, and adds them as a whole.
+func AppendGateway(vs *networkingv1beta1.VirtualService, gateway string) jsonpatch.Patch {
+	for _, g := range vs.Spec.Gateways {
+		if g == gateway {
+			return nil
+		}
+	}
+
+	p := jsonpatch.Patch{}.Test(jsonpatch.Path("spec", "gateways"), vs.Spec.Gateways)
+	if len(vs.Spec.Gateways) == 0 {
+		return p.Add(jsonpatch.Path("spec", "gateways"), []string{gateway})
+	}
+
+	return p.Add(jsonpatch.Path("spec", "gateways", "-"), gateway)
+}
+
+// RemoveJWTRules returns the patch removing the JWT rules of the issuer from
+// the request authentication, or an empty patch if it has none. The patch
+// fails unless the rules removed are still at the same indexes.
+func RemoveJWTRules(ra *securityv1beta1.RequestAuthentication, issuer string) jsonpatch.Patch {
+	var p jsonpatch.Patch
+	// the rules are removed from the last one so that the indexes of the
+	// rules still to remove do not change
+	for i := len(ra.Spec.JwtRules) - 1; i >= 0; i-- {
+		if rule := ra.Spec.JwtRules[i]; rule != nil && rule.Issuer == issuer {
+			p = p.
+				Test(jsonpatch.Path("spec", "jwtRules", i, "issuer"), issuer).
+				Remove(jsonpatch.Path("spec", "jwtRules", i))
+		}
+	}
+
+	return p
+}
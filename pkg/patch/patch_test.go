@@ -0,0 +1,119 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package patch
+
+import (
+	"testing"
+
+	"github.com/banzaicloud/istio-client-go/pkg/jsonpatch"
+	networkingv1beta1 "github.com/banzaicloud/istio-client-go/pkg/networking/v1beta1"
+	securityv1beta1 "github.com/banzaicloud/istio-client-go/pkg/security/v1beta1"
+)
+
+func data(t *testing.T, p jsonpatch.Patch) string {
+	t.Helper()
+	d, err := p.Data()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return string(d)
+}
+
+func TestRouteWeights(t *testing.T) {
+	name := func(s string) *string { return &s }
+	vs := &networkingv1beta1.VirtualService{Spec: networkingv1beta1.VirtualServiceSpec{
+		HTTP: []networkingv1beta1.HTTPRoute{
+			{Name: name("ratings")},
+			{Name: name("reviews"), Route: []*networkingv1beta1.HTTPRouteDestination{
+				{Destination: &networkingv1beta1.Destination{Host: "reviews", Subset: name("v1")}},
+				{Destination: &networkingv1beta1.Destination{Host: "reviews", Subset: name("v2")}},
+			}},
+		},
+	}}
+
+	p, err := RouteWeights(vs, "reviews", 90, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := `[{"op":"test","path":"/spec/http/1/name","value":"reviews"},` +
+		`{"op":"test","path":"/spec/http/1/route","value":[{"destination":{"host":"reviews","subset":"v1"}},{"destination":{"host":"reviews","subset":"v2"}}]},` +
+		`{"op":"add","path":"/spec/http/1/route/0/weight","value":90},` +
+		`{"op":"add","path":"/spec/http/1/route/1/weight","value":10}]`
+	if d := data(t, p); d != expected {
+		t.Errorf("unexpected patch %s", d)
+	}
+
+	if _, err := RouteWeights(vs, "details", 100); err == nil {
+		t.Error("expected a missing route to be rejected")
+	}
+	if _, err := RouteWeights(vs, "reviews", 100); err == nil {
+		t.Error("expected missing weights to be rejected")
+	}
+}
+
+func TestAppendGateway(t *testing.T) {
+	tests := []struct {
+		name     string
+		gateways []string
+		expected string
+	}{
+		{
+			name:     "no gateways",
+			expected: `[{"op":"test","path":"/spec/gateways","value":null},{"op":"add","path":"/spec/gateways","value":["bookinfo"]}]`,
+		},
+		{
+			name:     "empty gateways",
+			gateways: []string{},
+			expected: `[{"op":"test","path":"/spec/gateways","value":[]},{"op":"add","path":"/spec/gateways","value":["bookinfo"]}]`,
+		},
+		{
+			name:     "gateways",
+			gateways: []string{"mesh"},
+			expected: `[{"op":"test","path":"/spec/gateways","value":["mesh"]},{"op":"add","path":"/spec/gateways/-","value":"bookinfo"}]`,
+		},
+		{
+			name:     "gateway already appended",
+			gateways: []string{"bookinfo", "mesh"},
+			expected: `[]`,
+		},
+	}
+
+	for _, test := range tests {
+		vs := &networkingv1beta1.VirtualService{Spec: networkingv1beta1.VirtualServiceSpec{Gateways: test.gateways}}
+		if d := data(t, AppendGateway(vs, "bookinfo")); d != test.expected {
+			t.Errorf("%s: unexpected patch %s", test.name, d)
+		}
+	}
+}
+
+func TestRemoveJWTRules(t *testing.T) {
+	ra := &securityv1beta1.RequestAuthentication{Spec: securityv1beta1.RequestAuthenticationSpec{
+		JwtRules: []*securityv1beta1.JWTRule{
+			{Issuer: "issuer-foo"},
+			{Issuer: "issuer-bar"},
+			{Issuer: "issuer-foo"},
+		},
+	}}
+
+	expected := `[{"op":"test","path":"/spec/jwtRules/2/issuer","value":"issuer-foo"},{"op":"remove","path":"/spec/jwtRules/2"},` +
+		`{"op":"test","path":"/spec/jwtRules/0/issuer","value":"issuer-foo"},{"op":"remove","path":"/spec/jwtRules/0"}]`
+	if d := data(t, RemoveJWTRules(ra, "issuer-foo")); d != expected {
+		t.Errorf("unexpected patch %s", d)
+	}
+	if d := data(t, RemoveJWTRules(ra, "issuer-baz")); d != `[]` {
+		t.Errorf("unexpected patch %s", d)
+	}
+}
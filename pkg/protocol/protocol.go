@@ -0,0 +1,112 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package protocol implements the rules Istio selects the protocol of a
+// service port with. The name of the port tells the protocol with its prefix
+// up to the first dash, e.g. http-web is an HTTP port and tcp is a TCP one.
+// Istio sniffs the protocol of the ports whose name tells none.
+package protocol
+
+import (
+	"strings"
+
+	"github.com/banzaicloud/istio-client-go/pkg/common/v1alpha1"
+)
+
+const (
+	// Unsupported is the protocol of the ports whose name tells no protocol,
+	// which Istio sniffs from the traffic.
+	Unsupported v1alpha1.PortProtocol = "UnsupportedProtocol"
+
+	// UDP is the protocol of UDP ports, which Istio does not proxy.
+	UDP v1alpha1.PortProtocol = "UDP"
+)
+
+const grpcWebPrefix = "grpc-web"
+
+// Parse returns the protocol named s, ignoring the case, or Unsupported if s
+// names none.
+func Parse(s string) v1alpha1.PortProtocol {
+	if strings.EqualFold(s, string(UDP)) {
+		return UDP
+	}
+	if protocol := v1alpha1.PortProtocol(s).Canonical(); protocol.Validate() == nil {
+		return protocol
+	}
+
+	return Unsupported
+}
+
+// Infer returns the protocol of the port named name, e.g. HTTP for http-web
+// and GRPC-Web for grpc-web-api, or Unsupported if the name tells none.
+func Infer(name string) v1alpha1.PortProtocol {
+	if strings.HasPrefix(strings.ToLower(name), grpcWebPrefix) {
+		return v1alpha1.ProtocolGRPCWeb
+	}
+	if i := strings.IndexByte(name, '-'); i >= 0 {
+		name = name[:i]
+	}
+
+	return Parse(name)
+}
+
+// IsHTTP returns true if the protocol is routed by the HTTP routes of
+// virtual services.
+func IsHTTP(p v1alpha1.PortProtocol) bool {
+	switch Parse(string(p)) {
+	case v1alpha1.ProtocolHTTP, v1alpha1.ProtocolHTTP2, v1alpha1.ProtocolGRPC, v1alpha1.ProtocolGRPCWeb:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsTLS returns true if the protocol is TLS, which the TLS routes of virtual
+// services route by the SNI.
+func IsTLS(p v1alpha1.PortProtocol) bool {
+	switch Parse(string(p)) {
+	case v1alpha1.ProtocolHTTPS, v1alpha1.ProtocolTLS:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsTCP returns true if the protocol is proxied as opaque TCP traffic, which
+// includes the TLS protocols. The TCP routes of virtual services route the
+// ports of the protocols which are neither HTTP nor TLS.
+func IsTCP(p v1alpha1.PortProtocol) bool {
+	switch Parse(string(p)) {
+	case v1alpha1.ProtocolTCP, v1alpha1.ProtocolHTTPS, v1alpha1.ProtocolTLS,
+		v1alpha1.ProtocolMongo, v1alpha1.ProtocolMySQL, v1alpha1.ProtocolRedis:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsGRPC returns true if the protocol is gRPC or gRPC-Web.
+func IsGRPC(p v1alpha1.PortProtocol) bool {
+	switch Parse(string(p)) {
+	case v1alpha1.ProtocolGRPC, v1alpha1.ProtocolGRPCWeb:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsUnsupported returns true if the protocol is sniffed by Istio.
+func IsUnsupported(p v1alpha1.PortProtocol) bool {
+	return Parse(string(p)) == Unsupported
+}
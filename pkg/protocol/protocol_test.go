@@ -0,0 +1,65 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package protocol
+
+import (
+	"testing"
+
+	"github.com/banzaicloud/istio-client-go/pkg/common/v1alpha1"
+)
+
+func TestInfer(t *testing.T) {
+	tests := map[string]v1alpha1.PortProtocol{
+		"http":          v1alpha1.ProtocolHTTP,
+		"http-web":      v1alpha1.ProtocolHTTP,
+		"HTTP2-api":     v1alpha1.ProtocolHTTP2,
+		"grpc":          v1alpha1.ProtocolGRPC,
+		"grpc-web":      v1alpha1.ProtocolGRPCWeb,
+		"grpc-web-api":  v1alpha1.ProtocolGRPCWeb,
+		"grpc-internal": v1alpha1.ProtocolGRPC,
+		"tls-passthru":  v1alpha1.ProtocolTLS,
+		"mongo":         v1alpha1.ProtocolMongo,
+		"udp-dns":       UDP,
+		"web":           Unsupported,
+		"webhttp":       Unsupported,
+		"":              Unsupported,
+	}
+	for name, expected := range tests {
+		if protocol := Infer(name); protocol != expected {
+			t.Errorf("expected %q to be %s, got %s", name, expected, protocol)
+		}
+	}
+}
+
+func TestRoutes(t *testing.T) {
+	tests := []struct {
+		protocol          v1alpha1.PortProtocol
+		http, tls, tcp    bool
+		grpc, unsupported bool
+	}{
+		{protocol: "http", http: true},
+		{protocol: v1alpha1.ProtocolGRPCWeb, http: true, grpc: true},
+		{protocol: v1alpha1.ProtocolHTTPS, tls: true, tcp: true},
+		{protocol: v1alpha1.ProtocolRedis, tcp: true},
+		{protocol: UDP},
+		{protocol: "smtp", unsupported: true},
+	}
+	for _, test := range tests {
+		if IsHTTP(test.protocol) != test.http || IsTLS(test.protocol) != test.tls || IsTCP(test.protocol) != test.tcp ||
+			IsGRPC(test.protocol) != test.grpc || IsUnsupported(test.protocol) != test.unsupported {
+			t.Errorf("unexpected classification of %s", test.protocol)
+		}
+	}
+}
@@ -0,0 +1,183 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package protoconv converts the specs of this client to and from the
+// protobuf messages of istio.io/api they model.
+//
+// The conversions go through the JSON mapping of protobuf. The messages of
+// istio.io/api are generated with gogo/protobuf, so any of them can be passed
+// without this module depending on istio.io/api.
+package protoconv
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/proto"
+)
+
+// ToProto converts in, a spec of this client, into out, the istio.io/api
+// message it models. Fields unknown to the message are an error.
+func ToProto(in interface{}, out proto.Message) error {
+	data, err := json.Marshal(in)
+	if err != nil {
+		return err
+	}
+
+	if err := jsonpb.Unmarshal(bytes.NewReader(data), out); err != nil {
+		return fmt.Errorf("could not convert %T to %T: %w", in, out, err)
+	}
+
+	return nil
+}
+
+// FromProto converts in, an istio.io/api message, into out, the spec of this
+// client that models it.
+func FromProto(in proto.Message, out interface{}) error {
+	data, err := (&jsonpb.Marshaler{}).MarshalToString(in)
+	if err != nil {
+		return err
+	}
+
+	if err := DecodeJSON([]byte(data), out); err != nil {
+		return fmt.Errorf("could not convert %T to %T: %w", in, out, err)
+	}
+
+	return nil
+}
+
+// Marshal converts in, a spec of this client, into message, the istio.io/api
+// message it models, and encodes that with the JSON mapping of protobuf. The
+// output is the same Istio's own tooling produces for the message.
+func Marshal(in interface{}, message proto.Message) ([]byte, error) {
+	if err := ToProto(in, message); err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	if err := (&jsonpb.Marshaler{}).Marshal(&buf, message); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// Unmarshal decodes data, the JSON mapping of message, the istio.io/api
+// message out models, and converts it into out. It accepts everything the
+// JSON mapping of protobuf does, e.g. the original snake case field names.
+func Unmarshal(data []byte, message proto.Message, out interface{}) error {
+	if err := jsonpb.Unmarshal(bytes.NewReader(data), message); err != nil {
+		return err
+	}
+
+	return FromProto(message, out)
+}
+
+// DecodeJSON decodes the JSON mapping of a protobuf message into out. Unlike
+// json.Unmarshal it accepts the 64 bit integers protobuf encodes as strings
+// for the numeric fields of out.
+func DecodeJSON(data []byte, out interface{}) error {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil {
+		return err
+	}
+
+	data, err := json.Marshal(unquoteNumbers(value, reflect.TypeOf(out)))
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(data, out)
+}
+
+// unquoteNumbers replaces the strings of the decoded JSON value that belong
+// to numeric fields of t with numbers.
+func unquoteNumbers(value interface{}, t reflect.Type) interface{} {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	switch v := value.(type) {
+	case string:
+		switch t.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+			reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+			reflect.Float32, reflect.Float64:
+			if _, err := json.Number(v).Float64(); err == nil {
+				return json.Number(v)
+			}
+		}
+	case []interface{}:
+		if t.Kind() == reflect.Slice || t.Kind() == reflect.Array {
+			for i := range v {
+				v[i] = unquoteNumbers(v[i], t.Elem())
+			}
+		}
+	case map[string]interface{}:
+		switch t.Kind() {
+		case reflect.Map:
+			for key := range v {
+				v[key] = unquoteNumbers(v[key], t.Elem())
+			}
+		case reflect.Struct:
+			fields := jsonFields(t)
+			for key := range v {
+				if field, ok := fields[key]; ok {
+					v[key] = unquoteNumbers(v[key], field)
+				}
+			}
+		}
+	}
+
+	return value
+}
+
+// jsonFields returns the types of the fields of a struct by their JSON name,
+// including the fields of the embedded structs.
+func jsonFields(t reflect.Type) map[string]reflect.Type {
+	fields := make(map[string]reflect.Type, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		name := strings.Split(field.Tag.Get("json"), ",")[0]
+		if name == "-" {
+			continue
+		}
+
+		fieldType := field.Type
+		for fieldType.Kind() == reflect.Ptr {
+			fieldType = fieldType.Elem()
+		}
+		if field.Anonymous && name == "" && fieldType.Kind() == reflect.Struct {
+			for embeddedName, embeddedType := range jsonFields(fieldType) {
+				if _, ok := fields[embeddedName]; !ok {
+					fields[embeddedName] = embeddedType
+				}
+			}
+			continue
+		}
+
+		if name == "" {
+			name = field.Name
+		}
+		fields[name] = field.Type
+	}
+
+	return fields
+}
@@ -0,0 +1,75 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package protoconv
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/gogo/protobuf/types"
+)
+
+func TestRoundTrip(t *testing.T) {
+	in := map[string]interface{}{"name": "reviews", "weight": float64(80)}
+
+	message := &types.Struct{}
+	if err := ToProto(in, message); err != nil {
+		t.Fatal(err)
+	}
+	if message.Fields["name"].GetStringValue() != "reviews" {
+		t.Fatalf("unexpected message %v", message)
+	}
+
+	out := map[string]interface{}{}
+	if err := FromProto(message, &out); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(in, out) {
+		t.Fatalf("round trip changed the value: %v != %v", out, in)
+	}
+}
+
+func TestDecodeJSON(t *testing.T) {
+	type status struct {
+		ObservedGeneration int64             `json:"observedGeneration"`
+		Labels             map[string]string `json:"labels"`
+	}
+
+	out := &status{}
+	if err := DecodeJSON([]byte(`{"observedGeneration":"3","labels":{"version":"1"}}`), out); err != nil {
+		t.Fatal(err)
+	}
+	if out.ObservedGeneration != 3 || out.Labels["version"] != "1" {
+		t.Fatalf("unexpected status %+v", out)
+	}
+}
+
+func TestMarshal(t *testing.T) {
+	data, err := Marshal(map[string]interface{}{"weight": 80, "name": "reviews"}, &types.Struct{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := `{"name":"reviews","weight":80}`; string(data) != expected {
+		t.Fatalf("unexpected output %s, expected %s", data, expected)
+	}
+
+	out := map[string]interface{}{}
+	if err := Unmarshal(data, &types.Struct{}, &out); err != nil {
+		t.Fatal(err)
+	}
+	if out["name"] != "reviews" || out["weight"] != float64(80) {
+		t.Fatalf("unexpected value %v", out)
+	}
+}
@@ -0,0 +1,114 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package registry enumerates every Istio kind modeled by this module, so
+// generic tools can iterate over them without hardcoding the list.
+package registry
+
+import (
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	authenticationv1alpha1 "github.com/banzaicloud/istio-client-go/pkg/authentication/v1alpha1"
+	networkingv1alpha3 "github.com/banzaicloud/istio-client-go/pkg/networking/v1alpha3"
+	networkingv1beta1 "github.com/banzaicloud/istio-client-go/pkg/networking/v1beta1"
+	securityv1beta1 "github.com/banzaicloud/istio-client-go/pkg/security/v1beta1"
+	telemetryv1alpha1 "github.com/banzaicloud/istio-client-go/pkg/telemetry/v1alpha1"
+)
+
+// Kind describes a kind modeled by this module.
+type Kind struct {
+	GroupVersionKind     schema.GroupVersionKind
+	GroupVersionResource schema.GroupVersionResource
+	// ListKind is the kind of the list type of the kind, e.g. VirtualServiceList
+	ListKind string
+	// Namespaced is false for cluster scoped kinds
+	Namespaced bool
+	// New returns an empty object of the kind
+	New func() runtime.Object
+	// NewList returns an empty list of the kind
+	NewList func() runtime.Object
+}
+
+// ListGroupVersionKind returns the GroupVersionKind of the list type of the kind.
+func (k Kind) ListGroupVersionKind() schema.GroupVersionKind {
+	return k.GroupVersionKind.GroupVersion().WithKind(k.ListKind)
+}
+
+var kinds = []Kind{
+	newKind(authenticationv1alpha1.MeshPolicyGroupVersionKind, authenticationv1alpha1.MeshPolicyGroupVersionResource, false, func() runtime.Object { return &authenticationv1alpha1.MeshPolicy{} }, func() runtime.Object { return &authenticationv1alpha1.MeshPolicyList{} }),
+	newKind(authenticationv1alpha1.PolicyGroupVersionKind, authenticationv1alpha1.PolicyGroupVersionResource, true, func() runtime.Object { return &authenticationv1alpha1.Policy{} }, func() runtime.Object { return &authenticationv1alpha1.PolicyList{} }),
+	newKind(networkingv1alpha3.DestinationRuleGroupVersionKind, networkingv1alpha3.DestinationRuleGroupVersionResource, true, func() runtime.Object { return &networkingv1alpha3.DestinationRule{} }, func() runtime.Object { return &networkingv1alpha3.DestinationRuleList{} }),
+	newKind(networkingv1alpha3.EnvoyFilterGroupVersionKind, networkingv1alpha3.EnvoyFilterGroupVersionResource, true, func() runtime.Object { return &networkingv1alpha3.EnvoyFilter{} }, func() runtime.Object { return &networkingv1alpha3.EnvoyFilterList{} }),
+	newKind(networkingv1alpha3.GatewayGroupVersionKind, networkingv1alpha3.GatewayGroupVersionResource, true, func() runtime.Object { return &networkingv1alpha3.Gateway{} }, func() runtime.Object { return &networkingv1alpha3.GatewayList{} }),
+	newKind(networkingv1alpha3.ServiceEntryGroupVersionKind, networkingv1alpha3.ServiceEntryGroupVersionResource, true, func() runtime.Object { return &networkingv1alpha3.ServiceEntry{} }, func() runtime.Object { return &networkingv1alpha3.ServiceEntryList{} }),
+	newKind(networkingv1alpha3.SidecarGroupVersionKind, networkingv1alpha3.SidecarGroupVersionResource, true, func() runtime.Object { return &networkingv1alpha3.Sidecar{} }, func() runtime.Object { return &networkingv1alpha3.SidecarList{} }),
+	newKind(networkingv1alpha3.VirtualServiceGroupVersionKind, networkingv1alpha3.VirtualServiceGroupVersionResource, true, func() runtime.Object { return &networkingv1alpha3.VirtualService{} }, func() runtime.Object { return &networkingv1alpha3.VirtualServiceList{} }),
+	newKind(networkingv1alpha3.WorkloadEntryGroupVersionKind, networkingv1alpha3.WorkloadEntryGroupVersionResource, true, func() runtime.Object { return &networkingv1alpha3.WorkloadEntry{} }, func() runtime.Object { return &networkingv1alpha3.WorkloadEntryList{} }),
+	newKind(networkingv1alpha3.WorkloadGroupGroupVersionKind, networkingv1alpha3.WorkloadGroupGroupVersionResource, true, func() runtime.Object { return &networkingv1alpha3.WorkloadGroup{} }, func() runtime.Object { return &networkingv1alpha3.WorkloadGroupList{} }),
+	newKind(networkingv1beta1.DestinationRuleGroupVersionKind, networkingv1beta1.DestinationRuleGroupVersionResource, true, func() runtime.Object { return &networkingv1beta1.DestinationRule{} }, func() runtime.Object { return &networkingv1beta1.DestinationRuleList{} }),
+	newKind(networkingv1beta1.GatewayGroupVersionKind, networkingv1beta1.GatewayGroupVersionResource, true, func() runtime.Object { return &networkingv1beta1.Gateway{} }, func() runtime.Object { return &networkingv1beta1.GatewayList{} }),
+	newKind(networkingv1beta1.ServiceEntryGroupVersionKind, networkingv1beta1.ServiceEntryGroupVersionResource, true, func() runtime.Object { return &networkingv1beta1.ServiceEntry{} }, func() runtime.Object { return &networkingv1beta1.ServiceEntryList{} }),
+	newKind(networkingv1beta1.SidecarGroupVersionKind, networkingv1beta1.SidecarGroupVersionResource, true, func() runtime.Object { return &networkingv1beta1.Sidecar{} }, func() runtime.Object { return &networkingv1beta1.SidecarList{} }),
+	newKind(networkingv1beta1.VirtualServiceGroupVersionKind, networkingv1beta1.VirtualServiceGroupVersionResource, true, func() runtime.Object { return &networkingv1beta1.VirtualService{} }, func() runtime.Object { return &networkingv1beta1.VirtualServiceList{} }),
+	newKind(networkingv1beta1.WorkloadEntryGroupVersionKind, networkingv1beta1.WorkloadEntryGroupVersionResource, true, func() runtime.Object { return &networkingv1beta1.WorkloadEntry{} }, func() runtime.Object { return &networkingv1beta1.WorkloadEntryList{} }),
+	newKind(securityv1beta1.AuthorizationPolicyGroupVersionKind, securityv1beta1.AuthorizationPolicyGroupVersionResource, true, func() runtime.Object { return &securityv1beta1.AuthorizationPolicy{} }, func() runtime.Object { return &securityv1beta1.AuthorizationPolicyList{} }),
+	newKind(securityv1beta1.PeerAuthenticationGroupVersionKind, securityv1beta1.PeerAuthenticationGroupVersionResource, true, func() runtime.Object { return &securityv1beta1.PeerAuthentication{} }, func() runtime.Object { return &securityv1beta1.PeerAuthenticationList{} }),
+	newKind(securityv1beta1.RequestAuthenticationGroupVersionKind, securityv1beta1.RequestAuthenticationGroupVersionResource, true, func() runtime.Object { return &securityv1beta1.RequestAuthentication{} }, func() runtime.Object { return &securityv1beta1.RequestAuthenticationList{} }),
+	newKind(telemetryv1alpha1.TelemetryGroupVersionKind, telemetryv1alpha1.TelemetryGroupVersionResource, true, func() runtime.Object { return &telemetryv1alpha1.Telemetry{} }, func() runtime.Object { return &telemetryv1alpha1.TelemetryList{} }),
+}
+
+var (
+	byKind     = make(map[schema.GroupVersionKind]Kind, len(kinds))
+	byResource = make(map[schema.GroupVersionResource]Kind, len(kinds))
+)
+
+func init() {
+	for _, kind := range kinds {
+		byKind[kind.GroupVersionKind] = kind
+		byResource[kind.GroupVersionResource] = kind
+	}
+}
+
+func newKind(gvk schema.GroupVersionKind, gvr schema.GroupVersionResource, namespaced bool, newFunc, newListFunc func() runtime.Object) Kind {
+	return Kind{
+		GroupVersionKind:     gvk,
+		GroupVersionResource: gvr,
+		ListKind:             gvk.Kind + "List",
+		Namespaced:           namespaced,
+		New:                  newFunc,
+		NewList:              newListFunc,
+	}
+}
+
+// Kinds returns every kind modeled by this module, ordered by group, version
+// and kind.
+func Kinds() []Kind {
+	out := make([]Kind, len(kinds))
+	copy(out, kinds)
+
+	return out
+}
+
+// ForKind returns the kind registered with the given GroupVersionKind.
+func ForKind(gvk schema.GroupVersionKind) (Kind, bool) {
+	kind, ok := byKind[gvk]
+	return kind, ok
+}
+
+// ForResource returns the kind registered with the given GroupVersionResource.
+func ForResource(gvr schema.GroupVersionResource) (Kind, bool) {
+	kind, ok := byResource[gvr]
+	return kind, ok
+}
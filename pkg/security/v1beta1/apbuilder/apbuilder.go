@@ -0,0 +1,352 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package apbuilder builds v1beta1 authorization policies without spelling
+// out the nested literals of their rules, e.g.
+//
+//	ap, err := apbuilder.New("httpbin").Namespace("foo").
+//		Selector(map[string]string{"app": "httpbin"}).
+//		Rules(apbuilder.Rule().
+//			FromNamespaces("bar").
+//			ToMethods("GET").Paths("/info*").
+//			WhenClaim("iss", "https://accounts.google.com")).
+//		Allow()
+//
+// The From and To methods start a new source or operation, which a request
+// matches if it matches any of them, and the other methods of the sources and
+// operations add to the last one, which a request matches if it matches all
+// of their fields. The terminal methods set the action and validate the
+// policy.
+package apbuilder
+
+import (
+	"strconv"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/banzaicloud/istio-client-go/pkg/security/v1beta1"
+	selector "github.com/banzaicloud/istio-client-go/pkg/type/v1beta1"
+)
+
+// Builder builds an authorization policy.
+type Builder struct {
+	ap v1beta1.AuthorizationPolicy
+}
+
+// New returns a builder of the authorization policy with the name.
+func New(name string) *Builder {
+	return &Builder{
+		ap: v1beta1.AuthorizationPolicy{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: v1beta1.AuthorizationPolicyGroupVersionKind.GroupVersion().String(),
+				Kind:       v1beta1.AuthorizationPolicyGroupVersionKind.Kind,
+			},
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+		},
+	}
+}
+
+// Namespace sets the namespace of the authorization policy.
+func (b *Builder) Namespace(namespace string) *Builder {
+	b.ap.Namespace = namespace
+	return b
+}
+
+// Labels adds labels to the authorization policy.
+func (b *Builder) Labels(labels map[string]string) *Builder {
+	if b.ap.Labels == nil {
+		b.ap.Labels = make(map[string]string, len(labels))
+	}
+	for key, value := range labels {
+		b.ap.Labels[key] = value
+	}
+	return b
+}
+
+// Selector selects the workloads with the labels. The policy applies to every
+// workload of its namespace without a selector.
+func (b *Builder) Selector(labels map[string]string) *Builder {
+	b.ap.Spec.Selector = &selector.WorkloadSelector{MatchLabels: labels}
+	return b
+}
+
+// Rules adds rules to the authorization policy. A request matches the policy
+// if it matches any of its rules.
+func (b *Builder) Rules(rules ...*RuleBuilder) *Builder {
+	for _, rule := range rules {
+		b.ap.Spec.Rules = append(b.ap.Spec.Rules, rule.rule.DeepCopy())
+	}
+	return b
+}
+
+// Allow returns the policy allowing the requests matching the rules, or the
+// errors of its validation as a utilerrors.Aggregate.
+func (b *Builder) Allow() (*v1beta1.AuthorizationPolicy, error) {
+	return b.build(v1beta1.AuthorizationPolicyActionAllow, nil)
+}
+
+// Deny returns the policy denying the requests matching the rules, or the
+// errors of its validation as a utilerrors.Aggregate.
+func (b *Builder) Deny() (*v1beta1.AuthorizationPolicy, error) {
+	return b.build(v1beta1.AuthorizationPolicyActionDeny, nil)
+}
+
+// Audit returns the policy auditing the requests matching the rules, or the
+// errors of its validation as a utilerrors.Aggregate.
+func (b *Builder) Audit() (*v1beta1.AuthorizationPolicy, error) {
+	return b.build(v1beta1.AuthorizationPolicyActionAudit, nil)
+}
+
+// Custom returns the policy delegating the requests matching the rules to the
+// extension provider, or the errors of its validation as a
+// utilerrors.Aggregate.
+func (b *Builder) Custom(provider string) (*v1beta1.AuthorizationPolicy, error) {
+	return b.build(v1beta1.AuthorizationPolicyActionCustom, &v1beta1.ExtensionProvider{Name: provider})
+}
+
+func (b *Builder) build(action v1beta1.AuthorizationPolicyAction, provider *v1beta1.ExtensionProvider) (*v1beta1.AuthorizationPolicy, error) {
+	ap := b.ap.DeepCopy()
+	ap.Spec.Action = action
+	ap.Spec.Provider = provider
+	if errs := ap.Validate(); len(errs) > 0 {
+		return nil, errs.ToAggregate()
+	}
+
+	return ap, nil
+}
+
+// RuleBuilder builds a rule of an authorization policy.
+type RuleBuilder struct {
+	rule v1beta1.Rule
+}
+
+// Rule returns a builder of a rule.
+func Rule() *RuleBuilder {
+	return &RuleBuilder{}
+}
+
+// FromPrincipals starts a source matching the peer identities, e.g.
+// cluster.local/ns/default/sa/sleep
+func (r *RuleBuilder) FromPrincipals(principals ...string) *RuleBuilder {
+	r.rule.From = append(r.rule.From, &v1beta1.RuleFrom{Source: &v1beta1.Source{}})
+	return r.Principals(principals...)
+}
+
+// FromRequestPrincipals starts a source matching the request identities in
+// the issuer/subject format.
+func (r *RuleBuilder) FromRequestPrincipals(principals ...string) *RuleBuilder {
+	r.rule.From = append(r.rule.From, &v1beta1.RuleFrom{Source: &v1beta1.Source{}})
+	return r.RequestPrincipals(principals...)
+}
+
+// FromNamespaces starts a source matching the namespaces of the peers.
+func (r *RuleBuilder) FromNamespaces(namespaces ...string) *RuleBuilder {
+	r.rule.From = append(r.rule.From, &v1beta1.RuleFrom{Source: &v1beta1.Source{}})
+	return r.Namespaces(namespaces...)
+}
+
+// FromIPBlocks starts a source matching the IP addresses and CIDR ranges of
+// the peers.
+func (r *RuleBuilder) FromIPBlocks(blocks ...string) *RuleBuilder {
+	r.rule.From = append(r.rule.From, &v1beta1.RuleFrom{Source: &v1beta1.Source{}})
+	return r.IPBlocks(blocks...)
+}
+
+// Principals adds peer identities to the last source.
+func (r *RuleBuilder) Principals(principals ...string) *RuleBuilder {
+	source := r.source()
+	source.Principals = append(source.Principals, principals...)
+	return r
+}
+
+// NotPrincipals adds peer identities the last source does not match.
+func (r *RuleBuilder) NotPrincipals(principals ...string) *RuleBuilder {
+	source := r.source()
+	source.NotPrincipals = append(source.NotPrincipals, principals...)
+	return r
+}
+
+// RequestPrincipals adds request identities to the last source.
+func (r *RuleBuilder) RequestPrincipals(principals ...string) *RuleBuilder {
+	source := r.source()
+	source.RequestPrincipals = append(source.RequestPrincipals, principals...)
+	return r
+}
+
+// NotRequestPrincipals adds request identities the last source does not
+// match.
+func (r *RuleBuilder) NotRequestPrincipals(principals ...string) *RuleBuilder {
+	source := r.source()
+	source.NotRequestPrincipals = append(source.NotRequestPrincipals, principals...)
+	return r
+}
+
+// Namespaces adds namespaces to the last source.
+func (r *RuleBuilder) Namespaces(namespaces ...string) *RuleBuilder {
+	source := r.source()
+	source.Namespaces = append(source.Namespaces, namespaces...)
+	return r
+}
+
+// NotNamespaces adds namespaces the last source does not match.
+func (r *RuleBuilder) NotNamespaces(namespaces ...string) *RuleBuilder {
+	source := r.source()
+	source.NotNamespaces = append(source.NotNamespaces, namespaces...)
+	return r
+}
+
+// IPBlocks adds IP addresses and CIDR ranges to the last source.
+func (r *RuleBuilder) IPBlocks(blocks ...string) *RuleBuilder {
+	source := r.source()
+	source.IPBlocks = append(source.IPBlocks, blocks...)
+	return r
+}
+
+// NotIPBlocks adds IP addresses and CIDR ranges the last source does not
+// match.
+func (r *RuleBuilder) NotIPBlocks(blocks ...string) *RuleBuilder {
+	source := r.source()
+	source.NotIPBlocks = append(source.NotIPBlocks, blocks...)
+	return r
+}
+
+// source returns the last source, starting one if there is none.
+func (r *RuleBuilder) source() *v1beta1.Source {
+	if len(r.rule.From) == 0 {
+		r.rule.From = append(r.rule.From, &v1beta1.RuleFrom{Source: &v1beta1.Source{}})
+	}
+	return r.rule.From[len(r.rule.From)-1].Source
+}
+
+// ToHosts starts an operation matching the hosts of the requests.
+func (r *RuleBuilder) ToHosts(hosts ...string) *RuleBuilder {
+	r.rule.To = append(r.rule.To, &v1beta1.RuleTo{Operation: &v1beta1.Operation{}})
+	return r.Hosts(hosts...)
+}
+
+// ToPorts starts an operation matching the ports of the connections.
+func (r *RuleBuilder) ToPorts(ports ...int) *RuleBuilder {
+	r.rule.To = append(r.rule.To, &v1beta1.RuleTo{Operation: &v1beta1.Operation{}})
+	return r.Ports(ports...)
+}
+
+// ToMethods starts an operation matching the methods of the requests.
+func (r *RuleBuilder) ToMethods(methods ...string) *RuleBuilder {
+	r.rule.To = append(r.rule.To, &v1beta1.RuleTo{Operation: &v1beta1.Operation{}})
+	return r.Methods(methods...)
+}
+
+// ToPaths starts an operation matching the paths of the requests.
+func (r *RuleBuilder) ToPaths(paths ...string) *RuleBuilder {
+	r.rule.To = append(r.rule.To, &v1beta1.RuleTo{Operation: &v1beta1.Operation{}})
+	return r.Paths(paths...)
+}
+
+// Hosts adds hosts to the last operation.
+func (r *RuleBuilder) Hosts(hosts ...string) *RuleBuilder {
+	operation := r.operation()
+	operation.Hosts = append(operation.Hosts, hosts...)
+	return r
+}
+
+// NotHosts adds hosts the last operation does not match.
+func (r *RuleBuilder) NotHosts(hosts ...string) *RuleBuilder {
+	operation := r.operation()
+	operation.NotHosts = append(operation.NotHosts, hosts...)
+	return r
+}
+
+// Ports adds ports to the last operation.
+func (r *RuleBuilder) Ports(ports ...int) *RuleBuilder {
+	operation := r.operation()
+	operation.Ports = appendPorts(operation.Ports, ports)
+	return r
+}
+
+// NotPorts adds ports the last operation does not match.
+func (r *RuleBuilder) NotPorts(ports ...int) *RuleBuilder {
+	operation := r.operation()
+	operation.NotPorts = appendPorts(operation.NotPorts, ports)
+	return r
+}
+
+// Methods adds methods to the last operation.
+func (r *RuleBuilder) Methods(methods ...string) *RuleBuilder {
+	operation := r.operation()
+	operation.Methods = append(operation.Methods, methods...)
+	return r
+}
+
+// NotMethods adds methods the last operation does not match.
+func (r *RuleBuilder) NotMethods(methods ...string) *RuleBuilder {
+	operation := r.operation()
+	operation.NotMethods = append(operation.NotMethods, methods...)
+	return r
+}
+
+// Paths adds paths to the last operation.
+func (r *RuleBuilder) Paths(paths ...string) *RuleBuilder {
+	operation := r.operation()
+	operation.Paths = append(operation.Paths, paths...)
+	return r
+}
+
+// NotPaths adds paths the last operation does not match.
+func (r *RuleBuilder) NotPaths(paths ...string) *RuleBuilder {
+	operation := r.operation()
+	operation.NotPaths = append(operation.NotPaths, paths...)
+	return r
+}
+
+// operation returns the last operation, starting one if there is none.
+func (r *RuleBuilder) operation() *v1beta1.Operation {
+	if len(r.rule.To) == 0 {
+		r.rule.To = append(r.rule.To, &v1beta1.RuleTo{Operation: &v1beta1.Operation{}})
+	}
+	return r.rule.To[len(r.rule.To)-1].Operation
+}
+
+func appendPorts(ports []string, numbers []int) []string {
+	for _, number := range numbers {
+		ports = append(ports, strconv.Itoa(number))
+	}
+	return ports
+}
+
+// When adds a condition matching the requests whose attribute with the key,
+// e.g. source.ip, has any of the values. A request matches the rule if it
+// matches all of its conditions.
+func (r *RuleBuilder) When(key string, values ...string) *RuleBuilder {
+	r.rule.When = append(r.rule.When, &v1beta1.Condition{Key: key, Values: values})
+	return r
+}
+
+// WhenNot adds a condition matching the requests whose attribute with the key
+// has none of the values.
+func (r *RuleBuilder) WhenNot(key string, values ...string) *RuleBuilder {
+	r.rule.When = append(r.rule.When, &v1beta1.Condition{Key: key, NotValues: values})
+	return r
+}
+
+// WhenClaim adds a condition matching the requests whose JWT claim has any of
+// the values.
+func (r *RuleBuilder) WhenClaim(claim string, values ...string) *RuleBuilder {
+	return r.When("request.auth.claims["+claim+"]", values...)
+}
+
+// WhenHeader adds a condition matching the requests whose header has any of
+// the values.
+func (r *RuleBuilder) WhenHeader(name string, values ...string) *RuleBuilder {
+	return r.When("request.headers["+name+"]", values...)
+}
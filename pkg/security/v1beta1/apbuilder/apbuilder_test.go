@@ -0,0 +1,74 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apbuilder
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/banzaicloud/istio-client-go/pkg/security/v1beta1"
+)
+
+func TestAllow(t *testing.T) {
+	ap, err := New("httpbin").Namespace("foo").
+		Selector(map[string]string{"app": "httpbin"}).
+		Rules(Rule().
+			FromNamespaces("bar").Principals("cluster.local/ns/bar/sa/sleep").
+			FromIPBlocks("10.0.0.0/8").
+			ToMethods("GET").Paths("/info*").
+			ToPorts(8080).
+			WhenClaim("iss", "https://accounts.google.com")).
+		Allow()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if ap.Spec.Action != v1beta1.AuthorizationPolicyActionAllow || ap.Kind != "AuthorizationPolicy" {
+		t.Errorf("unexpected policy %+v", ap)
+	}
+	rule := ap.Spec.Rules[0]
+	expectedFrom := []*v1beta1.RuleFrom{
+		{Source: &v1beta1.Source{Namespaces: []string{"bar"}, Principals: []string{"cluster.local/ns/bar/sa/sleep"}}},
+		{Source: &v1beta1.Source{IPBlocks: []string{"10.0.0.0/8"}}},
+	}
+	if !reflect.DeepEqual(rule.From, expectedFrom) {
+		t.Errorf("unexpected sources %v", rule.From)
+	}
+	expectedTo := []*v1beta1.RuleTo{
+		{Operation: &v1beta1.Operation{Methods: []string{"GET"}, Paths: []string{"/info*"}}},
+		{Operation: &v1beta1.Operation{Ports: []string{"8080"}}},
+	}
+	if !reflect.DeepEqual(rule.To, expectedTo) {
+		t.Errorf("unexpected operations %v", rule.To)
+	}
+	if rule.When[0].Key != "request.auth.claims[iss]" {
+		t.Errorf("unexpected condition %v", rule.When[0])
+	}
+}
+
+func TestCustom(t *testing.T) {
+	ap, err := New("ext-authz").Rules(Rule().ToPaths("/admin*")).Custom("opa")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ap.Spec.Action != v1beta1.AuthorizationPolicyActionCustom || ap.Spec.Provider.Name != "opa" {
+		t.Errorf("unexpected policy %+v", ap.Spec)
+	}
+
+	if _, err := New("deny").Deny(); err == nil || !strings.Contains(err.Error(), "spec.rules") {
+		t.Errorf("expected a DENY policy without rules to be invalid, got %v", err)
+	}
+}
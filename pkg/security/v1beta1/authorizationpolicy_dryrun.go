@@ -0,0 +1,63 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1beta1
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// DryRunAnnotation is the annotation used by Istio to put an authorization policy
+// into dry-run mode. A policy in dry-run mode is evaluated and its result is
+// reported in the proxy logs and metrics, but it is not enforced.
+const DryRunAnnotation = "istio.io/dry-run"
+
+// SetDryRun puts the policy into (or takes it out of) dry-run mode by managing the
+// `istio.io/dry-run` annotation. Disabling dry-run removes the annotation.
+func (p *AuthorizationPolicy) SetDryRun(dryRun bool) {
+	if !dryRun {
+		delete(p.Annotations, DryRunAnnotation)
+		return
+	}
+
+	if p.Annotations == nil {
+		p.Annotations = make(map[string]string)
+	}
+	p.Annotations[DryRunAnnotation] = strconv.FormatBool(dryRun)
+}
+
+// IsDryRun returns true if the policy is annotated to run in dry-run mode.
+// An annotation value that cannot be parsed is treated as not dry-run, the
+// same way Istio does.
+func (p *AuthorizationPolicy) IsDryRun() bool {
+	dryRun, err := p.DryRunStatus()
+	return err == nil && dryRun
+}
+
+// DryRunStatus returns the dry-run mode of the policy together with an error
+// if the `istio.io/dry-run` annotation is present but is not a valid boolean.
+func (p *AuthorizationPolicy) DryRunStatus() (bool, error) {
+	value, ok := p.Annotations[DryRunAnnotation]
+	if !ok {
+		return false, nil
+	}
+
+	dryRun, err := strconv.ParseBool(value)
+	if err != nil {
+		return false, fmt.Errorf("invalid value %q for annotation %s: must be true or false", value, DryRunAnnotation)
+	}
+
+	return dryRun, nil
+}
@@ -0,0 +1,77 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1beta1
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestDryRunRoundTrip(t *testing.T) {
+	ap := &AuthorizationPolicy{}
+	ap.SetDryRun(true)
+
+	data, err := json.Marshal(ap)
+	if err != nil {
+		t.Fatal(err)
+	}
+	decoded := &AuthorizationPolicy{}
+	if err := json.Unmarshal(data, decoded); err != nil {
+		t.Fatal(err)
+	}
+	if decoded.Annotations[DryRunAnnotation] != "true" || !decoded.IsDryRun() {
+		t.Fatalf("expected the decoded policy to be in dry-run mode, got the annotations %v", decoded.Annotations)
+	}
+
+	decoded.SetDryRun(false)
+	if _, ok := decoded.Annotations[DryRunAnnotation]; ok || decoded.IsDryRun() {
+		t.Fatalf("expected the annotation to be removed, got %v", decoded.Annotations)
+	}
+	if data, err = json.Marshal(decoded); err != nil {
+		t.Fatal(err)
+	}
+	if err := json.Unmarshal(data, decoded); err != nil {
+		t.Fatal(err)
+	}
+	if decoded.IsDryRun() {
+		t.Fatal("unexpected dry-run mode")
+	}
+}
+
+func TestDryRunStatus(t *testing.T) {
+	tests := []struct {
+		annotations map[string]string
+		dryRun      bool
+		invalid     bool
+	}{
+		{annotations: nil},
+		{annotations: map[string]string{DryRunAnnotation: "true"}, dryRun: true},
+		{annotations: map[string]string{DryRunAnnotation: "false"}},
+		{annotations: map[string]string{DryRunAnnotation: "1"}, dryRun: true},
+		{annotations: map[string]string{DryRunAnnotation: "yes"}, invalid: true},
+	}
+
+	for _, test := range tests {
+		ap := &AuthorizationPolicy{}
+		ap.Annotations = test.annotations
+		dryRun, err := ap.DryRunStatus()
+		if dryRun != test.dryRun || (err != nil) != test.invalid {
+			t.Errorf("%v: unexpected status %t, %v", test.annotations, dryRun, err)
+		}
+		if ap.IsDryRun() != test.dryRun {
+			t.Errorf("%v: unexpected dry-run mode %t", test.annotations, ap.IsDryRun())
+		}
+	}
+}
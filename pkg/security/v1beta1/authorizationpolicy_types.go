@@ -18,6 +18,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	selector "github.com/banzaicloud/istio-client-go/pkg/type/v1beta1"
+
+	metav1alpha1 "github.com/banzaicloud/istio-client-go/pkg/meta/v1alpha1"
 )
 
 // Istio Authorization Policy enables access control on workloads in the mesh.
@@ -150,11 +152,16 @@ import (
 
 // +genclient
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:categories=istio-io;security-istio-io
+// +kubebuilder:printcolumn:name="Action",type="string",JSONPath=".spec.action",description="The operation to take."
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp",description="CreationTimestamp is a timestamp representing the server time when this object was created. It is not guaranteed to be set in happens-before order across separate operations. Clients may not set this value. It is represented in RFC3339 form and is in UTC. Populated by the system. Read-only. Null for lists. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#metadata"
 // AuthorizationPolicy
 type AuthorizationPolicy struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
-	Spec              AuthorizationPolicySpec `json:"spec"`
+	Spec              AuthorizationPolicySpec   `json:"spec"`
+	Status            *metav1alpha1.IstioStatus `json:"status,omitempty"`
 }
 
 // AuthorizationPolicy enables access control on workloads.
@@ -198,9 +205,13 @@ type AuthorizationPolicySpec struct {
 	Rules []*Rule `json:"rules,omitempty"`
 	// Optional. The action to take if the request is matched with the rules.
 	Action AuthorizationPolicyAction `json:"action,omitempty"`
+	// Specifies detailed configuration of the CUSTOM action. Must be used only
+	// with CUSTOM action.
+	Provider *ExtensionProvider `json:"provider,omitempty"`
 }
 
 // Action specifies the operation to take.
+// +kubebuilder:validation:Enum=ALLOW;DENY;AUDIT;CUSTOM
 type AuthorizationPolicyAction string
 
 const (
@@ -208,8 +219,22 @@ const (
 	AuthorizationPolicyActionAllow AuthorizationPolicyAction = "ALLOW"
 	// Deny a request if it matches any of the rules.
 	AuthorizationPolicyActionDeny AuthorizationPolicyAction = "DENY"
+	// Audit a request if it matches any of the rules.
+	AuthorizationPolicyActionAudit AuthorizationPolicyAction = "AUDIT"
+	// The CUSTOM action allows an extension to handle the user request if the
+	// matching rules evaluate to true. The extension is evaluated independently
+	// and before the native ALLOW and DENY actions.
+	AuthorizationPolicyActionCustom AuthorizationPolicyAction = "CUSTOM"
 )
 
+// ExtensionProvider selects the extension that handles the requests of the
+// CUSTOM action.
+type ExtensionProvider struct {
+	// Specifies the name of the extension provider. The list of available
+	// providers is defined in the MeshConfig.
+	Name string `json:"name,omitempty"`
+}
+
 // Rule matches requests from a list of sources that perform a list of operations subject to a
 // list of conditions. A match occurs when at least one source, operation and condition
 // matches the request. An empty rule is always matched.
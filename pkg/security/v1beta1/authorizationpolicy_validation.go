@@ -0,0 +1,235 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1beta1
+
+import (
+	"net"
+	"strconv"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/banzaicloud/istio-client-go/pkg/validation"
+)
+
+// conditionKeys are the supported keys of the conditions of the rules. The
+// keys ending in [ take the name of a header or claim, e.g. request.headers[User-Agent].
+var conditionKeys = []string{
+	"request.headers[",
+	"source.ip",
+	"remote.ip",
+	"source.namespace",
+	"source.principal",
+	"request.auth.principal",
+	"request.auth.audiences",
+	"request.auth.presenter",
+	"request.auth.claims[",
+	"destination.ip",
+	"destination.port",
+	"connection.sni",
+	"experimental.envoy.filters.",
+}
+
+// Validate checks the authorization policy the way Istio does before accepting it,
+// including its dry-run annotation.
+func (ap *AuthorizationPolicy) Validate() field.ErrorList {
+	errs := ap.validateDryRun(field.NewPath("metadata", "annotations").Key(DryRunAnnotation))
+
+	return append(errs, ap.Spec.Validate(field.NewPath("spec"))...)
+}
+
+// validateDryRun checks that the dry-run annotation, if any, is a boolean,
+// and that the action of a policy in dry-run mode supports it.
+func (ap *AuthorizationPolicy) validateDryRun(path *field.Path) field.ErrorList {
+	dryRun, err := ap.DryRunStatus()
+	switch {
+	case err != nil:
+		return field.ErrorList{field.Invalid(path, ap.Annotations[DryRunAnnotation], "must be true or false")}
+	case dryRun && ap.Spec.Action == AuthorizationPolicyActionCustom:
+		return field.ErrorList{field.Forbidden(path, "the CUSTOM action does not support dry-run")}
+	}
+
+	return nil
+}
+
+// Validate checks the spec the way Istio does before accepting it.
+func (s *AuthorizationPolicySpec) Validate(path *field.Path) field.ErrorList {
+	errs := validateSelector(path.Child("selector"), s.Selector)
+
+	switch s.Action {
+	case AuthorizationPolicyActionCustom:
+		if s.Provider == nil || s.Provider.Name == "" {
+			errs = append(errs, field.Required(path.Child("provider", "name"), "the CUSTOM action requires a provider"))
+		}
+	case "", AuthorizationPolicyActionAllow, AuthorizationPolicyActionDeny, AuthorizationPolicyActionAudit:
+		if s.Provider != nil {
+			errs = append(errs, field.Forbidden(path.Child("provider"), "a provider may only be set for the CUSTOM action"))
+		}
+	default:
+		errs = append(errs, field.NotSupported(path.Child("action"), s.Action, []string{
+			string(AuthorizationPolicyActionAllow), string(AuthorizationPolicyActionDeny),
+			string(AuthorizationPolicyActionAudit), string(AuthorizationPolicyActionCustom),
+		}))
+	}
+
+	if len(s.Rules) == 0 && s.Action != "" && s.Action != AuthorizationPolicyActionAllow {
+		errs = append(errs, field.Required(path.Child("rules"), "a policy without rules only denies requests with the ALLOW action"))
+	}
+	for i, rule := range s.Rules {
+		errs = append(errs, rule.validate(path.Child("rules").Index(i), s.Action)...)
+	}
+
+	return errs
+}
+
+func (r *Rule) validate(path *field.Path, action AuthorizationPolicyAction) field.ErrorList {
+	if r == nil {
+		return nil
+	}
+
+	var errs field.ErrorList
+	for i, from := range r.From {
+		fromPath := path.Child("from").Index(i).Child("source")
+		if from == nil || from.Source == nil {
+			errs = append(errs, field.Required(fromPath, "source must not be empty"))
+			continue
+		}
+		errs = append(errs, from.Source.validate(fromPath, action)...)
+	}
+	for i, to := range r.To {
+		toPath := path.Child("to").Index(i).Child("operation")
+		if to == nil || to.Operation == nil {
+			errs = append(errs, field.Required(toPath, "operation must not be empty"))
+			continue
+		}
+		errs = append(errs, to.Operation.validate(toPath)...)
+	}
+	for i, condition := range r.When {
+		errs = append(errs, condition.validate(path.Child("when").Index(i), action)...)
+	}
+
+	return errs
+}
+
+func (s *Source) validate(path *field.Path, action AuthorizationPolicyAction) field.ErrorList {
+	if len(s.Principals) == 0 && len(s.NotPrincipals) == 0 && len(s.RequestPrincipals) == 0 && len(s.NotRequestPrincipals) == 0 &&
+		len(s.Namespaces) == 0 && len(s.NotNamespaces) == 0 && len(s.IPBlocks) == 0 && len(s.NotIPBlocks) == 0 {
+		return field.ErrorList{field.Required(path, "source must have at least one field set")}
+	}
+
+	var errs field.ErrorList
+	if action == AuthorizationPolicyActionCustom {
+		if len(s.RequestPrincipals) > 0 {
+			errs = append(errs, field.Forbidden(path.Child("requestPrincipals"), "the CUSTOM action does not support request principals"))
+		}
+		if len(s.NotRequestPrincipals) > 0 {
+			errs = append(errs, field.Forbidden(path.Child("notRequestPrincipals"), "the CUSTOM action does not support request principals"))
+		}
+	}
+	errs = append(errs, validateIPBlocks(path.Child("ipBlocks"), s.IPBlocks)...)
+	errs = append(errs, validateIPBlocks(path.Child("notIpBlocks"), s.NotIPBlocks)...)
+
+	return errs
+}
+
+func (o *Operation) validate(path *field.Path) field.ErrorList {
+	if len(o.Hosts) == 0 && len(o.NotHosts) == 0 && len(o.Ports) == 0 && len(o.NotPorts) == 0 &&
+		len(o.Methods) == 0 && len(o.NotMethods) == 0 && len(o.Paths) == 0 && len(o.NotPaths) == 0 {
+		return field.ErrorList{field.Required(path, "operation must have at least one field set")}
+	}
+
+	var errs field.ErrorList
+	errs = append(errs, validatePorts(path.Child("ports"), o.Ports)...)
+	errs = append(errs, validatePorts(path.Child("notPorts"), o.NotPorts)...)
+
+	return errs
+}
+
+func (c *Condition) validate(path *field.Path, action AuthorizationPolicyAction) field.ErrorList {
+	if c == nil {
+		return nil
+	}
+
+	var errs field.ErrorList
+	if len(c.Values) == 0 && len(c.NotValues) == 0 {
+		errs = append(errs, field.Required(path.Child("values"), "at least one of values or notValues is required"))
+	}
+
+	switch key := c.Key; {
+	case key == "":
+		errs = append(errs, field.Required(path.Child("key"), "condition key must not be empty"))
+	case !supportedConditionKey(key):
+		errs = append(errs, field.Invalid(path.Child("key"), key, "unsupported condition key"))
+	case action == AuthorizationPolicyActionCustom && strings.HasPrefix(key, "request.auth."):
+		errs = append(errs, field.Forbidden(path.Child("key"), "the CUSTOM action does not support the request.auth attributes"))
+	case key == "source.ip" || key == "remote.ip" || key == "destination.ip":
+		errs = append(errs, validateIPBlocks(path.Child("values"), c.Values)...)
+		errs = append(errs, validateIPBlocks(path.Child("notValues"), c.NotValues)...)
+	case key == "destination.port":
+		errs = append(errs, validatePorts(path.Child("values"), c.Values)...)
+		errs = append(errs, validatePorts(path.Child("notValues"), c.NotValues)...)
+	}
+
+	return errs
+}
+
+func supportedConditionKey(key string) bool {
+	for _, supported := range conditionKeys {
+		switch {
+		case strings.HasSuffix(supported, "["):
+			if strings.HasPrefix(key, supported) && strings.HasSuffix(key, "]") && len(key) > len(supported)+1 {
+				return true
+			}
+		case strings.HasSuffix(supported, "."):
+			if strings.HasPrefix(key, supported) && len(key) > len(supported) {
+				return true
+			}
+		case key == supported:
+			return true
+		}
+	}
+
+	return false
+}
+
+// validateIPBlocks checks that the values are IP addresses or CIDR ranges.
+func validateIPBlocks(path *field.Path, values []string) field.ErrorList {
+	var errs field.ErrorList
+	for i, value := range values {
+		if net.ParseIP(value) != nil {
+			continue
+		}
+		if _, _, err := net.ParseCIDR(value); err != nil {
+			errs = append(errs, field.Invalid(path.Index(i), value, "must be an IP address or a CIDR range"))
+		}
+	}
+
+	return errs
+}
+
+// validatePorts checks that the values are port numbers.
+func validatePorts(path *field.Path, values []string) field.ErrorList {
+	var errs field.ErrorList
+	for i, value := range values {
+		port, err := strconv.ParseInt(value, 10, 32)
+		if err != nil {
+			errs = append(errs, field.Invalid(path.Index(i), value, "must be a port number"))
+			continue
+		}
+		errs = append(errs, validation.Port(path.Index(i), port)...)
+	}
+
+	return errs
+}
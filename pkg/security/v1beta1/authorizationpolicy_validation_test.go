@@ -0,0 +1,121 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1beta1
+
+import (
+	"testing"
+)
+
+func TestAuthorizationPolicyValidate(t *testing.T) {
+	ap := &AuthorizationPolicy{
+		Spec: AuthorizationPolicySpec{
+			Action: AuthorizationPolicyActionDeny,
+			Rules: []*Rule{
+				{
+					From: []*RuleFrom{{Source: &Source{IPBlocks: []string{"10.0.0.1", "192.168.0.0/16"}}}},
+					To:   []*RuleTo{{Operation: &Operation{Ports: []string{"8080"}, Methods: []string{"POST"}}}},
+					When: []*Condition{
+						{Key: "request.headers[User-Agent]", Values: []string{"curl*"}},
+						{Key: "destination.port", NotValues: []string{"9090"}},
+					},
+				},
+			},
+		},
+	}
+	if errs := ap.Validate(); len(errs) > 0 {
+		t.Fatalf("unexpected errors %v", errs)
+	}
+
+	ap.Spec.Provider = &ExtensionProvider{Name: "ext-authz"}
+	ap.Spec.Rules[0].From[0].Source.NotIPBlocks = []string{"10.0.0.0/33"}
+	ap.Spec.Rules[0].To[0].Operation.NotPorts = []string{"http", "70000"}
+	ap.Spec.Rules[0].When = append(ap.Spec.Rules[0].When,
+		&Condition{Key: "request.headers[]", Values: []string{"x"}},
+		&Condition{Key: "source.ip"},
+	)
+
+	expected := []string{
+		"spec.provider",
+		"spec.rules[0].from[0].source.notIpBlocks[0]",
+		"spec.rules[0].to[0].operation.notPorts[0]",
+		"spec.rules[0].to[0].operation.notPorts[1]",
+		"spec.rules[0].when[2].key",
+		"spec.rules[0].when[3].values",
+	}
+	errs := ap.Validate()
+	if len(errs) != len(expected) {
+		t.Fatalf("unexpected errors %v", errs)
+	}
+	for i, err := range errs {
+		if err.Field != expected[i] {
+			t.Errorf("unexpected error %v, expected one for %s", err, expected[i])
+		}
+	}
+}
+
+func TestAuthorizationPolicyValidateCustom(t *testing.T) {
+	ap := &AuthorizationPolicy{
+		Spec: AuthorizationPolicySpec{
+			Action: AuthorizationPolicyActionCustom,
+			Rules: []*Rule{
+				{
+					From: []*RuleFrom{{Source: &Source{RequestPrincipals: []string{"*"}}}},
+					When: []*Condition{{Key: "request.auth.claims[iss]", Values: []string{"issuer"}}},
+				},
+			},
+		},
+	}
+
+	expected := []string{
+		"spec.provider.name",
+		"spec.rules[0].from[0].source.requestPrincipals",
+		"spec.rules[0].when[0].key",
+	}
+	errs := ap.Validate()
+	if len(errs) != len(expected) {
+		t.Fatalf("unexpected errors %v", errs)
+	}
+	for i, err := range errs {
+		if err.Field != expected[i] {
+			t.Errorf("unexpected error %v, expected one for %s", err, expected[i])
+		}
+	}
+
+	ap.Spec.Rules = nil
+	ap.Spec.Provider = &ExtensionProvider{Name: "ext-authz"}
+	if errs := ap.Validate(); len(errs) != 1 || errs[0].Field != "spec.rules" {
+		t.Fatalf("expected the rules to be required, got %v", errs)
+	}
+}
+
+func TestAuthorizationPolicyValidateDryRun(t *testing.T) {
+	ap := &AuthorizationPolicy{Spec: AuthorizationPolicySpec{Action: AuthorizationPolicyActionDeny, Rules: []*Rule{{}}}}
+	ap.SetDryRun(true)
+	if errs := ap.Validate(); len(errs) > 0 {
+		t.Fatalf("unexpected errors %v", errs)
+	}
+
+	ap.Annotations[DryRunAnnotation] = "yes"
+	if errs := ap.Validate(); len(errs) != 1 || errs[0].Field != "metadata.annotations[istio.io/dry-run]" {
+		t.Fatalf("expected the annotation to be invalid, got %v", errs)
+	}
+
+	ap.SetDryRun(true)
+	ap.Spec.Action = AuthorizationPolicyActionCustom
+	ap.Spec.Provider = &ExtensionProvider{Name: "ext-authz"}
+	if errs := ap.Validate(); len(errs) != 1 || errs[0].Field != "metadata.annotations[istio.io/dry-run]" {
+		t.Fatalf("expected dry-run to be forbidden, got %v", errs)
+	}
+}
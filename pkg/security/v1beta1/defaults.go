@@ -0,0 +1,56 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1beta1
+
+// Default sets the values Istio assumes for the unset fields of the
+// authorization policy.
+func (ap *AuthorizationPolicy) Default() {
+	ap.Spec.Default()
+}
+
+// Default sets the ALLOW action if there is no action.
+func (s *AuthorizationPolicySpec) Default() {
+	if s.Action == "" {
+		s.Action = AuthorizationPolicyActionAllow
+	}
+}
+
+// Default sets the values Istio assumes for the unset fields of the peer
+// authentication.
+func (pa *PeerAuthentication) Default() {
+	pa.Spec.Default()
+}
+
+// Default sets the UNSET mode for the workload and the ports without a mode.
+// Istio treats UNSET as PERMISSIVE unless a namespace or mesh wide policy sets
+// another mode, which an explicit PERMISSIVE would override.
+func (s *PeerAuthenticationSpec) Default() {
+	if s.Mtls == nil {
+		s.Mtls = &PeerAuthenticationMTLS{}
+	}
+	if s.Mtls.Mode == "" {
+		s.Mtls.Mode = MTLSModeUnset
+	}
+
+	for port, mtls := range s.PortLevelMtls {
+		if mtls == nil {
+			mtls = &PeerAuthenticationMTLS{}
+			s.PortLevelMtls[port] = mtls
+		}
+		if mtls.Mode == "" {
+			mtls.Mode = MTLSModeUnset
+		}
+	}
+}
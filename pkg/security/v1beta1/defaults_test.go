@@ -0,0 +1,43 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1beta1
+
+import (
+	"testing"
+)
+
+func TestDefault(t *testing.T) {
+	ap := &AuthorizationPolicy{}
+	ap.Default()
+	if ap.Spec.Action != AuthorizationPolicyActionAllow {
+		t.Errorf("expected the ALLOW action, got %q", ap.Spec.Action)
+	}
+
+	pa := &PeerAuthentication{
+		Spec: PeerAuthenticationSpec{
+			PortLevelMtls: map[uint32]*PeerAuthenticationMTLS{8080: nil, 9090: {Mode: MTLSModeStrict}},
+		},
+	}
+	pa.Default()
+	if pa.Spec.Mtls == nil || pa.Spec.Mtls.Mode != MTLSModeUnset {
+		t.Errorf("expected the UNSET mode, got %v", pa.Spec.Mtls)
+	}
+	if mode := pa.Spec.PortMTLSMode(8080); mode != MTLSModeUnset {
+		t.Errorf("expected the UNSET mode for port 8080, got %s", mode)
+	}
+	if mode := pa.Spec.PortMTLSMode(9090); mode != MTLSModeStrict {
+		t.Errorf("expected the STRICT mode for port 9090, got %s", mode)
+	}
+}
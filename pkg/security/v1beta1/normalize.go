@@ -0,0 +1,113 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1beta1
+
+import (
+	"github.com/banzaicloud/istio-client-go/pkg/normalize"
+)
+
+// Normalize puts the spec of the authorization policy into a deterministic
+// form, see AuthorizationPolicySpec.Normalize.
+func (ap *AuthorizationPolicy) Normalize() {
+	ap.Spec.Normalize()
+}
+
+// Normalize sorts the rules, their sources, operations and conditions, and
+// the values of these, none of which are ordered, and strips the empty lists
+// and maps.
+func (s *AuthorizationPolicySpec) Normalize() {
+	for _, rule := range s.Rules {
+		if rule != nil {
+			rule.normalize()
+		}
+	}
+	normalize.SortByJSON(s.Rules)
+
+	normalize.StripEmpty(s)
+}
+
+func (r *Rule) normalize() {
+	for _, from := range r.From {
+		if from != nil && from.Source != nil {
+			from.Source.normalize()
+		}
+	}
+	for _, to := range r.To {
+		if to != nil && to.Operation != nil {
+			to.Operation.normalize()
+		}
+	}
+	for _, condition := range r.When {
+		if condition != nil {
+			condition.Values = normalize.Strings(condition.Values)
+			condition.NotValues = normalize.Strings(condition.NotValues)
+		}
+	}
+
+	normalize.SortByJSON(r.From)
+	normalize.SortByJSON(r.To)
+	normalize.SortByJSON(r.When)
+}
+
+func (s *Source) normalize() {
+	s.Principals = normalize.Strings(s.Principals)
+	s.NotPrincipals = normalize.Strings(s.NotPrincipals)
+	s.RequestPrincipals = normalize.Strings(s.RequestPrincipals)
+	s.NotRequestPrincipals = normalize.Strings(s.NotRequestPrincipals)
+	s.Namespaces = normalize.Strings(s.Namespaces)
+	s.NotNamespaces = normalize.Strings(s.NotNamespaces)
+	s.IPBlocks = normalize.Strings(s.IPBlocks)
+	s.NotIPBlocks = normalize.Strings(s.NotIPBlocks)
+}
+
+func (o *Operation) normalize() {
+	o.Hosts = normalize.Strings(o.Hosts)
+	o.NotHosts = normalize.Strings(o.NotHosts)
+	o.Ports = normalize.Strings(o.Ports)
+	o.NotPorts = normalize.Strings(o.NotPorts)
+	o.Methods = normalize.Strings(o.Methods)
+	o.NotMethods = normalize.Strings(o.NotMethods)
+	o.Paths = normalize.Strings(o.Paths)
+	o.NotPaths = normalize.Strings(o.NotPaths)
+}
+
+// Normalize puts the spec of the peer authentication into a deterministic
+// form, see PeerAuthenticationSpec.Normalize.
+func (pa *PeerAuthentication) Normalize() {
+	pa.Spec.Normalize()
+}
+
+// Normalize strips the empty lists and maps of the spec.
+func (s *PeerAuthenticationSpec) Normalize() {
+	normalize.StripEmpty(s)
+}
+
+// Normalize puts the spec of the request authentication into a deterministic
+// form, see RequestAuthenticationSpec.Normalize.
+func (ra *RequestAuthentication) Normalize() {
+	ra.Spec.Normalize()
+}
+
+// Normalize sorts the audiences of the JWT rules, and strips the empty lists
+// and maps.
+func (s *RequestAuthenticationSpec) Normalize() {
+	for _, rule := range s.JwtRules {
+		if rule != nil {
+			rule.Audiences = normalize.Strings(rule.Audiences)
+		}
+	}
+
+	normalize.StripEmpty(s)
+}
@@ -0,0 +1,50 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1beta1
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestAuthorizationPolicyNormalize(t *testing.T) {
+	ap := &AuthorizationPolicy{
+		Spec: AuthorizationPolicySpec{
+			Rules: []*Rule{
+				{To: []*RuleTo{{Operation: &Operation{Methods: []string{"POST", "GET"}, Paths: []string{}}}}},
+				{From: []*RuleFrom{
+					{Source: &Source{Namespaces: []string{"foo"}}},
+					{Source: &Source{Namespaces: []string{"dev", "bar"}}},
+				}},
+			},
+		},
+	}
+	expected := &AuthorizationPolicy{
+		Spec: AuthorizationPolicySpec{
+			Rules: []*Rule{
+				{From: []*RuleFrom{
+					{Source: &Source{Namespaces: []string{"bar", "dev"}}},
+					{Source: &Source{Namespaces: []string{"foo"}}},
+				}},
+				{To: []*RuleTo{{Operation: &Operation{Methods: []string{"GET", "POST"}}}}},
+			},
+		},
+	}
+
+	ap.Normalize()
+	if !reflect.DeepEqual(ap, expected) {
+		t.Errorf("expected %v, got %v", expected.Spec, ap.Spec)
+	}
+}
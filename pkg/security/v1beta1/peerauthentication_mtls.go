@@ -0,0 +1,118 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1beta1
+
+import (
+	"bytes"
+	"encoding/json"
+	"sort"
+	"strconv"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// maxPort is the highest valid port number.
+const maxPort = 65535
+
+// PortMTLSMode returns the mutual TLS mode of the port: the port level mode
+// if there is one, the mode of the workload otherwise, or UNSET.
+func (s *PeerAuthenticationSpec) PortMTLSMode(port uint32) MTLSMode {
+	if mtls, ok := s.PortLevelMtls[port]; ok && mtls != nil && mtls.Mode != "" {
+		return mtls.Mode
+	}
+	if s.Mtls != nil && s.Mtls.Mode != "" {
+		return s.Mtls.Mode
+	}
+
+	return MTLSModeUnset
+}
+
+// SetPortMTLSMode sets the port level mutual TLS mode of the port.
+func (s *PeerAuthenticationSpec) SetPortMTLSMode(port uint32, mode MTLSMode) {
+	if s.PortLevelMtls == nil {
+		s.PortLevelMtls = make(map[uint32]*PeerAuthenticationMTLS)
+	}
+	s.PortLevelMtls[port] = &PeerAuthenticationMTLS{Mode: mode}
+}
+
+// ValidatePortLevelMTLS checks that the port level settings are for valid,
+// non-zero port numbers and have a known mode.
+func (s *PeerAuthenticationSpec) ValidatePortLevelMTLS(path *field.Path) field.ErrorList {
+	var errs field.ErrorList
+	for _, port := range sortedPorts(s.PortLevelMtls) {
+		portPath := path.Key(strconv.FormatUint(uint64(port), 10))
+		if port == 0 || port > maxPort {
+			errs = append(errs, field.Invalid(portPath, port, "must be a port number between 1 and 65535"))
+		}
+
+		mtls := s.PortLevelMtls[port]
+		if mtls == nil {
+			errs = append(errs, field.Required(portPath.Child("mode"), "port level mutual TLS settings must have a mode"))
+			continue
+		}
+		switch mtls.Mode {
+		case MTLSModeUnset, MTLSModeDisable, MTLSModePermissive, MTLSModeStrict, "":
+		default:
+			errs = append(errs, field.NotSupported(portPath.Child("mode"), mtls.Mode, []string{string(MTLSModeUnset), string(MTLSModeDisable), string(MTLSModePermissive), string(MTLSModeStrict)}))
+		}
+	}
+
+	return errs
+}
+
+// MarshalJSON encodes the spec with the port level settings ordered by port
+// number, the same way Istio encodes them, rather than by the port numbers as
+// strings.
+func (s PeerAuthenticationSpec) MarshalJSON() ([]byte, error) {
+	type peerAuthenticationSpec PeerAuthenticationSpec
+	return json.Marshal(struct {
+		peerAuthenticationSpec
+		PortLevelMtls portLevelMTLS `json:"portLevelMtls,omitempty"`
+	}{
+		peerAuthenticationSpec: peerAuthenticationSpec(s),
+		PortLevelMtls:          s.PortLevelMtls,
+	})
+}
+
+type portLevelMTLS map[uint32]*PeerAuthenticationMTLS
+
+func (m portLevelMTLS) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, port := range sortedPorts(m) {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		value, err := json.Marshal(m[port])
+		if err != nil {
+			return nil, err
+		}
+		buf.WriteString(`"` + strconv.FormatUint(uint64(port), 10) + `":`)
+		buf.Write(value)
+	}
+	buf.WriteByte('}')
+
+	return buf.Bytes(), nil
+}
+
+func sortedPorts(m map[uint32]*PeerAuthenticationMTLS) []uint32 {
+	ports := make([]uint32, 0, len(m))
+	for port := range m {
+		ports = append(ports, port)
+	}
+	sort.Slice(ports, func(i, j int) bool { return ports[i] < ports[j] })
+
+	return ports
+}
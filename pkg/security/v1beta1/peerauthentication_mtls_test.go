@@ -0,0 +1,76 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1beta1
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestPeerAuthenticationSpecMarshalJSON(t *testing.T) {
+	spec := PeerAuthenticationSpec{
+		Mtls: &PeerAuthenticationMTLS{Mode: MTLSModeStrict},
+	}
+	spec.SetPortMTLSMode(9080, MTLSModeDisable)
+	spec.SetPortMTLSMode(80, MTLSModePermissive)
+	spec.SetPortMTLSMode(443, MTLSModeStrict)
+
+	data, err := json.Marshal(spec)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := `{"mtls":{"mode":"STRICT"},"portLevelMtls":{"80":{"mode":"PERMISSIVE"},"443":{"mode":"STRICT"},"9080":{"mode":"DISABLE"}}}`
+	if string(data) != expected {
+		t.Fatalf("unexpected output\n%s\nexpected\n%s", data, expected)
+	}
+
+	decoded := PeerAuthenticationSpec{}
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if decoded.PortMTLSMode(9080) != MTLSModeDisable || decoded.PortMTLSMode(8080) != MTLSModeStrict {
+		t.Fatalf("unexpected spec %+v", decoded)
+	}
+
+	data, err = json.Marshal(PeerAuthenticationSpec{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != `{}` {
+		t.Fatalf("unexpected output of the empty spec %s", data)
+	}
+}
+
+func TestValidatePortLevelMTLS(t *testing.T) {
+	spec := PeerAuthenticationSpec{
+		PortLevelMtls: map[uint32]*PeerAuthenticationMTLS{
+			0:     {Mode: MTLSModeStrict},
+			8080:  {Mode: MTLSModeStrict},
+			70000: {Mode: "STRICTER"},
+			9090:  nil,
+		},
+	}
+
+	errs := spec.ValidatePortLevelMTLS(nil)
+	expected := []string{"[0]", "[9090].mode", "[70000]", "[70000].mode"}
+	if len(errs) != len(expected) {
+		t.Fatalf("unexpected errors %v", errs)
+	}
+	for i, err := range errs {
+		if err.Field != expected[i] {
+			t.Errorf("unexpected error %v, expected one for %s", err, expected[i])
+		}
+	}
+}
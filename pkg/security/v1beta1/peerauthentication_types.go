@@ -18,8 +18,11 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	selector "github.com/banzaicloud/istio-client-go/pkg/type/v1beta1"
+
+	metav1alpha1 "github.com/banzaicloud/istio-client-go/pkg/meta/v1alpha1"
 )
 
+// +kubebuilder:validation:Enum=UNSET;DISABLE;PERMISSIVE;STRICT
 type MTLSMode string
 
 const (
@@ -35,11 +38,16 @@ const (
 
 // +genclient
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:shortName=pa,categories=istio-io;security-istio-io
+// +kubebuilder:printcolumn:name="Mode",type="string",JSONPath=".spec.mtls.mode",description="Defines the mTLS mode used for peer authentication."
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp",description="CreationTimestamp is a timestamp representing the server time when this object was created. It is not guaranteed to be set in happens-before order across separate operations. Clients may not set this value. It is represented in RFC3339 form and is in UTC. Populated by the system. Read-only. Null for lists. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#metadata"
 // PeerAuthentication
 type PeerAuthentication struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
-	Spec              PeerAuthenticationSpec `json:"spec"`
+	Spec              PeerAuthenticationSpec    `json:"spec"`
+	Status            *metav1alpha1.IstioStatus `json:"status,omitempty"`
 }
 
 // PeerAuthentication defines how traffic will be tunneled (or not) to the sidecar.
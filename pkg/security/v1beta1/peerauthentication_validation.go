@@ -0,0 +1,46 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1beta1
+
+import (
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// Validate checks the peer authentication the way Istio does before
+// accepting it.
+func (pa *PeerAuthentication) Validate() field.ErrorList {
+	return pa.Spec.Validate(field.NewPath("spec"))
+}
+
+// Validate checks the spec the way Istio does before accepting it. Port level
+// settings are only allowed for policies selecting workloads, as the ports of
+// a namespace or of the mesh are not known.
+func (s *PeerAuthenticationSpec) Validate(path *field.Path) field.ErrorList {
+	errs := validateSelector(path.Child("selector"), s.Selector)
+
+	if s.Mtls != nil {
+		switch s.Mtls.Mode {
+		case MTLSModeUnset, MTLSModeDisable, MTLSModePermissive, MTLSModeStrict, "":
+		default:
+			errs = append(errs, field.NotSupported(path.Child("mtls", "mode"), s.Mtls.Mode, []string{string(MTLSModeUnset), string(MTLSModeDisable), string(MTLSModePermissive), string(MTLSModeStrict)}))
+		}
+	}
+	if len(s.PortLevelMtls) > 0 && (s.Selector == nil || len(s.Selector.MatchLabels) == 0) {
+		errs = append(errs, field.Forbidden(path.Child("portLevelMtls"), "port level mutual TLS settings require a workload selector"))
+	}
+	errs = append(errs, s.ValidatePortLevelMTLS(path.Child("portLevelMtls"))...)
+
+	return errs
+}
@@ -0,0 +1,53 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1beta1
+
+import (
+	"testing"
+
+	selector "github.com/banzaicloud/istio-client-go/pkg/type/v1beta1"
+)
+
+func TestPeerAuthenticationValidate(t *testing.T) {
+	pa := &PeerAuthentication{
+		Spec: PeerAuthenticationSpec{
+			Selector: &selector.WorkloadSelector{MatchLabels: map[string]string{"app": "finance"}},
+			Mtls:     &PeerAuthenticationMTLS{Mode: MTLSModeStrict},
+		},
+	}
+	pa.Spec.SetPortMTLSMode(8080, MTLSModeDisable)
+	if errs := pa.Validate(); len(errs) > 0 {
+		t.Fatalf("unexpected errors %v", errs)
+	}
+
+	pa.Spec.Selector = nil
+	pa.Spec.Mtls.Mode = "OPTIONAL"
+	pa.Spec.SetPortMTLSMode(0, MTLSModeStrict)
+
+	expected := []string{
+		"spec.mtls.mode",
+		"spec.portLevelMtls",
+		"spec.portLevelMtls[0]",
+	}
+	errs := pa.Validate()
+	if len(errs) != len(expected) {
+		t.Fatalf("unexpected errors %v", errs)
+	}
+	for i, err := range errs {
+		if err.Field != expected[i] {
+			t.Errorf("unexpected error %v, expected one for %s", err, expected[i])
+		}
+	}
+}
@@ -0,0 +1,93 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1beta1
+
+import (
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/banzaicloud/istio-client-go/pkg/protoconv"
+)
+
+// ToProto converts the spec into out, a *AuthorizationPolicy of istio.io/api/security/v1beta1.
+func (s *AuthorizationPolicySpec) ToProto(out proto.Message) error {
+	return protoconv.ToProto(s, out)
+}
+
+// FromProto sets the spec from in, a *AuthorizationPolicy of istio.io/api/security/v1beta1.
+func (s *AuthorizationPolicySpec) FromProto(in proto.Message) error {
+	*s = AuthorizationPolicySpec{}
+	return protoconv.FromProto(in, s)
+}
+
+// MarshalProtoJSON encodes the spec the way Istio's own tooling does, through
+// message, an empty *AuthorizationPolicy of istio.io/api/security/v1beta1.
+func (s *AuthorizationPolicySpec) MarshalProtoJSON(message proto.Message) ([]byte, error) {
+	return protoconv.Marshal(s, message)
+}
+
+// UnmarshalProtoJSON sets the spec from data, a *AuthorizationPolicy of
+// istio.io/api/security/v1beta1 encoded the way Istio's own tooling does.
+func (s *AuthorizationPolicySpec) UnmarshalProtoJSON(data []byte, message proto.Message) error {
+	*s = AuthorizationPolicySpec{}
+	return protoconv.Unmarshal(data, message, s)
+}
+
+// ToProto converts the spec into out, a *PeerAuthentication of istio.io/api/security/v1beta1.
+func (s *PeerAuthenticationSpec) ToProto(out proto.Message) error {
+	return protoconv.ToProto(s, out)
+}
+
+// FromProto sets the spec from in, a *PeerAuthentication of istio.io/api/security/v1beta1.
+func (s *PeerAuthenticationSpec) FromProto(in proto.Message) error {
+	*s = PeerAuthenticationSpec{}
+	return protoconv.FromProto(in, s)
+}
+
+// MarshalProtoJSON encodes the spec the way Istio's own tooling does, through
+// message, an empty *PeerAuthentication of istio.io/api/security/v1beta1.
+func (s *PeerAuthenticationSpec) MarshalProtoJSON(message proto.Message) ([]byte, error) {
+	return protoconv.Marshal(s, message)
+}
+
+// UnmarshalProtoJSON sets the spec from data, a *PeerAuthentication of
+// istio.io/api/security/v1beta1 encoded the way Istio's own tooling does.
+func (s *PeerAuthenticationSpec) UnmarshalProtoJSON(data []byte, message proto.Message) error {
+	*s = PeerAuthenticationSpec{}
+	return protoconv.Unmarshal(data, message, s)
+}
+
+// ToProto converts the spec into out, a *RequestAuthentication of istio.io/api/security/v1beta1.
+func (s *RequestAuthenticationSpec) ToProto(out proto.Message) error {
+	return protoconv.ToProto(s, out)
+}
+
+// FromProto sets the spec from in, a *RequestAuthentication of istio.io/api/security/v1beta1.
+func (s *RequestAuthenticationSpec) FromProto(in proto.Message) error {
+	*s = RequestAuthenticationSpec{}
+	return protoconv.FromProto(in, s)
+}
+
+// MarshalProtoJSON encodes the spec the way Istio's own tooling does, through
+// message, an empty *RequestAuthentication of istio.io/api/security/v1beta1.
+func (s *RequestAuthenticationSpec) MarshalProtoJSON(message proto.Message) ([]byte, error) {
+	return protoconv.Marshal(s, message)
+}
+
+// UnmarshalProtoJSON sets the spec from data, a *RequestAuthentication of
+// istio.io/api/security/v1beta1 encoded the way Istio's own tooling does.
+func (s *RequestAuthenticationSpec) UnmarshalProtoJSON(data []byte, message proto.Message) error {
+	*s = RequestAuthenticationSpec{}
+	return protoconv.Unmarshal(data, message, s)
+}
@@ -35,6 +35,16 @@ func Resource(resource string) schema.GroupResource {
 	return SchemeGroupVersion.WithResource(resource).GroupResource()
 }
 
+// GroupVersionKinds and GroupVersionResources of the types in this group version
+var (
+	AuthorizationPolicyGroupVersionKind       = SchemeGroupVersion.WithKind("AuthorizationPolicy")
+	AuthorizationPolicyGroupVersionResource   = SchemeGroupVersion.WithResource("authorizationpolicies")
+	PeerAuthenticationGroupVersionKind        = SchemeGroupVersion.WithKind("PeerAuthentication")
+	PeerAuthenticationGroupVersionResource    = SchemeGroupVersion.WithResource("peerauthentications")
+	RequestAuthenticationGroupVersionKind     = SchemeGroupVersion.WithKind("RequestAuthentication")
+	RequestAuthenticationGroupVersionResource = SchemeGroupVersion.WithResource("requestauthentications")
+)
+
 var (
 	SchemeBuilder = runtime.NewSchemeBuilder(addKnownTypes)
 	AddToScheme   = SchemeBuilder.AddToScheme
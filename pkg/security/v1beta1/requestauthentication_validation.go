@@ -0,0 +1,113 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1beta1
+
+import (
+	"net"
+	"net/url"
+	"strconv"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/banzaicloud/istio-client-go/pkg/validation"
+)
+
+// Validate checks the request authentication the way Istio does before
+// accepting it.
+func (ra *RequestAuthentication) Validate() field.ErrorList {
+	return ra.Spec.Validate(field.NewPath("spec"))
+}
+
+// Validate checks the spec the way Istio does before accepting it.
+func (s *RequestAuthenticationSpec) Validate(path *field.Path) field.ErrorList {
+	errs := validateSelector(path.Child("selector"), s.Selector)
+	for i, rule := range s.JwtRules {
+		rulePath := path.Child("jwtRules").Index(i)
+		if rule == nil {
+			errs = append(errs, field.Required(rulePath, "JWT rule must not be empty"))
+			continue
+		}
+		errs = append(errs, rule.validate(rulePath)...)
+	}
+
+	return errs
+}
+
+func (r *JWTRule) validate(path *field.Path) field.ErrorList {
+	var errs field.ErrorList
+
+	if r.Issuer == "" {
+		errs = append(errs, field.Required(path.Child("issuer"), "issuer must not be empty"))
+	}
+	for i, audience := range r.Audiences {
+		if audience == "" {
+			errs = append(errs, field.Required(path.Child("audiences").Index(i), "audience must not be empty"))
+		}
+	}
+	if r.JwksURI != "" {
+		if r.Jwks != "" {
+			errs = append(errs, field.Forbidden(path.Child("jwksUri"), "only one of jwks and jwksUri may be set"))
+		}
+		errs = append(errs, validateJwksURI(path.Child("jwksUri"), r.JwksURI)...)
+	}
+	for i, header := range r.FromHeaders {
+		if header == nil || header.Name == "" {
+			errs = append(errs, field.Required(path.Child("fromHeaders").Index(i).Child("name"), "header name must not be empty"))
+		}
+	}
+	for i, param := range r.FromParams {
+		if param == "" {
+			errs = append(errs, field.Required(path.Child("fromParams").Index(i), "parameter name must not be empty"))
+		}
+	}
+
+	return errs
+}
+
+// validateJwksURI checks that the URI is an HTTP or HTTPS URL with a valid
+// host and, if there is one, port.
+func validateJwksURI(path *field.Path, uri string) field.ErrorList {
+	u, err := url.Parse(uri)
+	if err != nil {
+		return field.ErrorList{field.Invalid(path, uri, err.Error())}
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return field.ErrorList{field.Invalid(path, uri, "must be an http or https URL")}
+	}
+
+	host, port := u.Hostname(), u.Port()
+	if host == "" {
+		return field.ErrorList{field.Invalid(path, uri, "must have a host")}
+	}
+	var errs field.ErrorList
+	if net.ParseIP(host) == nil {
+		for _, err := range validation.Host(path, host) {
+			err.BadValue = uri
+			errs = append(errs, err)
+		}
+	}
+	if port != "" {
+		number, err := strconv.ParseInt(port, 10, 32)
+		if err != nil {
+			return append(errs, field.Invalid(path, uri, "must have a numeric port"))
+		}
+		for _, err := range validation.Port(path, number) {
+			err.BadValue = uri
+			errs = append(errs, err)
+		}
+	}
+
+	return errs
+}
@@ -0,0 +1,65 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1beta1
+
+import (
+	"testing"
+
+	selector "github.com/banzaicloud/istio-client-go/pkg/type/v1beta1"
+)
+
+func TestRequestAuthenticationValidate(t *testing.T) {
+	ra := &RequestAuthentication{
+		Spec: RequestAuthenticationSpec{
+			Selector: &selector.WorkloadSelector{MatchLabels: map[string]string{"app": "httpbin"}},
+			JwtRules: []*JWTRule{
+				{
+					Issuer:      "https://example.com",
+					JwksURI:     "https://example.com:8443/.well-known/jwks.json",
+					FromHeaders: []*JWTHeader{{Name: "x-jwt-assertion", Prefix: "Bearer "}},
+				},
+				{
+					Issuer: "testing@secure.istio.io",
+					Jwks:   `{"keys":[]}`,
+				},
+			},
+		},
+	}
+	if errs := ra.Validate(); len(errs) > 0 {
+		t.Fatalf("unexpected errors %v", errs)
+	}
+
+	ra.Spec.JwtRules[0].JwksURI = "ftp://example.com/jwks.json"
+	ra.Spec.JwtRules[1].Issuer = ""
+	ra.Spec.JwtRules[1].JwksURI = "https://example.com:70000/jwks.json"
+	ra.Spec.JwtRules[1].FromParams = []string{""}
+
+	expected := []string{
+		"spec.jwtRules[0].jwksUri",
+		"spec.jwtRules[1].issuer",
+		"spec.jwtRules[1].jwksUri",
+		"spec.jwtRules[1].jwksUri",
+		"spec.jwtRules[1].fromParams[0]",
+	}
+	errs := ra.Validate()
+	if len(errs) != len(expected) {
+		t.Fatalf("unexpected errors %v", errs)
+	}
+	for i, err := range errs {
+		if err.Field != expected[i] {
+			t.Errorf("unexpected error %v, expected one for %s", err, expected[i])
+		}
+	}
+}
@@ -0,0 +1,76 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1beta1
+
+import (
+	metav1alpha1 "github.com/banzaicloud/istio-client-go/pkg/meta/v1alpha1"
+)
+
+var (
+	_ metav1alpha1.ConditionsAccessor = &AuthorizationPolicy{}
+	_ metav1alpha1.ConditionsAccessor = &PeerAuthentication{}
+	_ metav1alpha1.ConditionsAccessor = &RequestAuthentication{}
+)
+
+// GetStatusConditions returns the conditions of the status of the authorization policy.
+func (a *AuthorizationPolicy) GetStatusConditions() []*metav1alpha1.IstioCondition {
+	if a.Status == nil {
+		return nil
+	}
+
+	return a.Status.Conditions
+}
+
+// SetStatusConditions sets the conditions of the status of the authorization policy.
+func (a *AuthorizationPolicy) SetStatusConditions(conditions []*metav1alpha1.IstioCondition) {
+	if a.Status == nil {
+		a.Status = &metav1alpha1.IstioStatus{}
+	}
+	a.Status.Conditions = conditions
+}
+
+// GetStatusConditions returns the conditions of the status of the peer authentication.
+func (p *PeerAuthentication) GetStatusConditions() []*metav1alpha1.IstioCondition {
+	if p.Status == nil {
+		return nil
+	}
+
+	return p.Status.Conditions
+}
+
+// SetStatusConditions sets the conditions of the status of the peer authentication.
+func (p *PeerAuthentication) SetStatusConditions(conditions []*metav1alpha1.IstioCondition) {
+	if p.Status == nil {
+		p.Status = &metav1alpha1.IstioStatus{}
+	}
+	p.Status.Conditions = conditions
+}
+
+// GetStatusConditions returns the conditions of the status of the request authentication.
+func (r *RequestAuthentication) GetStatusConditions() []*metav1alpha1.IstioCondition {
+	if r.Status == nil {
+		return nil
+	}
+
+	return r.Status.Conditions
+}
+
+// SetStatusConditions sets the conditions of the status of the request authentication.
+func (r *RequestAuthentication) SetStatusConditions(conditions []*metav1alpha1.IstioCondition) {
+	if r.Status == nil {
+		r.Status = &metav1alpha1.IstioStatus{}
+	}
+	r.Status.Conditions = conditions
+}
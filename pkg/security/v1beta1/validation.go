@@ -0,0 +1,57 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1beta1
+
+import (
+	"sort"
+
+	k8svalidation "k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	selector "github.com/banzaicloud/istio-client-go/pkg/type/v1beta1"
+	"github.com/banzaicloud/istio-client-go/pkg/validation"
+)
+
+var (
+	_ validation.Validator = &AuthorizationPolicy{}
+	_ validation.Validator = &PeerAuthentication{}
+	_ validation.Validator = &RequestAuthentication{}
+)
+
+// validateSelector checks the keys and the values of the labels of the
+// selector in key order.
+func validateSelector(path *field.Path, s *selector.WorkloadSelector) field.ErrorList {
+	if s == nil {
+		return nil
+	}
+
+	keys := make([]string, 0, len(s.MatchLabels))
+	for key := range s.MatchLabels {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var errs field.ErrorList
+	for _, key := range keys {
+		for _, msg := range k8svalidation.IsQualifiedName(key) {
+			errs = append(errs, field.Invalid(path.Child("matchLabels"), key, msg))
+		}
+		for _, msg := range k8svalidation.IsValidLabelValue(s.MatchLabels[key]) {
+			errs = append(errs, field.Invalid(path.Child("matchLabels").Key(key), s.MatchLabels[key], msg))
+		}
+	}
+
+	return errs
+}
@@ -20,6 +20,7 @@
 package v1beta1
 
 import (
+	metav1alpha1 "github.com/banzaicloud/istio-client-go/pkg/meta/v1alpha1"
 	typev1beta1 "github.com/banzaicloud/istio-client-go/pkg/type/v1beta1"
 	"k8s.io/apimachinery/pkg/runtime"
 )
@@ -30,6 +31,11 @@ func (in *AuthorizationPolicy) DeepCopyInto(out *AuthorizationPolicy) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(metav1alpha1.IstioStatus)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthorizationPolicy.
@@ -101,6 +107,11 @@ func (in *AuthorizationPolicySpec) DeepCopyInto(out *AuthorizationPolicySpec) {
 			}
 		}
 	}
+	if in.Provider != nil {
+		in, out := &in.Provider, &out.Provider
+		*out = new(ExtensionProvider)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthorizationPolicySpec.
@@ -138,6 +149,21 @@ func (in *Condition) DeepCopy() *Condition {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExtensionProvider) DeepCopyInto(out *ExtensionProvider) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExtensionProvider.
+func (in *ExtensionProvider) DeepCopy() *ExtensionProvider {
+	if in == nil {
+		return nil
+	}
+	out := new(ExtensionProvider)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *JWTHeader) DeepCopyInto(out *JWTHeader) {
 	*out = *in
@@ -250,6 +276,11 @@ func (in *PeerAuthentication) DeepCopyInto(out *PeerAuthentication) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(metav1alpha1.IstioStatus)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PeerAuthentication.
@@ -363,6 +394,11 @@ func (in *RequestAuthentication) DeepCopyInto(out *RequestAuthentication) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(metav1alpha1.IstioStatus)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RequestAuthentication.
@@ -0,0 +1,103 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package selector converts the workload selectors of Istio resources to
+// label selectors, to find the workloads the resources apply to. The workload
+// selectors are the WorkloadSelector types of the networking packages, the
+// one of the type package used by the security kinds, and the selector label
+// map of the gateways.
+//
+// Like a missing selector in Istio, a nil selector selects every workload of
+// the namespace of the resource.
+package selector
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	networkingv1alpha3 "github.com/banzaicloud/istio-client-go/pkg/networking/v1alpha3"
+	networkingv1beta1 "github.com/banzaicloud/istio-client-go/pkg/networking/v1beta1"
+	typev1beta1 "github.com/banzaicloud/istio-client-go/pkg/type/v1beta1"
+)
+
+// MatchLabels returns the labels the workload selector matches, nil for a
+// nil selector. It panics if ws is not a workload selector.
+func MatchLabels(ws interface{}) map[string]string {
+	switch ws := ws.(type) {
+	case nil:
+		return nil
+	case *networkingv1beta1.WorkloadSelector:
+		if ws == nil {
+			return nil
+		}
+		return ws.Labels
+	case *networkingv1alpha3.WorkloadSelector:
+		if ws == nil {
+			return nil
+		}
+		return ws.Labels
+	case *typev1beta1.WorkloadSelector:
+		if ws == nil {
+			return nil
+		}
+		return ws.MatchLabels
+	case map[string]string:
+		return ws
+	default:
+		panic(fmt.Sprintf("%T is not a workload selector", ws))
+	}
+}
+
+// ToLabelsSelector returns the label selector of the workload selector.
+func ToLabelsSelector(ws interface{}) labels.Selector {
+	return labels.SelectorFromSet(MatchLabels(ws))
+}
+
+// Matches returns true if the workload selector selects the pod, or the
+// workload entry, with the labels.
+func Matches(ws interface{}, podLabels map[string]string) bool {
+	return ToLabelsSelector(ws).Matches(labels.Set(podLabels))
+}
+
+// ForObject returns the labels the workload selector of the Istio object
+// matches, read from its JSON form so that both API versions and the
+// unstructured objects are supported: the workloadSelector of the networking
+// kinds, the selector of the security kinds and the selector of the gateways.
+// The labels are nil if the object has no workload selector.
+func ForObject(obj runtime.Object) (map[string]string, error) {
+	var content map[string]interface{}
+	if u, ok := obj.(runtime.Unstructured); ok {
+		content = u.UnstructuredContent()
+	} else {
+		var err error
+		if content, err = runtime.DefaultUnstructuredConverter.ToUnstructured(obj); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, path := range [][]string{
+		{"spec", "workloadSelector", "labels"},
+		{"spec", "selector", "matchLabels"},
+		{"spec", "selector"},
+	} {
+		if selected, found, err := unstructured.NestedStringMap(content, path...); err == nil && found && len(selected) > 0 {
+			return selected, nil
+		}
+	}
+
+	return nil, nil
+}
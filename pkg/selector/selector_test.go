@@ -0,0 +1,96 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package selector
+
+import (
+	"reflect"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	networkingv1alpha3 "github.com/banzaicloud/istio-client-go/pkg/networking/v1alpha3"
+	networkingv1beta1 "github.com/banzaicloud/istio-client-go/pkg/networking/v1beta1"
+	securityv1beta1 "github.com/banzaicloud/istio-client-go/pkg/security/v1beta1"
+	typev1beta1 "github.com/banzaicloud/istio-client-go/pkg/type/v1beta1"
+)
+
+func TestMatches(t *testing.T) {
+	pod := map[string]string{"app": "ratings", "version": "v1"}
+	var nilSelector *typev1beta1.WorkloadSelector
+	tests := []struct {
+		name     string
+		ws       interface{}
+		expected bool
+	}{
+		{name: "networking", ws: &networkingv1beta1.WorkloadSelector{Labels: map[string]string{"app": "ratings"}}, expected: true},
+		{name: "v1alpha3", ws: &networkingv1alpha3.WorkloadSelector{Labels: map[string]string{"app": "reviews"}}, expected: false},
+		{name: "security", ws: &typev1beta1.WorkloadSelector{MatchLabels: map[string]string{"app": "ratings", "version": "v1"}}, expected: true},
+		{name: "gateway", ws: map[string]string{"istio": "ingressgateway"}, expected: false},
+		{name: "nil", ws: nil, expected: true},
+		{name: "typed nil", ws: nilSelector, expected: true},
+	}
+
+	for _, test := range tests {
+		if matches := Matches(test.ws, pod); matches != test.expected {
+			t.Errorf("%s: Matches returned %v, expected %v", test.name, matches, test.expected)
+		}
+	}
+}
+
+func TestForObject(t *testing.T) {
+	tests := []struct {
+		name     string
+		obj      runtime.Object
+		expected map[string]string
+	}{
+		{
+			name: "sidecar",
+			obj: &networkingv1beta1.Sidecar{Spec: networkingv1beta1.SidecarSpec{
+				WorkloadSelector: &networkingv1beta1.WorkloadSelector{Labels: map[string]string{"app": "ratings"}},
+			}},
+			expected: map[string]string{"app": "ratings"},
+		},
+		{
+			name: "peer authentication",
+			obj: &securityv1beta1.PeerAuthentication{Spec: securityv1beta1.PeerAuthenticationSpec{
+				Selector: &typev1beta1.WorkloadSelector{MatchLabels: map[string]string{"app": "httpbin"}},
+			}},
+			expected: map[string]string{"app": "httpbin"},
+		},
+		{
+			name: "unstructured gateway",
+			obj: &unstructured.Unstructured{Object: map[string]interface{}{
+				"spec": map[string]interface{}{"selector": map[string]interface{}{"istio": "ingressgateway"}},
+			}},
+			expected: map[string]string{"istio": "ingressgateway"},
+		},
+		{
+			name: "authorization policy without a selector",
+			obj:  &securityv1beta1.AuthorizationPolicy{},
+		},
+	}
+
+	for _, test := range tests {
+		selected, err := ForObject(test.obj)
+		if err != nil {
+			t.Errorf("%s: unexpected error %v", test.name, err)
+			continue
+		}
+		if !reflect.DeepEqual(selected, test.expected) {
+			t.Errorf("%s: unexpected labels %v, expected %v", test.name, selected, test.expected)
+		}
+	}
+}
@@ -0,0 +1,186 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package sidecar resolves the sidecar configuration in effect for a
+// workload, and the egress hosts it may reach, the way Istio picks it, e.g.
+//
+//	effective := sidecar.Resolve("istio-system", sidecars, "prod", map[string]string{"app": "httpbin"})
+//	if !effective.Allows("payments", "ledger.payments.svc.cluster.local") {
+//		...
+//	}
+//
+// The sidecar with a workload selector selecting the workload in its
+// namespace applies first, then the sidecar without a selector of its
+// namespace, then the sidecar without a selector of the root namespace. The
+// sidecars with a selector in the root namespace only apply to the workloads
+// of the root namespace. Without any sidecar, the workload may reach every
+// host of the mesh.
+//
+// Istio leaves undefined which sidecar applies when several apply at the same
+// level. The oldest one is used then, and the others are reported as
+// conflicts.
+package sidecar
+
+import (
+	"strings"
+
+	"github.com/banzaicloud/istio-client-go/pkg/host"
+	networkingv1beta1 "github.com/banzaicloud/istio-client-go/pkg/networking/v1beta1"
+	"github.com/banzaicloud/istio-client-go/pkg/selector"
+)
+
+// Level is the level of the sidecar in effect for a workload.
+type Level string
+
+const (
+	// LevelNone is the level of the workloads without any sidecar
+	LevelNone Level = ""
+	// LevelWorkload is the level of the sidecars selecting the workload
+	LevelWorkload Level = "Workload"
+	// LevelNamespace is the level of the sidecars without a selector in the
+	// namespace of the workload
+	LevelNamespace Level = "Namespace"
+	// LevelMesh is the level of the sidecars without a selector in the root
+	// namespace
+	LevelMesh Level = "Mesh"
+)
+
+// Namespace wildcards of the egress hosts.
+const (
+	anyNamespace     = "*"
+	currentNamespace = "."
+	noNamespace      = "~"
+)
+
+// Effective is the sidecar configuration in effect for a workload.
+type Effective struct {
+	// Sidecar is the sidecar in effect, nil if none applies
+	Sidecar *networkingv1beta1.Sidecar
+	// Level is the level of the sidecar
+	Level Level
+	// Conflicts are the other sidecars applying at the same level, for
+	// which Istio leaves undefined which one is in effect
+	Conflicts []*networkingv1beta1.Sidecar
+	// EgressHosts are the egress hosts of the sidecar, in the
+	// namespace/dnsName format, with the current namespace resolved to the
+	// namespace of the workload. They are */* without a sidecar.
+	EgressHosts []string
+	// OutboundTrafficPolicy is the outbound traffic policy mode of the
+	// sidecar, empty if it does not override the one of the mesh
+	OutboundTrafficPolicy networkingv1beta1.OutboundTrafficPolicyMode
+}
+
+// Ambiguous returns true if several sidecars apply at the same level.
+func (e Effective) Ambiguous() bool {
+	return len(e.Conflicts) > 0
+}
+
+// Allows returns true if the egress hosts of the workload import the host of
+// the namespace, e.g. ledger.payments.svc.cluster.local of the payments
+// namespace.
+func (e Effective) Allows(namespace, hostname string) bool {
+	for _, egress := range e.EgressHosts {
+		parts := strings.SplitN(egress, "/", 2)
+		if len(parts) != 2 || parts[0] == noNamespace {
+			continue
+		}
+		if (parts[0] == anyNamespace || parts[0] == namespace) && host.SubsetOf(hostname, parts[1]) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Resolve returns the sidecar configuration in effect for the workload of
+// the namespace with the labels, from the sidecars of the mesh of the root
+// namespace.
+func Resolve(rootNamespace string, sidecars []*networkingv1beta1.Sidecar, namespace string, labels map[string]string) Effective {
+	var workload, namespaced, mesh []*networkingv1beta1.Sidecar
+	for _, sidecar := range sidecars {
+		switch {
+		case sidecar == nil:
+		case len(selector.MatchLabels(sidecar.Spec.WorkloadSelector)) > 0:
+			if sidecar.Namespace == namespace && selector.Matches(sidecar.Spec.WorkloadSelector, labels) {
+				workload = append(workload, sidecar)
+			}
+		case sidecar.Namespace == namespace:
+			namespaced = append(namespaced, sidecar)
+		case sidecar.Namespace == rootNamespace:
+			mesh = append(mesh, sidecar)
+		}
+	}
+
+	var e Effective
+	for _, level := range []struct {
+		level    Level
+		sidecars []*networkingv1beta1.Sidecar
+	}{
+		{LevelWorkload, workload},
+		{LevelNamespace, namespaced},
+		{LevelMesh, mesh},
+	} {
+		if len(level.sidecars) == 0 {
+			continue
+		}
+		e.Level = level.level
+		e.Sidecar = level.sidecars[0]
+		for _, sidecar := range level.sidecars[1:] {
+			if older(sidecar, e.Sidecar) {
+				e.Sidecar = sidecar
+			}
+		}
+		for _, sidecar := range level.sidecars {
+			if sidecar != e.Sidecar {
+				e.Conflicts = append(e.Conflicts, sidecar)
+			}
+		}
+		break
+	}
+
+	if e.Sidecar == nil {
+		e.EgressHosts = []string{anyNamespace + "/*"}
+		return e
+	}
+	if policy := e.Sidecar.Spec.OutboundTrafficPolicy; policy != nil && policy.Mode != nil {
+		e.OutboundTrafficPolicy = *policy.Mode
+	}
+	seen := map[string]bool{}
+	for _, listener := range e.Sidecar.Spec.Egress {
+		if listener == nil {
+			continue
+		}
+		for _, egress := range listener.Hosts {
+			if strings.HasPrefix(egress, currentNamespace+"/") {
+				egress = namespace + egress[len(currentNamespace):]
+			}
+			if !seen[egress] {
+				seen[egress] = true
+				e.EgressHosts = append(e.EgressHosts, egress)
+			}
+		}
+	}
+
+	return e
+}
+
+// older returns true if the sidecar is older than the other, by creation time
+// and then by name.
+func older(sidecar, other *networkingv1beta1.Sidecar) bool {
+	if sidecar.CreationTimestamp.Equal(&other.CreationTimestamp) {
+		return sidecar.Name < other.Name
+	}
+
+	return sidecar.CreationTimestamp.Before(&other.CreationTimestamp)
+}
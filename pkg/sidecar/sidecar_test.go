@@ -0,0 +1,151 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sidecar
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	networkingv1beta1 "github.com/banzaicloud/istio-client-go/pkg/networking/v1beta1"
+)
+
+var created = time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func sidecar(namespace, name string, age int, labels map[string]string, hosts ...string) *networkingv1beta1.Sidecar {
+	s := &networkingv1beta1.Sidecar{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:         namespace,
+			Name:              name,
+			CreationTimestamp: metav1.NewTime(created.Add(-time.Duration(age) * time.Hour)),
+		},
+		Spec: networkingv1beta1.SidecarSpec{
+			Egress: []*networkingv1beta1.IstioEgressListener{{Hosts: hosts}},
+		},
+	}
+	if labels != nil {
+		s.Spec.WorkloadSelector = &networkingv1beta1.WorkloadSelector{Labels: labels}
+	}
+
+	return s
+}
+
+func TestResolve(t *testing.T) {
+	httpbin := map[string]string{"app": "httpbin"}
+	mesh := sidecar("istio-system", "default", 0, nil, "./*", "istio-system/*")
+	namespace := sidecar("prod", "default", 0, nil, "./*", "./*", "payments/ledger.payments.svc.cluster.local")
+	workload := sidecar("prod", "httpbin", 0, httpbin, "~/*")
+	older := sidecar("prod", "httpbin-older", 1, map[string]string{"version": "v1"}, "*/*")
+	rootSelector := sidecar("istio-system", "httpbin", 0, httpbin, "*/*")
+	registryOnly := networkingv1beta1.OutboundTrafficPolicyRegistryOnly
+	restricted := sidecar("prod", "default", 0, nil, "./*")
+	restricted.Spec.OutboundTrafficPolicy = &networkingv1beta1.OutboundTrafficPolicy{Mode: &registryOnly}
+
+	tests := []struct {
+		name     string
+		sidecars []*networkingv1beta1.Sidecar
+		labels   map[string]string
+		expected Effective
+	}{
+		{
+			name:     "no sidecar",
+			sidecars: []*networkingv1beta1.Sidecar{rootSelector},
+			labels:   httpbin,
+			expected: Effective{EgressHosts: []string{"*/*"}},
+		},
+		{
+			name:     "mesh",
+			sidecars: []*networkingv1beta1.Sidecar{mesh},
+			labels:   httpbin,
+			expected: Effective{Sidecar: mesh, Level: LevelMesh, EgressHosts: []string{"prod/*", "istio-system/*"}},
+		},
+		{
+			name:     "namespace",
+			sidecars: []*networkingv1beta1.Sidecar{mesh, namespace},
+			labels:   httpbin,
+			expected: Effective{Sidecar: namespace, Level: LevelNamespace, EgressHosts: []string{"prod/*", "payments/ledger.payments.svc.cluster.local"}},
+		},
+		{
+			name:     "workload",
+			sidecars: []*networkingv1beta1.Sidecar{mesh, namespace, workload},
+			labels:   httpbin,
+			expected: Effective{Sidecar: workload, Level: LevelWorkload, EgressHosts: []string{"~/*"}},
+		},
+		{
+			name:     "unselected workload",
+			sidecars: []*networkingv1beta1.Sidecar{namespace, workload},
+			labels:   map[string]string{"app": "ratings"},
+			expected: Effective{Sidecar: namespace, Level: LevelNamespace, EgressHosts: []string{"prod/*", "payments/ledger.payments.svc.cluster.local"}},
+		},
+		{
+			name:     "several selectors",
+			sidecars: []*networkingv1beta1.Sidecar{workload, older},
+			labels:   map[string]string{"app": "httpbin", "version": "v1"},
+			expected: Effective{
+				Sidecar:     older,
+				Level:       LevelWorkload,
+				Conflicts:   []*networkingv1beta1.Sidecar{workload},
+				EgressHosts: []string{"*/*"},
+			},
+		},
+		{
+			name:     "outbound traffic policy",
+			sidecars: []*networkingv1beta1.Sidecar{restricted},
+			labels:   httpbin,
+			expected: Effective{
+				Sidecar:               restricted,
+				Level:                 LevelNamespace,
+				EgressHosts:           []string{"prod/*"},
+				OutboundTrafficPolicy: registryOnly,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		effective := Resolve("istio-system", test.sidecars, "prod", test.labels)
+		if !reflect.DeepEqual(effective, test.expected) {
+			t.Errorf("%s: unexpected result %+v, expected %+v", test.name, effective, test.expected)
+		}
+		if effective.Ambiguous() != (len(test.expected.Conflicts) > 0) {
+			t.Errorf("%s: unexpected ambiguity", test.name)
+		}
+	}
+}
+
+func TestAllows(t *testing.T) {
+	effective := Effective{EgressHosts: []string{"prod/*", "payments/*.payments.svc.cluster.local", "*/httpbin.org", "~/*"}}
+	tests := []struct {
+		namespace string
+		host      string
+		expected  bool
+	}{
+		{"prod", "reviews.prod.svc.cluster.local", true},
+		{"payments", "ledger.payments.svc.cluster.local", true},
+		{"payments", "ledger.example.com", false},
+		{"external", "httpbin.org", true},
+		{"test", "reviews.test.svc.cluster.local", false},
+	}
+
+	for _, test := range tests {
+		if allowed := effective.Allows(test.namespace, test.host); allowed != test.expected {
+			t.Errorf("%s/%s: unexpected result %t", test.namespace, test.host, allowed)
+		}
+	}
+	if (Effective{EgressHosts: []string{"~/*"}}).Allows("prod", "reviews.prod.svc.cluster.local") {
+		t.Error("expected ~/* to allow no host")
+	}
+}
@@ -0,0 +1,34 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+// DeepCopyItemsInto deep copies the items of the list into dst and returns the
+// result, to snapshot caches without allocating the items again. The backing
+// array of dst is reused when its capacity is large enough; the items it held
+// are overwritten.
+func (in *TelemetryList) DeepCopyItemsInto(dst []Telemetry) []Telemetry {
+	if in == nil {
+		return dst[:0]
+	}
+	if cap(dst) < len(in.Items) {
+		dst = make([]Telemetry, len(in.Items))
+	} else {
+		dst = dst[:len(in.Items)]
+	}
+	for i := range in.Items {
+		in.Items[i].DeepCopyInto(&dst[i])
+	}
+	return dst
+}
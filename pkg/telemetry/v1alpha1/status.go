@@ -0,0 +1,38 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	metav1alpha1 "github.com/banzaicloud/istio-client-go/pkg/meta/v1alpha1"
+)
+
+var _ metav1alpha1.ConditionsAccessor = &Telemetry{}
+
+// GetStatusConditions returns the conditions of the status of the telemetry.
+func (t *Telemetry) GetStatusConditions() []*metav1alpha1.IstioCondition {
+	if t.Status == nil {
+		return nil
+	}
+
+	return t.Status.Conditions
+}
+
+// SetStatusConditions sets the conditions of the status of the telemetry.
+func (t *Telemetry) SetStatusConditions(conditions []*metav1alpha1.IstioCondition) {
+	if t.Status == nil {
+		t.Status = &metav1alpha1.IstioStatus{}
+	}
+	t.Status.Conditions = conditions
+}
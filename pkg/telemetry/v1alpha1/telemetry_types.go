@@ -0,0 +1,296 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	metav1alpha1 "github.com/banzaicloud/istio-client-go/pkg/meta/v1alpha1"
+	selector "github.com/banzaicloud/istio-client-go/pkg/type/v1beta1"
+)
+
+// +kubebuilder:validation:Enum=CLIENT_AND_SERVER;CLIENT;SERVER
+type WorkloadMode string
+
+const (
+	// Selects the behavior of the workload as both a client and a server.
+	WorkloadModeClientAndServer WorkloadMode = "CLIENT_AND_SERVER"
+	// Selects the behavior of the workload when it sends requests.
+	WorkloadModeClient WorkloadMode = "CLIENT"
+	// Selects the behavior of the workload when it receives requests.
+	WorkloadModeServer WorkloadMode = "SERVER"
+)
+
+// +kubebuilder:validation:Enum=ALL_METRICS;REQUEST_COUNT;REQUEST_DURATION;REQUEST_SIZE;RESPONSE_SIZE;TCP_OPENED_CONNECTIONS;TCP_CLOSED_CONNECTIONS;TCP_SENT_BYTES;TCP_RECEIVED_BYTES;GRPC_REQUEST_MESSAGES;GRPC_RESPONSE_MESSAGES
+type IstioMetric string
+
+const (
+	IstioMetricAllMetrics           IstioMetric = "ALL_METRICS"
+	IstioMetricRequestCount         IstioMetric = "REQUEST_COUNT"
+	IstioMetricRequestDuration      IstioMetric = "REQUEST_DURATION"
+	IstioMetricRequestSize          IstioMetric = "REQUEST_SIZE"
+	IstioMetricResponseSize         IstioMetric = "RESPONSE_SIZE"
+	IstioMetricTCPOpenedConnections IstioMetric = "TCP_OPENED_CONNECTIONS"
+	IstioMetricTCPClosedConnections IstioMetric = "TCP_CLOSED_CONNECTIONS"
+	IstioMetricTCPSentBytes         IstioMetric = "TCP_SENT_BYTES"
+	IstioMetricTCPReceivedBytes     IstioMetric = "TCP_RECEIVED_BYTES"
+	IstioMetricGRPCRequestMessages  IstioMetric = "GRPC_REQUEST_MESSAGES"
+	IstioMetricGRPCResponseMessages IstioMetric = "GRPC_RESPONSE_MESSAGES"
+)
+
+// +kubebuilder:validation:Enum=UPSERT;REMOVE
+type TagOverrideOperation string
+
+const (
+	// Inserts or updates the tag with the value.
+	TagOverrideOperationUpsert TagOverrideOperation = "UPSERT"
+	// Removes the tag.
+	TagOverrideOperationRemove TagOverrideOperation = "REMOVE"
+)
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:shortName=telemetry,categories=istio-io;telemetry-istio-io
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp",description="CreationTimestamp is a timestamp representing the server time when this object was created. It is not guaranteed to be set in happens-before order across separate operations. Clients may not set this value. It is represented in RFC3339 form and is in UTC. Populated by the system. Read-only. Null for lists. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#metadata"
+// Telemetry
+type Telemetry struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              TelemetrySpec             `json:"spec"`
+	Status            *metav1alpha1.IstioStatus `json:"status,omitempty"`
+}
+
+// Telemetry defines how the telemetry is generated for workloads within a mesh.
+//
+// The hierarchy of Telemetry configuration is as follows:
+// 1. Workload-specific configuration
+// 2. Namespace-specific configuration
+// 3. Root namespace configuration
+//
+// Examples:
+//
+// Policy to enable random sampling for 10% of traffic:
+// ```yaml
+// apiVersion: telemetry.istio.io/v1alpha1
+// kind: Telemetry
+// metadata:
+//   name: mesh-default
+//   namespace: istio-system
+// spec:
+//   tracing:
+//   - randomSamplingPercentage: 10.00
+// ```
+//
+// Policy to enable access logging for the workloads of namespace `foo`
+// labeled `app: httpbin`:
+// ```yaml
+// apiVersion: telemetry.istio.io/v1alpha1
+// kind: Telemetry
+// metadata:
+//   name: httpbin-logging
+//   namespace: foo
+// spec:
+//   selector:
+//     matchLabels:
+//       app: httpbin
+//   accessLogging:
+//   - providers:
+//     - name: envoy
+// ```
+//
+// Policy to disable span reporting for the workloads of namespace `foo`:
+// ```yaml
+// apiVersion: telemetry.istio.io/v1alpha1
+// kind: Telemetry
+// metadata:
+//   name: no-tracing
+//   namespace: foo
+// spec:
+//   tracing:
+//   - disableSpanReporting: true
+// ```
+type TelemetrySpec struct {
+	// Optional. The selector decides where to apply the Telemetry policy.
+	// If not set, the Telemetry policy will be applied to all workloads in the
+	// same namespace as the Telemetry policy.
+	Selector *selector.WorkloadSelector `json:"selector,omitempty"`
+	// Optional. Tracing configures the tracing behavior for all
+	// selected workloads.
+	Tracing []*Tracing `json:"tracing,omitempty"`
+	// Optional. Metrics configures the metrics behavior for all
+	// selected workloads.
+	Metrics []*Metrics `json:"metrics,omitempty"`
+	// Optional. AccessLogging configures the access logging behavior for all
+	// selected workloads.
+	AccessLogging []*AccessLogging `json:"accessLogging,omitempty"`
+}
+
+// Tracing configures tracing behavior for workloads within a mesh.
+type Tracing struct {
+	// Allows tailoring of behavior to specific conditions.
+	Match *TracingSelector `json:"match,omitempty"`
+	// Optional. Name of provider(s) to use for span reporting. If a provider is
+	// not specified, the default tracing provider will be used.
+	Providers []*ProviderRef `json:"providers,omitempty"`
+	// Controls the rate at which traffic will be selected for tracing if no
+	// prior sampling decision has been made. If a prior sampling decision has
+	// been made, that decision will be respected. Value ranges from 0.0 to
+	// 100.0.
+	RandomSamplingPercentage *float64 `json:"randomSamplingPercentage,omitempty"`
+	// Controls span reporting. If set to true, no spans will be reported for
+	// impacted workloads. This does NOT impact context propagation or trace
+	// sampling behavior.
+	DisableSpanReporting *bool `json:"disableSpanReporting,omitempty"`
+	// Optional. Configures additional custom tags to the generated trace spans.
+	CustomTags map[string]*TracingCustomTag `json:"customTags,omitempty"`
+	// Controls whether or not the request ID is used to decide if traffic is
+	// selected for tracing.
+	UseRequestIDForTraceSampling *bool `json:"useRequestIdForTraceSampling,omitempty"`
+}
+
+// TracingSelector provides a coarse-grained ability to configure tracing
+// behavior based on certain traffic metadata (such as traffic direction).
+type TracingSelector struct {
+	// This determines whether or not to apply tracing configuration based on
+	// the direction of traffic relative to the proxied workload.
+	Mode WorkloadMode `json:"mode,omitempty"`
+}
+
+// TracingCustomTag defines a tag to be added to a trace span that is based on
+// an operator-supplied value. Exactly one of the fields is set.
+type TracingCustomTag struct {
+	// Literal adds the same, hard-coded value to each span.
+	Literal *TracingLiteral `json:"literal,omitempty"`
+	// Environment adds the value of an environment variable to each span.
+	Environment *TracingEnvironment `json:"environment,omitempty"`
+	// RequestHeader adds the value of an header from the request to each span.
+	Header *TracingRequestHeader `json:"header,omitempty"`
+}
+
+type TracingLiteral struct {
+	// The tag value to use.
+	Value string `json:"value,omitempty"`
+}
+
+type TracingEnvironment struct {
+	// Name of the environment variable from which to extract the tag value.
+	Name string `json:"name,omitempty"`
+	// Optional. If the environment variable is not found, this value will be
+	// used instead.
+	DefaultValue string `json:"defaultValue,omitempty"`
+}
+
+type TracingRequestHeader struct {
+	// Name of the header from which to extract the tag value.
+	Name string `json:"name,omitempty"`
+	// Optional. If the header is not found, this value will be used instead.
+	DefaultValue string `json:"defaultValue,omitempty"`
+}
+
+// ProviderRef references an extension provider of the MeshConfig of Istio by
+// name.
+type ProviderRef struct {
+	// Name of Telemetry provider in MeshConfig.
+	Name string `json:"name,omitempty"`
+}
+
+// Metrics configures metrics behavior for workloads within a mesh.
+type Metrics struct {
+	// Optional. Name of providers to which this configuration should apply.
+	// If a provider is not specified, the default metrics provider will be
+	// used.
+	Providers []*ProviderRef `json:"providers,omitempty"`
+	// Optional. Ordered list of overrides to metrics generation behavior.
+	Overrides []*MetricsOverrides `json:"overrides,omitempty"`
+}
+
+// MetricSelector provides a mechanism for selecting metrics for the
+// application of an override behavior.
+type MetricSelector struct {
+	// One of the well-known Istio Standard Metrics.
+	Metric IstioMetric `json:"metric,omitempty"`
+	// Allows free-form specification of a metric. No validation of custom
+	// metrics is provided.
+	CustomMetric string `json:"customMetric,omitempty"`
+	// Controls which mode of metrics generation is selected: CLIENT and/or
+	// SERVER.
+	Mode WorkloadMode `json:"mode,omitempty"`
+}
+
+// MetricsOverrides defines custom metric generation behavior for an
+// individual metric or the set of all standard metrics.
+type MetricsOverrides struct {
+	// Match allows provides the scope of the override. If match is not
+	// specified, the override will apply to all metrics for both the client
+	// and server modes.
+	Match *MetricSelector `json:"match,omitempty"`
+	// Optional. Must explicitly disable a metric.
+	Disabled *bool `json:"disabled,omitempty"`
+	// Optional. Collection of tag names and tag expressions to override in
+	// the selected metric(s).
+	TagOverrides map[string]*MetricsOverridesTagOverride `json:"tagOverrides,omitempty"`
+}
+
+// MetricsOverridesTagOverride specifies an operation to perform on a metric
+// dimension (also known as a label).
+type MetricsOverridesTagOverride struct {
+	// Operation controls whether or not to update/add a tag, or to remove it.
+	Operation TagOverrideOperation `json:"operation,omitempty"`
+	// Value is only considered if the operation is UPSERT, and is an
+	// expression of the attributes of the request.
+	Value string `json:"value,omitempty"`
+}
+
+// AccessLogging configures access logging behavior for workloads within a
+// mesh.
+type AccessLogging struct {
+	// Allows tailoring of logging behavior to specific conditions.
+	Match *AccessLoggingLogSelector `json:"match,omitempty"`
+	// Optional. Name of providers to which this configuration should apply.
+	// If a provider is not specified, the default logging provider will be
+	// used.
+	Providers []*ProviderRef `json:"providers,omitempty"`
+	// Controls logging. If set to true, no access logs will be generated for
+	// impacted workloads (for the specified providers).
+	Disabled *bool `json:"disabled,omitempty"`
+	// Optional. If specified, this filter will be used to select specific
+	// log entries.
+	Filter *AccessLoggingFilter `json:"filter,omitempty"`
+}
+
+// AccessLoggingLogSelector provides a coarse-grained ability to configure
+// logging behavior based on certain traffic metadata (such as traffic
+// direction).
+type AccessLoggingLogSelector struct {
+	// This determines whether or not to apply access logging configuration
+	// based on the direction of traffic relative to the proxied workload.
+	Mode WorkloadMode `json:"mode,omitempty"`
+}
+
+// AccessLoggingFilter allows selection of the access logs to generate.
+type AccessLoggingFilter struct {
+	// CEL expression for selecting when requests/connections should be
+	// logged, e.g. `response.code >= 400`.
+	Expression string `json:"expression,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// TelemetryList is a list of Telemetry resources
+type TelemetryList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+	Items           []Telemetry `json:"items"`
+}
@@ -0,0 +1,195 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	"sort"
+
+	k8svalidation "k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	selector "github.com/banzaicloud/istio-client-go/pkg/type/v1beta1"
+	"github.com/banzaicloud/istio-client-go/pkg/validation"
+)
+
+var _ validation.Validator = &Telemetry{}
+
+// Validate checks the telemetry the way Istio does before accepting it.
+func (t *Telemetry) Validate() field.ErrorList {
+	return t.Spec.Validate(field.NewPath("spec"))
+}
+
+// Validate checks the spec the way Istio does before accepting it. Istio only
+// supports a single tracing configuration, reporting to a single provider.
+func (s *TelemetrySpec) Validate(path *field.Path) field.ErrorList {
+	errs := validateSelector(path.Child("selector"), s.Selector)
+
+	if len(s.Tracing) > 1 {
+		errs = append(errs, field.Forbidden(path.Child("tracing"), "multiple tracing configurations are not supported"))
+	}
+	for i, tracing := range s.Tracing {
+		if tracing != nil {
+			errs = append(errs, tracing.validate(path.Child("tracing").Index(i))...)
+		}
+	}
+	for i, metrics := range s.Metrics {
+		if metrics != nil {
+			errs = append(errs, metrics.validate(path.Child("metrics").Index(i))...)
+		}
+	}
+	for i, logging := range s.AccessLogging {
+		if logging != nil {
+			errs = append(errs, validateProviders(path.Child("accessLogging").Index(i).Child("providers"), logging.Providers)...)
+		}
+	}
+
+	return errs
+}
+
+func (t *Tracing) validate(path *field.Path) field.ErrorList {
+	errs := validateProviders(path.Child("providers"), t.Providers)
+	if len(t.Providers) > 1 {
+		errs = append(errs, field.Forbidden(path.Child("providers"), "multiple tracing providers are not supported"))
+	}
+	if t.RandomSamplingPercentage != nil {
+		errs = append(errs, validation.Percentage(path.Child("randomSamplingPercentage"), *t.RandomSamplingPercentage)...)
+	}
+
+	names := make([]string, 0, len(t.CustomTags))
+	for name := range t.CustomTags {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		tagPath := path.Child("customTags").Key(name)
+		if name == "" {
+			errs = append(errs, field.Invalid(tagPath, name, "tag name must not be empty"))
+		}
+		errs = append(errs, t.CustomTags[name].validate(tagPath)...)
+	}
+
+	return errs
+}
+
+// validate checks that exactly one of the sources of the tag value is set.
+func (t *TracingCustomTag) validate(path *field.Path) field.ErrorList {
+	var set []string
+	var errs field.ErrorList
+	if t != nil && t.Literal != nil {
+		set = append(set, "literal")
+		if t.Literal.Value == "" {
+			errs = append(errs, field.Required(path.Child("literal", "value"), "literal tag value must not be empty"))
+		}
+	}
+	if t != nil && t.Environment != nil {
+		set = append(set, "environment")
+		if t.Environment.Name == "" {
+			errs = append(errs, field.Required(path.Child("environment", "name"), "environment variable name must not be empty"))
+		}
+	}
+	if t != nil && t.Header != nil {
+		set = append(set, "header")
+		if t.Header.Name == "" {
+			errs = append(errs, field.Required(path.Child("header", "name"), "header name must not be empty"))
+		}
+	}
+	switch len(set) {
+	case 0:
+		errs = append(errs, field.Required(path, "one of literal, environment or header must be set"))
+	case 1:
+	default:
+		errs = append(errs, field.Invalid(path, set, "only one of literal, environment or header may be set"))
+	}
+
+	return errs
+}
+
+func (m *Metrics) validate(path *field.Path) field.ErrorList {
+	errs := validateProviders(path.Child("providers"), m.Providers)
+	for i, override := range m.Overrides {
+		if override == nil {
+			continue
+		}
+		overridePath := path.Child("overrides").Index(i)
+		if override.Match != nil && override.Match.Metric != "" && override.Match.CustomMetric != "" {
+			errs = append(errs, field.Invalid(overridePath.Child("match"), override.Match.CustomMetric, "only one of metric or customMetric may be set"))
+		}
+
+		names := make([]string, 0, len(override.TagOverrides))
+		for name := range override.TagOverrides {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			tagPath := overridePath.Child("tagOverrides").Key(name)
+			tag := override.TagOverrides[name]
+			if tag == nil {
+				continue
+			}
+			switch tag.Operation {
+			case TagOverrideOperationUpsert, "":
+				if tag.Value == "" {
+					errs = append(errs, field.Required(tagPath.Child("value"), "a value is required to upsert the tag"))
+				}
+			case TagOverrideOperationRemove:
+				if tag.Value != "" {
+					errs = append(errs, field.Invalid(tagPath.Child("value"), tag.Value, "a removed tag has no value"))
+				}
+			default:
+				errs = append(errs, field.NotSupported(tagPath.Child("operation"), tag.Operation, []string{string(TagOverrideOperationUpsert), string(TagOverrideOperationRemove)}))
+			}
+		}
+	}
+
+	return errs
+}
+
+// validateProviders checks that the providers are referenced by name.
+func validateProviders(path *field.Path, providers []*ProviderRef) field.ErrorList {
+	var errs field.ErrorList
+	for i, provider := range providers {
+		if provider == nil || provider.Name == "" {
+			errs = append(errs, field.Required(path.Index(i).Child("name"), "provider name must not be empty"))
+		}
+	}
+
+	return errs
+}
+
+// validateSelector checks the keys and the values of the labels of the
+// selector in key order.
+func validateSelector(path *field.Path, s *selector.WorkloadSelector) field.ErrorList {
+	if s == nil {
+		return nil
+	}
+
+	keys := make([]string, 0, len(s.MatchLabels))
+	for key := range s.MatchLabels {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var errs field.ErrorList
+	for _, key := range keys {
+		for _, msg := range k8svalidation.IsQualifiedName(key) {
+			errs = append(errs, field.Invalid(path.Child("matchLabels"), key, msg))
+		}
+		for _, msg := range k8svalidation.IsValidLabelValue(s.MatchLabels[key]) {
+			errs = append(errs, field.Invalid(path.Child("matchLabels").Key(key), s.MatchLabels[key], msg))
+		}
+	}
+
+	return errs
+}
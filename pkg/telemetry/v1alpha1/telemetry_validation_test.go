@@ -0,0 +1,73 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	"testing"
+
+	selector "github.com/banzaicloud/istio-client-go/pkg/type/v1beta1"
+)
+
+func TestTelemetryValidate(t *testing.T) {
+	percentage := 10.0
+	tm := &Telemetry{
+		Spec: TelemetrySpec{
+			Selector: &selector.WorkloadSelector{MatchLabels: map[string]string{"app": "httpbin"}},
+			Tracing: []*Tracing{{
+				Providers:                []*ProviderRef{{Name: "zipkin"}},
+				RandomSamplingPercentage: &percentage,
+				CustomTags: map[string]*TracingCustomTag{
+					"cluster": {Environment: &TracingEnvironment{Name: "CLUSTER_ID"}},
+				},
+			}},
+			Metrics: []*Metrics{{
+				Overrides: []*MetricsOverrides{{
+					Match:        &MetricSelector{Metric: IstioMetricRequestCount},
+					TagOverrides: map[string]*MetricsOverridesTagOverride{"request_host": {Operation: TagOverrideOperationRemove}},
+				}},
+			}},
+			AccessLogging: []*AccessLogging{{Providers: []*ProviderRef{{Name: "envoy"}}}},
+		},
+	}
+	if errs := tm.Validate(); len(errs) > 0 {
+		t.Fatalf("unexpected errors %v", errs)
+	}
+
+	percentage = 110
+	tm.Spec.Tracing[0].Providers = append(tm.Spec.Tracing[0].Providers, &ProviderRef{})
+	tm.Spec.Tracing[0].CustomTags["user"] = &TracingCustomTag{Literal: &TracingLiteral{Value: "x"}, Header: &TracingRequestHeader{Name: "x-user"}}
+	tm.Spec.Tracing = append(tm.Spec.Tracing, &Tracing{})
+	tm.Spec.Metrics[0].Overrides[0].TagOverrides["request_host"].Value = "request.host"
+	tm.Spec.AccessLogging[0].Providers[0].Name = ""
+
+	expected := []string{
+		"spec.tracing",
+		"spec.tracing[0].providers[1].name",
+		"spec.tracing[0].providers",
+		"spec.tracing[0].randomSamplingPercentage",
+		"spec.tracing[0].customTags[user]",
+		"spec.metrics[0].overrides[0].tagOverrides[request_host].value",
+		"spec.accessLogging[0].providers[0].name",
+	}
+	errs := tm.Validate()
+	if len(errs) != len(expected) {
+		t.Fatalf("unexpected errors %v", errs)
+	}
+	for i, err := range errs {
+		if err.Field != expected[i] {
+			t.Errorf("unexpected error %v, expected one for %s", err, expected[i])
+		}
+	}
+}
@@ -0,0 +1,189 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package telemetrybuilder builds v1alpha1 telemetries for the common
+// observability intents without spelling out their nested literals, e.g.
+// enabling the access logs of a workload with a provider:
+//
+//	tm, err := telemetrybuilder.New("httpbin-logging").Namespace("foo").
+//		Selector(map[string]string{"app": "httpbin"}).
+//		AccessLogging("envoy").
+//		Build()
+//
+// or sampling 10% of the traces of the mesh:
+//
+//	tm, err := telemetrybuilder.New("mesh-default").Namespace("istio-system").
+//		TracingSampling(10).
+//		Build()
+//
+// The tracing methods all set the single tracing configuration Istio
+// supports. Build validates the telemetry too.
+package telemetrybuilder
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/banzaicloud/istio-client-go/pkg/telemetry/v1alpha1"
+	selector "github.com/banzaicloud/istio-client-go/pkg/type/v1beta1"
+)
+
+// Builder builds a telemetry.
+type Builder struct {
+	tm v1alpha1.Telemetry
+}
+
+// New returns a builder of the telemetry with the name.
+func New(name string) *Builder {
+	return &Builder{
+		tm: v1alpha1.Telemetry{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: v1alpha1.TelemetryGroupVersionKind.GroupVersion().String(),
+				Kind:       v1alpha1.TelemetryGroupVersionKind.Kind,
+			},
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+		},
+	}
+}
+
+// Namespace sets the namespace of the telemetry. A telemetry in the root
+// namespace of Istio applies to the whole mesh.
+func (b *Builder) Namespace(namespace string) *Builder {
+	b.tm.Namespace = namespace
+	return b
+}
+
+// Labels adds labels to the telemetry.
+func (b *Builder) Labels(labels map[string]string) *Builder {
+	if b.tm.Labels == nil {
+		b.tm.Labels = make(map[string]string, len(labels))
+	}
+	for key, value := range labels {
+		b.tm.Labels[key] = value
+	}
+	return b
+}
+
+// Selector selects the workloads with the labels. The telemetry applies to
+// every workload of its namespace without a selector.
+func (b *Builder) Selector(labels map[string]string) *Builder {
+	b.tm.Spec.Selector = &selector.WorkloadSelector{MatchLabels: labels}
+	return b
+}
+
+// AccessLogging enables the access logs of the providers, or of the default
+// provider of the mesh without providers.
+func (b *Builder) AccessLogging(providers ...string) *Builder {
+	disabled := false
+	b.tm.Spec.AccessLogging = append(b.tm.Spec.AccessLogging, &v1alpha1.AccessLogging{
+		Providers: providerRefs(providers),
+		Disabled:  &disabled,
+	})
+	return b
+}
+
+// AccessLoggingFilter enables the access logs of the providers, or of the
+// default provider of the mesh without providers, for the requests matching
+// the CEL expression, e.g. response.code >= 400
+func (b *Builder) AccessLoggingFilter(expression string, providers ...string) *Builder {
+	b.AccessLogging(providers...)
+	b.tm.Spec.AccessLogging[len(b.tm.Spec.AccessLogging)-1].Filter = &v1alpha1.AccessLoggingFilter{Expression: expression}
+	return b
+}
+
+// DisableAccessLogging disables the access logs of the providers, or of the
+// default provider of the mesh without providers.
+func (b *Builder) DisableAccessLogging(providers ...string) *Builder {
+	disabled := true
+	b.tm.Spec.AccessLogging = append(b.tm.Spec.AccessLogging, &v1alpha1.AccessLogging{
+		Providers: providerRefs(providers),
+		Disabled:  &disabled,
+	})
+	return b
+}
+
+// TracingProvider reports the spans to the provider instead of the default
+// provider of the mesh.
+func (b *Builder) TracingProvider(provider string) *Builder {
+	b.tracing().Providers = providerRefs([]string{provider})
+	return b
+}
+
+// TracingSampling samples the percentage of the requests without a prior
+// sampling decision, between 0 and 100.
+func (b *Builder) TracingSampling(percentage float64) *Builder {
+	b.tracing().RandomSamplingPercentage = &percentage
+	return b
+}
+
+// DisableSpanReporting stops reporting spans, without affecting the
+// propagation of the trace context.
+func (b *Builder) DisableSpanReporting() *Builder {
+	disabled := true
+	b.tracing().DisableSpanReporting = &disabled
+	return b
+}
+
+// TracingLiteralTag adds the tag with the value to every span.
+func (b *Builder) TracingLiteralTag(name, value string) *Builder {
+	return b.tracingTag(name, &v1alpha1.TracingCustomTag{Literal: &v1alpha1.TracingLiteral{Value: value}})
+}
+
+// TracingHeaderTag adds the tag with the value of the request header, or the
+// default value without the header, to every span.
+func (b *Builder) TracingHeaderTag(name, header, defaultValue string) *Builder {
+	return b.tracingTag(name, &v1alpha1.TracingCustomTag{Header: &v1alpha1.TracingRequestHeader{Name: header, DefaultValue: defaultValue}})
+}
+
+// TracingEnvironmentTag adds the tag with the value of the environment
+// variable of the proxy, or the default value without the variable, to every
+// span.
+func (b *Builder) TracingEnvironmentTag(name, variable, defaultValue string) *Builder {
+	return b.tracingTag(name, &v1alpha1.TracingCustomTag{Environment: &v1alpha1.TracingEnvironment{Name: variable, DefaultValue: defaultValue}})
+}
+
+// Build returns the telemetry, or the errors of its validation as a
+// utilerrors.Aggregate.
+func (b *Builder) Build() (*v1alpha1.Telemetry, error) {
+	tm := b.tm.DeepCopy()
+	if errs := tm.Validate(); len(errs) > 0 {
+		return nil, errs.ToAggregate()
+	}
+
+	return tm, nil
+}
+
+func (b *Builder) tracingTag(name string, tag *v1alpha1.TracingCustomTag) *Builder {
+	tracing := b.tracing()
+	if tracing.CustomTags == nil {
+		tracing.CustomTags = make(map[string]*v1alpha1.TracingCustomTag)
+	}
+	tracing.CustomTags[name] = tag
+	return b
+}
+
+// tracing returns the tracing configuration, adding it first if needed.
+func (b *Builder) tracing() *v1alpha1.Tracing {
+	if len(b.tm.Spec.Tracing) == 0 {
+		b.tm.Spec.Tracing = append(b.tm.Spec.Tracing, &v1alpha1.Tracing{})
+	}
+	return b.tm.Spec.Tracing[0]
+}
+
+func providerRefs(providers []string) []*v1alpha1.ProviderRef {
+	var refs []*v1alpha1.ProviderRef
+	for _, provider := range providers {
+		refs = append(refs, &v1alpha1.ProviderRef{Name: provider})
+	}
+	return refs
+}
@@ -0,0 +1,81 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package telemetrybuilder
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/banzaicloud/istio-client-go/pkg/telemetry/v1alpha1"
+)
+
+func TestAccessLogging(t *testing.T) {
+	tm, err := New("httpbin-logging").Namespace("foo").
+		Selector(map[string]string{"app": "httpbin"}).
+		AccessLogging("envoy").
+		AccessLoggingFilter("response.code >= 400", "otel").
+		Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if tm.Kind != "Telemetry" || tm.APIVersion != "telemetry.istio.io/v1alpha1" || tm.Spec.Selector.MatchLabels["app"] != "httpbin" {
+		t.Errorf("unexpected telemetry %+v", tm)
+	}
+	disabled := false
+	expected := []*v1alpha1.AccessLogging{
+		{Providers: []*v1alpha1.ProviderRef{{Name: "envoy"}}, Disabled: &disabled},
+		{Providers: []*v1alpha1.ProviderRef{{Name: "otel"}}, Disabled: &disabled, Filter: &v1alpha1.AccessLoggingFilter{Expression: "response.code >= 400"}},
+	}
+	if !reflect.DeepEqual(tm.Spec.AccessLogging, expected) {
+		t.Errorf("unexpected access logging %v", tm.Spec.AccessLogging)
+	}
+
+	tm, err = New("no-logging").DisableAccessLogging().Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if logging := tm.Spec.AccessLogging[0]; logging.Providers != nil || !*logging.Disabled {
+		t.Errorf("unexpected access logging %+v", logging)
+	}
+}
+
+func TestTracing(t *testing.T) {
+	tm, err := New("mesh-default").Namespace("istio-system").
+		TracingSampling(10).
+		TracingProvider("zipkin").
+		TracingLiteralTag("cluster", "east").
+		TracingHeaderTag("user", "x-user", "anonymous").
+		Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(tm.Spec.Tracing) != 1 {
+		t.Fatalf("unexpected tracing %v", tm.Spec.Tracing)
+	}
+	tracing := tm.Spec.Tracing[0]
+	if *tracing.RandomSamplingPercentage != 10 || tracing.Providers[0].Name != "zipkin" {
+		t.Errorf("unexpected tracing %+v", tracing)
+	}
+	if tracing.CustomTags["cluster"].Literal.Value != "east" || tracing.CustomTags["user"].Header.DefaultValue != "anonymous" {
+		t.Errorf("unexpected custom tags %v", tracing.CustomTags)
+	}
+
+	if _, err := New("sampling").TracingSampling(200).Build(); err == nil || !strings.Contains(err.Error(), "spec.tracing[0].randomSamplingPercentage") {
+		t.Errorf("expected a sampling percentage above 100 to be invalid, got %v", err)
+	}
+}
@@ -0,0 +1,317 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package testutil makes valid v1beta1 Istio objects for unit tests in one
+// line, e.g.
+//
+//	vs := testutil.NewVirtualService(testutil.WithNamespace("prod"), testutil.WithHosts("ratings"))
+//
+// Every object is made with defaults that pass its validation, modeled on
+// the reviews service of the Bookinfo sample in the default namespace, and
+// the options change what the test cares about. The objects are new on every
+// call, so tests are free to modify them further. For objects with more
+// elaborate specs see the vsbuilder, drbuilder and apbuilder packages.
+package testutil
+
+import (
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	networkingv1beta1 "github.com/banzaicloud/istio-client-go/pkg/networking/v1beta1"
+	securityv1beta1 "github.com/banzaicloud/istio-client-go/pkg/security/v1beta1"
+	selector "github.com/banzaicloud/istio-client-go/pkg/type/v1beta1"
+)
+
+// DefaultNamespace is the namespace of the objects made without WithNamespace.
+const DefaultNamespace = "default"
+
+// Option modifies an object made by the New functions. The options specific
+// to some kinds, such as WithHosts, panic when applied to the other kinds.
+type Option func(obj runtime.Object)
+
+// WithName sets the name of the object.
+func WithName(name string) Option {
+	return func(obj runtime.Object) {
+		obj.(metav1.Object).SetName(name)
+	}
+}
+
+// WithNamespace sets the namespace of the object.
+func WithNamespace(namespace string) Option {
+	return func(obj runtime.Object) {
+		obj.(metav1.Object).SetNamespace(namespace)
+	}
+}
+
+// WithLabels adds labels to the object.
+func WithLabels(labels map[string]string) Option {
+	return func(obj runtime.Object) {
+		meta := obj.(metav1.Object)
+		meta.SetLabels(merge(meta.GetLabels(), labels))
+	}
+}
+
+// WithAnnotations adds annotations to the object.
+func WithAnnotations(annotations map[string]string) Option {
+	return func(obj runtime.Object) {
+		meta := obj.(metav1.Object)
+		meta.SetAnnotations(merge(meta.GetAnnotations(), annotations))
+	}
+}
+
+// WithHosts replaces the hosts of a virtual service, the destinations of its
+// routes included, of a service entry, of the servers of a gateway, or of the
+// egress listeners of a sidecar. A destination rule takes the first host.
+func WithHosts(hosts ...string) Option {
+	return func(obj runtime.Object) {
+		switch obj := obj.(type) {
+		case *networkingv1beta1.VirtualService:
+			obj.Spec.Hosts = hosts
+			for _, route := range obj.Spec.HTTP {
+				for _, destination := range route.Route {
+					destination.Destination.Host = hosts[0]
+				}
+			}
+		case *networkingv1beta1.DestinationRule:
+			obj.Spec.Host = hosts[0]
+		case *networkingv1beta1.ServiceEntry:
+			obj.Spec.Hosts = hosts
+		case *networkingv1beta1.Gateway:
+			for i := range obj.Spec.Servers {
+				obj.Spec.Servers[i].Hosts = hosts
+			}
+		case *networkingv1beta1.Sidecar:
+			for _, listener := range obj.Spec.Egress {
+				listener.Hosts = hosts
+			}
+		default:
+			panic(fmt.Sprintf("testutil: WithHosts does not apply to %T", obj))
+		}
+	}
+}
+
+// WithSelector replaces the workload selector of a gateway, of a sidecar or
+// of a security policy.
+func WithSelector(labels map[string]string) Option {
+	return func(obj runtime.Object) {
+		switch obj := obj.(type) {
+		case *networkingv1beta1.Gateway:
+			obj.Spec.Selector = labels
+		case *networkingv1beta1.Sidecar:
+			obj.Spec.WorkloadSelector = &networkingv1beta1.WorkloadSelector{Labels: labels}
+		case *securityv1beta1.AuthorizationPolicy:
+			obj.Spec.Selector = &selector.WorkloadSelector{MatchLabels: labels}
+		case *securityv1beta1.PeerAuthentication:
+			obj.Spec.Selector = &selector.WorkloadSelector{MatchLabels: labels}
+		case *securityv1beta1.RequestAuthentication:
+			obj.Spec.Selector = &selector.WorkloadSelector{MatchLabels: labels}
+		default:
+			panic(fmt.Sprintf("testutil: WithSelector does not apply to %T", obj))
+		}
+	}
+}
+
+// NewVirtualService returns a virtual service named reviews, routing the
+// reviews host to the reviews service.
+func NewVirtualService(opts ...Option) *networkingv1beta1.VirtualService {
+	vs := &networkingv1beta1.VirtualService{
+		TypeMeta:   typeMeta(networkingv1beta1.VirtualServiceGroupVersionKind),
+		ObjectMeta: objectMeta("reviews"),
+		Spec: networkingv1beta1.VirtualServiceSpec{
+			Hosts: []string{"reviews"},
+			HTTP: []networkingv1beta1.HTTPRoute{{
+				Route: []*networkingv1beta1.HTTPRouteDestination{{
+					Destination: &networkingv1beta1.Destination{Host: "reviews"},
+				}},
+			}},
+		},
+	}
+	apply(vs, opts)
+
+	return vs
+}
+
+// NewDestinationRule returns a destination rule named reviews, with a v1
+// subset of the reviews host.
+func NewDestinationRule(opts ...Option) *networkingv1beta1.DestinationRule {
+	dr := &networkingv1beta1.DestinationRule{
+		TypeMeta:   typeMeta(networkingv1beta1.DestinationRuleGroupVersionKind),
+		ObjectMeta: objectMeta("reviews"),
+		Spec: networkingv1beta1.DestinationRuleSpec{
+			Host: "reviews",
+			Subsets: []networkingv1beta1.Subset{{
+				Name:   "v1",
+				Labels: map[string]string{"version": "v1"},
+			}},
+		},
+	}
+	apply(dr, opts)
+
+	return dr
+}
+
+// NewGateway returns a gateway named bookinfo-gateway, exposing every host on
+// port 80 of the ingress gateway.
+func NewGateway(opts ...Option) *networkingv1beta1.Gateway {
+	gateway := &networkingv1beta1.Gateway{
+		TypeMeta:   typeMeta(networkingv1beta1.GatewayGroupVersionKind),
+		ObjectMeta: objectMeta("bookinfo-gateway"),
+		Spec: networkingv1beta1.GatewaySpec{
+			Selector: map[string]string{"istio": "ingressgateway"},
+			Servers: []networkingv1beta1.Server{{
+				Port:  &networkingv1beta1.Port{Number: 80, Name: "http", Protocol: networkingv1beta1.ProtocolHTTP},
+				Hosts: []string{"*"},
+			}},
+		},
+	}
+	apply(gateway, opts)
+
+	return gateway
+}
+
+// NewServiceEntry returns a service entry named external, adding the HTTPS
+// port of example.com to the mesh.
+func NewServiceEntry(opts ...Option) *networkingv1beta1.ServiceEntry {
+	location, resolution := networkingv1beta1.MeshExternal, networkingv1beta1.DNS
+	se := &networkingv1beta1.ServiceEntry{
+		TypeMeta:   typeMeta(networkingv1beta1.ServiceEntryGroupVersionKind),
+		ObjectMeta: objectMeta("external"),
+		Spec: networkingv1beta1.ServiceEntrySpec{
+			Hosts:      []string{"example.com"},
+			Ports:      []*networkingv1beta1.Port{{Number: 443, Name: "https", Protocol: networkingv1beta1.ProtocolTLS}},
+			Location:   &location,
+			Resolution: &resolution,
+		},
+	}
+	apply(se, opts)
+
+	return se
+}
+
+// NewSidecar returns a sidecar named default, limiting the egress of the
+// workloads of its namespace to their own namespace and istio-system.
+func NewSidecar(opts ...Option) *networkingv1beta1.Sidecar {
+	sidecar := &networkingv1beta1.Sidecar{
+		TypeMeta:   typeMeta(networkingv1beta1.SidecarGroupVersionKind),
+		ObjectMeta: objectMeta("default"),
+		Spec: networkingv1beta1.SidecarSpec{
+			Egress: []*networkingv1beta1.IstioEgressListener{{
+				Hosts: []string{"./*", "istio-system/*"},
+			}},
+		},
+	}
+	apply(sidecar, opts)
+
+	return sidecar
+}
+
+// NewWorkloadEntry returns a workload entry named reviews-vm, of a virtual
+// machine of the reviews service.
+func NewWorkloadEntry(opts ...Option) *networkingv1beta1.WorkloadEntry {
+	we := &networkingv1beta1.WorkloadEntry{
+		TypeMeta:   typeMeta(networkingv1beta1.WorkloadEntryGroupVersionKind),
+		ObjectMeta: objectMeta("reviews-vm"),
+		Spec: networkingv1beta1.WorkloadEntrySpec{
+			Address: "10.0.0.1",
+			Labels:  map[string]string{"app": "reviews"},
+		},
+	}
+	apply(we, opts)
+
+	return we
+}
+
+// NewAuthorizationPolicy returns an authorization policy named reviews,
+// allowing the requests of its namespace to the reviews workloads.
+func NewAuthorizationPolicy(opts ...Option) *securityv1beta1.AuthorizationPolicy {
+	ap := &securityv1beta1.AuthorizationPolicy{
+		TypeMeta:   typeMeta(securityv1beta1.AuthorizationPolicyGroupVersionKind),
+		ObjectMeta: objectMeta("reviews"),
+		Spec: securityv1beta1.AuthorizationPolicySpec{
+			Selector: &selector.WorkloadSelector{MatchLabels: map[string]string{"app": "reviews"}},
+			Action:   securityv1beta1.AuthorizationPolicyActionAllow,
+			Rules: []*securityv1beta1.Rule{{
+				From: []*securityv1beta1.RuleFrom{{
+					Source: &securityv1beta1.Source{Namespaces: []string{DefaultNamespace}},
+				}},
+			}},
+		},
+	}
+	apply(ap, opts)
+
+	return ap
+}
+
+// NewPeerAuthentication returns a peer authentication named default,
+// requiring mutual TLS in its namespace.
+func NewPeerAuthentication(opts ...Option) *securityv1beta1.PeerAuthentication {
+	pa := &securityv1beta1.PeerAuthentication{
+		TypeMeta:   typeMeta(securityv1beta1.PeerAuthenticationGroupVersionKind),
+		ObjectMeta: objectMeta("default"),
+		Spec: securityv1beta1.PeerAuthenticationSpec{
+			Mtls: &securityv1beta1.PeerAuthenticationMTLS{Mode: securityv1beta1.MTLSModeStrict},
+		},
+	}
+	apply(pa, opts)
+
+	return pa
+}
+
+// NewRequestAuthentication returns a request authentication named reviews,
+// accepting the JWTs of the https://example.com issuer at the reviews
+// workloads.
+func NewRequestAuthentication(opts ...Option) *securityv1beta1.RequestAuthentication {
+	ra := &securityv1beta1.RequestAuthentication{
+		TypeMeta:   typeMeta(securityv1beta1.RequestAuthenticationGroupVersionKind),
+		ObjectMeta: objectMeta("reviews"),
+		Spec: securityv1beta1.RequestAuthenticationSpec{
+			Selector: &selector.WorkloadSelector{MatchLabels: map[string]string{"app": "reviews"}},
+			JwtRules: []*securityv1beta1.JWTRule{{
+				Issuer:  "https://example.com",
+				JwksURI: "https://example.com/.well-known/jwks.json",
+			}},
+		},
+	}
+	apply(ra, opts)
+
+	return ra
+}
+
+func typeMeta(gvk schema.GroupVersionKind) metav1.TypeMeta {
+	return metav1.TypeMeta{APIVersion: gvk.GroupVersion().String(), Kind: gvk.Kind}
+}
+
+func objectMeta(name string) metav1.ObjectMeta {
+	return metav1.ObjectMeta{Name: name, Namespace: DefaultNamespace}
+}
+
+func apply(obj runtime.Object, opts []Option) {
+	for _, opt := range opts {
+		opt(obj)
+	}
+}
+
+func merge(m, other map[string]string) map[string]string {
+	if m == nil {
+		m = make(map[string]string, len(other))
+	}
+	for key, value := range other {
+		m[key] = value
+	}
+
+	return m
+}
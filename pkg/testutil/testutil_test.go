@@ -0,0 +1,78 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package testutil
+
+import (
+	"reflect"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func TestDefaultsAreValid(t *testing.T) {
+	validators := map[string]interface{ Validate() field.ErrorList }{
+		"VirtualService":        NewVirtualService(),
+		"DestinationRule":       NewDestinationRule(),
+		"Sidecar":               NewSidecar(),
+		"AuthorizationPolicy":   NewAuthorizationPolicy(),
+		"PeerAuthentication":    NewPeerAuthentication(),
+		"RequestAuthentication": NewRequestAuthentication(),
+	}
+	for kind, obj := range validators {
+		if errs := obj.Validate(); len(errs) > 0 {
+			t.Errorf("expected the default %s to be valid, got %v", kind, errs)
+		}
+	}
+}
+
+func TestOptions(t *testing.T) {
+	vs := NewVirtualService(
+		WithName("ratings"),
+		WithNamespace("prod"),
+		WithLabels(map[string]string{"app": "ratings"}),
+		WithAnnotations(map[string]string{"owner": "team"}),
+		WithHosts("ratings.prod.svc.cluster.local"),
+	)
+	if vs.Name != "ratings" || vs.Namespace != "prod" {
+		t.Errorf("expected prod/ratings, got %s/%s", vs.Namespace, vs.Name)
+	}
+	if vs.Labels["app"] != "ratings" || vs.Annotations["owner"] != "team" {
+		t.Errorf("expected the labels and annotations to be set, got %v and %v", vs.Labels, vs.Annotations)
+	}
+	if host := vs.Spec.HTTP[0].Route[0].Destination.Host; !reflect.DeepEqual(vs.Spec.Hosts, []string{host}) {
+		t.Errorf("expected the hosts and the destination to be replaced, got %v and %s", vs.Spec.Hosts, host)
+	}
+	if errs := vs.Validate(); len(errs) > 0 {
+		t.Errorf("expected the virtual service to stay valid, got %v", errs)
+	}
+
+	ap := NewAuthorizationPolicy(WithSelector(map[string]string{"app": "ratings"}))
+	if ap.Spec.Selector.MatchLabels["app"] != "ratings" {
+		t.Errorf("expected the selector to be replaced, got %v", ap.Spec.Selector.MatchLabels)
+	}
+
+	if NewVirtualService() == NewVirtualService() || NewVirtualService().Spec.HTTP[0].Route[0] == vs.Spec.HTTP[0].Route[0] {
+		t.Error("expected every call to return a new object")
+	}
+}
+
+func TestOptionOfAnotherKindPanics(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("expected WithSelector to panic on a virtual service")
+		}
+	}()
+	_ = NewVirtualService(WithSelector(map[string]string{"app": "reviews"}))
+}
@@ -0,0 +1,204 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package validation holds the checks shared by the Validate methods of the
+// Istio kinds, which mirror the validation of Istio itself.
+package validation
+
+import (
+	"net"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/banzaicloud/istio-client-go/pkg/common/v1alpha1"
+)
+
+// Validator is implemented by the kinds which check themselves the way Istio
+// does before accepting them.
+type Validator interface {
+	Validate() field.ErrorList
+}
+
+// Host checks that the host is a DNS name, which may start with a wildcard
+// label, e.g. *.example.com, an IP address or the * wildcard. Istio matches
+// hosts case-insensitively.
+func Host(path *field.Path, host string) field.ErrorList {
+	if host == "" {
+		return field.ErrorList{field.Required(path, "host must not be empty")}
+	}
+	if host == "*" || net.ParseIP(host) != nil {
+		return nil
+	}
+
+	var msgs []string
+	if strings.HasPrefix(host, "*.") {
+		msgs = validation.IsWildcardDNS1123Subdomain(strings.ToLower(host))
+	} else {
+		msgs = validation.IsDNS1123Subdomain(strings.ToLower(host))
+	}
+
+	var errs field.ErrorList
+	for _, msg := range msgs {
+		errs = append(errs, field.Invalid(path, host, msg))
+	}
+
+	return errs
+}
+
+// Port checks that the number is a valid, non-zero port number.
+func Port(path *field.Path, port int64) field.ErrorList {
+	var errs field.ErrorList
+	for _, msg := range validation.IsValidPortNum(int(port)) {
+		errs = append(errs, field.Invalid(path, port, msg))
+	}
+
+	return errs
+}
+
+// ServicePort checks the number and the protocol of the port at path, the name
+// if set, which must be a DNS label, and the target port if set.
+func ServicePort(path *field.Path, port *v1alpha1.Port) field.ErrorList {
+	if port == nil {
+		return field.ErrorList{field.Required(path, "port is required")}
+	}
+
+	errs := Port(path.Child("number"), int64(port.Number))
+	if err := port.Protocol.Validate(); err != nil {
+		errs = append(errs, field.NotSupported(path.Child("protocol"), port.Protocol, portProtocolNames()))
+	}
+	if port.Name != "" {
+		for _, msg := range validation.IsDNS1123Label(port.Name) {
+			errs = append(errs, field.Invalid(path.Child("name"), port.Name, msg))
+		}
+	}
+	if port.TargetPort != 0 {
+		errs = append(errs, Port(path.Child("targetPort"), int64(port.TargetPort))...)
+	}
+
+	return errs
+}
+
+func portProtocolNames() []string {
+	names := make([]string, 0, len(v1alpha1.PortProtocols))
+	for _, protocol := range v1alpha1.PortProtocols {
+		names = append(names, string(protocol))
+	}
+
+	return names
+}
+
+// Percentage checks that the value is between 0 and 100.
+func Percentage(path *field.Path, value float64) field.ErrorList {
+	if value < 0 || value > 100 {
+		return field.ErrorList{field.Invalid(path, value, "must be between 0 and 100")}
+	}
+
+	return nil
+}
+
+// Duration checks that the duration is at least v1alpha1.MinDuration.
+func Duration(path *field.Path, d v1alpha1.Duration) field.ErrorList {
+	if err := d.Validate(); err != nil {
+		return field.ErrorList{field.Invalid(path, d.String(), err.Error())}
+	}
+
+	return nil
+}
+
+// StringMatch checks that exactly one of the fields of the match is set.
+func StringMatch(path *field.Path, match *v1alpha1.StringMatch) field.ErrorList {
+	if match == nil {
+		return nil
+	}
+	if err := match.Validate(); err != nil {
+		return field.ErrorList{field.Invalid(path, match, err.Error())}
+	}
+
+	return nil
+}
+
+// TLSProtocols checks the minimum and the maximum TLS protocol versions of
+// the TLS settings at path.
+func TLSProtocols(path *field.Path, min, max *v1alpha1.TLSProtocol) field.ErrorList {
+	var errs field.ErrorList
+	if min != nil && min.Validate() != nil {
+		errs = append(errs, field.NotSupported(path.Child("minProtocolVersion"), *min, tlsProtocolNames()))
+	}
+	if max != nil && max.Validate() != nil {
+		errs = append(errs, field.NotSupported(path.Child("maxProtocolVersion"), *max, tlsProtocolNames()))
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+
+	if err := v1alpha1.ValidateTLSProtocolRange(min, max); err != nil {
+		return field.ErrorList{field.Invalid(path.Child("minProtocolVersion"), *min, err.Error())}
+	}
+
+	return nil
+}
+
+func tlsProtocolNames() []string {
+	names := make([]string, 0, len(v1alpha1.TLSProtocols))
+	for _, protocol := range v1alpha1.TLSProtocols {
+		names = append(names, string(protocol))
+	}
+
+	return names
+}
+
+// CipherSuites checks that the cipher suites are supported by Envoy and
+// listed once.
+func CipherSuites(path *field.Path, suites []v1alpha1.TLSCipherSuite) field.ErrorList {
+	var errs field.ErrorList
+	seen := make(map[v1alpha1.TLSCipherSuite]bool, len(suites))
+	for i, suite := range suites {
+		if err := suite.Validate(); err != nil {
+			errs = append(errs, field.Invalid(path.Index(i), suite, err.Error()))
+		}
+		if seen[suite] {
+			errs = append(errs, field.Duplicate(path.Index(i), suite))
+		}
+		seen[suite] = true
+	}
+
+	return errs
+}
+
+// Weights checks the weights of the destinations of a route: a single
+// destination may omit its weight, otherwise the weights must add up to 100.
+func Weights(path *field.Path, weights []*int32) field.ErrorList {
+	if len(weights) == 0 || (len(weights) == 1 && weights[0] == nil) {
+		return nil
+	}
+
+	var errs field.ErrorList
+	var total int64
+	for i, weight := range weights {
+		if weight == nil {
+			continue
+		}
+		if *weight < 0 || *weight > 100 {
+			errs = append(errs, field.Invalid(path.Index(i).Child("weight"), *weight, "must be between 0 and 100"))
+		}
+		total += int64(*weight)
+	}
+	if total != 100 {
+		errs = append(errs, field.Invalid(path, total, "the weights of the destinations must add up to 100"))
+	}
+
+	return errs
+}
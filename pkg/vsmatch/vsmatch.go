@@ -0,0 +1,249 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package vsmatch simulates the HTTP routing of virtual services: it tells
+// which route, and which destinations, Istio picks for a request, e.g.
+//
+//	result, ok := vsmatch.Resolve(vs, vsmatch.Request{
+//		Method:    "GET",
+//		Authority: "reviews.prod.svc.cluster.local",
+//		Path:      "/api/v1/reviews?user=jason",
+//	})
+//
+// The routes are tried in order and the first one matching the request is
+// picked. A route matches if any of its match requests does, or if it has
+// none, and a match request matches if all of its conditions do. Like in
+// Istio, ignoreUriCase only applies to the exact and prefix URI matches, and
+// the regular expressions must match the whole value.
+package vsmatch
+
+import (
+	"net"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/labels"
+
+	"github.com/banzaicloud/istio-client-go/pkg/common/v1alpha1"
+	"github.com/banzaicloud/istio-client-go/pkg/host"
+	networkingv1beta1 "github.com/banzaicloud/istio-client-go/pkg/networking/v1beta1"
+)
+
+// Request are the attributes of a request the routes match.
+type Request struct {
+	// Method is the HTTP method of the request
+	Method string
+	// Scheme is the scheme of the request, e.g. http
+	Scheme string
+	// Authority is the host, or authority, of the request, with or without
+	// a port
+	Authority string
+	// Path is the path of the request, with its query string if any
+	Path string
+	// Headers are the headers of the request
+	Headers map[string]string
+	// SourceLabels are the labels of the workload sending the request
+	SourceLabels map[string]string
+	// Port is the port the request is sent to
+	Port uint32
+	// Gateway is the gateway the request goes through, in the
+	// namespace/name format, or mesh for the sidecars, the default
+	Gateway string
+}
+
+// Result is the route Istio picks for a request.
+type Result struct {
+	// Route is the route picked
+	Route *networkingv1beta1.HTTPRoute
+	// Index is the index of the route in the HTTP routes of the virtual
+	// service
+	Index int
+	// Match is the match request of the route which matched, nil if the
+	// route has none
+	Match *networkingv1beta1.HTTPMatchRequest
+}
+
+// Destination returns the destination of the route most requests are sent
+// to, the first of those with the highest weight, or nil if the route has no
+// destination, e.g. since it redirects.
+func (r Result) Destination() *networkingv1beta1.HTTPRouteDestination {
+	var picked *networkingv1beta1.HTTPRouteDestination
+	var pickedWeight int32
+	for _, destination := range r.Route.Route {
+		if destination == nil {
+			continue
+		}
+		weight := int32(0)
+		if destination.Weight != nil {
+			weight = *destination.Weight
+		} else if len(r.Route.Route) == 1 {
+			weight = 100
+		}
+		if picked == nil || weight > pickedWeight {
+			picked, pickedWeight = destination, weight
+		}
+	}
+
+	return picked
+}
+
+// Resolve returns the HTTP route of the virtual service Istio picks for the
+// request, or false if the virtual service does not apply to the request or
+// none of its routes matches it.
+func Resolve(vs *networkingv1beta1.VirtualService, req Request) (Result, bool) {
+	gateway := req.Gateway
+	if gateway == "" {
+		gateway = networkingv1beta1.MeshGateway
+	}
+	if !matchAuthority(vs, req.Authority) {
+		return Result{}, false
+	}
+
+	for i := range vs.Spec.HTTP {
+		route := &vs.Spec.HTTP[i]
+		if len(route.Match) == 0 {
+			if matchGateway(vs.Spec.Gateways, vs.Namespace, gateway) {
+				return Result{Route: route, Index: i}, true
+			}
+			continue
+		}
+		for _, match := range route.Match {
+			if match == nil {
+				continue
+			}
+			gateways := vs.Spec.Gateways
+			if len(match.Gateways) > 0 {
+				gateways = match.Gateways
+			}
+			if matchGateway(gateways, vs.Namespace, gateway) && matchRequest(match, req) {
+				return Result{Route: route, Index: i, Match: match}, true
+			}
+		}
+	}
+
+	return Result{}, false
+}
+
+// matchAuthority returns true if a host of the virtual service matches the
+// authority of the request, ignoring its port. The requests without an
+// authority match any virtual service.
+func matchAuthority(vs *networkingv1beta1.VirtualService, authority string) bool {
+	if authority == "" {
+		return true
+	}
+	if h, _, err := net.SplitHostPort(authority); err == nil {
+		authority = h
+	}
+	for _, h := range vs.Spec.Hosts {
+		if host.SubsetOf(host.Resolve(authority, vs.Namespace), host.Resolve(h, vs.Namespace)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// matchGateway returns true if the gateway is one of the gateways, mesh if
+// there are none.
+func matchGateway(gateways []string, namespace, gateway string) bool {
+	if len(gateways) == 0 {
+		return gateway == networkingv1beta1.MeshGateway
+	}
+	for _, g := range gateways {
+		if networkingv1beta1.ResolveGateway(g, namespace) == gateway {
+			return true
+		}
+	}
+
+	return false
+}
+
+func matchRequest(m *networkingv1beta1.HTTPMatchRequest, req Request) bool {
+	path, query := req.Path, url.Values{}
+	if i := strings.Index(path, "?"); i >= 0 {
+		query, _ = url.ParseQuery(path[i+1:])
+		path = path[:i]
+	}
+
+	ignoreCase := m.IgnoreURICase != nil && *m.IgnoreURICase
+	if m.URI != nil && !matchString(m.URI, path, ignoreCase) {
+		return false
+	}
+	if m.Scheme != nil && !matchString(m.Scheme, req.Scheme, false) {
+		return false
+	}
+	if m.Method != nil && !matchString(m.Method, req.Method, false) {
+		return false
+	}
+	if m.Authority != nil && !matchString(m.Authority, req.Authority, false) {
+		return false
+	}
+	if m.Port != nil && *m.Port != req.Port {
+		return false
+	}
+	for name, match := range m.Headers {
+		value, ok := header(req.Headers, name)
+		if !ok || !matchString(&match, value, false) {
+			return false
+		}
+	}
+	for name, match := range m.QueryParams {
+		values, ok := query[name]
+		if !ok || match == nil || !matchString(match, values[0], false) {
+			return false
+		}
+	}
+	if len(m.SourceLabels) > 0 && !labels.SelectorFromSet(m.SourceLabels).Matches(labels.Set(req.SourceLabels)) {
+		return false
+	}
+
+	return true
+}
+
+// header returns the value of the header, whose name is case insensitive.
+func header(headers map[string]string, name string) (string, bool) {
+	for key, value := range headers {
+		if strings.EqualFold(key, name) {
+			return value, true
+		}
+	}
+
+	return "", false
+}
+
+// matchString returns true if the value matches the string match. The empty
+// match matches any value, which is how Istio matches the presence of the
+// headers.
+func matchString(m *v1alpha1.StringMatch, value string, ignoreCase bool) bool {
+	switch {
+	case m.Exact != "":
+		if ignoreCase {
+			return strings.EqualFold(m.Exact, value)
+		}
+		return m.Exact == value
+	case m.Prefix != "":
+		if ignoreCase {
+			return strings.HasPrefix(strings.ToLower(value), strings.ToLower(m.Prefix))
+		}
+		return strings.HasPrefix(value, m.Prefix)
+	case m.Suffix != "":
+		return strings.HasSuffix(value, m.Suffix)
+	case m.Regex != "":
+		re, err := regexp.Compile("^(?:" + m.Regex + ")$")
+		return err == nil && re.MatchString(value)
+	default:
+		return true
+	}
+}
@@ -0,0 +1,166 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vsmatch
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/banzaicloud/istio-client-go/pkg/common/v1alpha1"
+	networkingv1beta1 "github.com/banzaicloud/istio-client-go/pkg/networking/v1beta1"
+)
+
+func destination(host, subset string, weight int32) *networkingv1beta1.HTTPRouteDestination {
+	d := &networkingv1beta1.HTTPRouteDestination{Destination: &networkingv1beta1.Destination{Host: host}}
+	if subset != "" {
+		d.Destination.Subset = &subset
+	}
+	if weight != 0 {
+		d.Weight = &weight
+	}
+
+	return d
+}
+
+func TestResolve(t *testing.T) {
+	ignoreCase := true
+	port := uint32(9080)
+	vs := &networkingv1beta1.VirtualService{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "prod", Name: "reviews"},
+		Spec: networkingv1beta1.VirtualServiceSpec{
+			Hosts:    []string{"reviews", "reviews.example.com"},
+			Gateways: []string{"mesh", "istio-system/public"},
+			HTTP: []networkingv1beta1.HTTPRoute{
+				{
+					Match: []*networkingv1beta1.HTTPMatchRequest{
+						{Headers: map[string]v1alpha1.StringMatch{"end-user": {Exact: "jason"}}},
+						{QueryParams: map[string]*v1alpha1.StringMatch{"user": v1alpha1.Regex("ja.*")}},
+					},
+					Route: []*networkingv1beta1.HTTPRouteDestination{destination("reviews", "v2", 0)},
+				},
+				{
+					Match: []*networkingv1beta1.HTTPMatchRequest{
+						{URI: v1alpha1.Prefix("/Admin"), IgnoreURICase: &ignoreCase, Method: v1alpha1.Exact("POST")},
+					},
+					Route: []*networkingv1beta1.HTTPRouteDestination{destination("admin", "", 0)},
+				},
+				{
+					Match: []*networkingv1beta1.HTTPMatchRequest{
+						{SourceLabels: map[string]string{"app": "productpage"}, Port: &port},
+					},
+					Route: []*networkingv1beta1.HTTPRouteDestination{destination("reviews", "v3", 0)},
+				},
+				{
+					Match: []*networkingv1beta1.HTTPMatchRequest{
+						{Gateways: []string{"istio-system/public"}},
+					},
+					Route: []*networkingv1beta1.HTTPRouteDestination{destination("reviews", "public", 0)},
+				},
+				{
+					Route: []*networkingv1beta1.HTTPRouteDestination{
+						destination("reviews", "v1", 20),
+						destination("reviews", "v4", 80),
+					},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name   string
+		req    Request
+		index  int
+		subset string
+		found  bool
+	}{
+		{
+			name:   "header",
+			req:    Request{Authority: "reviews:9080", Path: "/", Headers: map[string]string{"End-User": "jason"}},
+			index:  0,
+			subset: "v2",
+			found:  true,
+		},
+		{
+			name:   "query parameter",
+			req:    Request{Authority: "reviews.prod.svc.cluster.local", Path: "/reviews?user=jane"},
+			index:  0,
+			subset: "v2",
+			found:  true,
+		},
+		{
+			name:   "partial regex",
+			req:    Request{Authority: "reviews", Path: "/reviews?user=ajax"},
+			index:  4,
+			subset: "v4",
+			found:  true,
+		},
+		{
+			name:  "ignored uri case",
+			req:   Request{Authority: "reviews", Method: "POST", Path: "/admin/users"},
+			index: 1,
+			found: true,
+		},
+		{
+			name:   "method",
+			req:    Request{Authority: "reviews", Method: "GET", Path: "/admin/users"},
+			index:  4,
+			subset: "v4",
+			found:  true,
+		},
+		{
+			name:   "source labels and port",
+			req:    Request{Authority: "reviews", Path: "/", SourceLabels: map[string]string{"app": "productpage", "version": "v1"}, Port: 9080},
+			index:  2,
+			subset: "v3",
+			found:  true,
+		},
+		{
+			name:   "gateway",
+			req:    Request{Authority: "reviews.example.com", Path: "/", Gateway: "istio-system/public"},
+			index:  3,
+			subset: "public",
+			found:  true,
+		},
+		{
+			name: "other gateway",
+			req:  Request{Authority: "reviews.example.com", Path: "/", Gateway: "istio-system/private"},
+		},
+		{
+			name: "other host",
+			req:  Request{Authority: "ratings", Path: "/"},
+		},
+	}
+
+	for _, test := range tests {
+		result, found := Resolve(vs, test.req)
+		if found != test.found {
+			t.Errorf("%s: unexpected result %t", test.name, found)
+			continue
+		}
+		if !found {
+			continue
+		}
+		if result.Index != test.index || result.Route != &vs.Spec.HTTP[test.index] {
+			t.Errorf("%s: unexpected route %d, expected %d", test.name, result.Index, test.index)
+		}
+		if test.subset == "" {
+			continue
+		}
+		if d := result.Destination(); d == nil || d.Destination.Subset == nil || *d.Destination.Subset != test.subset {
+			t.Errorf("%s: unexpected destination %+v, expected subset %s", test.name, d, test.subset)
+		}
+	}
+}
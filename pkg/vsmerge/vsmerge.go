@@ -0,0 +1,214 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package vsmerge merges the virtual services defining the same host the way
+// Istio does, so that users can preview the effective routing table of a
+// gateway or of the sidecars, e.g.
+//
+//	tables := vsmerge.Merge(virtualServices, "istio-system/ingress")
+//
+// The virtual services are applied from the oldest to the newest. For a
+// gateway, the routes of every virtual service of a host are appended to the
+// routing table of the host in that order. For the sidecars of the mesh, only
+// the oldest virtual service of a host is used, and the others are ignored.
+//
+// Since the routes are matched in order, a route matching every request of a
+// virtual service shadows the routes of the virtual services merged after it,
+// which are reported as such. The delegating routes are expected to be
+// flattened first, see the delegate package.
+package vsmerge
+
+import (
+	"sort"
+	"strings"
+
+	hostname "github.com/banzaicloud/istio-client-go/pkg/host"
+	networkingv1beta1 "github.com/banzaicloud/istio-client-go/pkg/networking/v1beta1"
+)
+
+// HTTPRoute is an HTTP route of a routing table and the virtual service it
+// comes from.
+type HTTPRoute struct {
+	networkingv1beta1.HTTPRoute
+	// VirtualService is the virtual service of the route
+	VirtualService *networkingv1beta1.VirtualService
+	// Index is the index of the route in the HTTP routes of the virtual
+	// service
+	Index int
+}
+
+// TLSRoute is a TLS route of a routing table and the virtual service it
+// comes from.
+type TLSRoute struct {
+	networkingv1beta1.TLSRoute
+	// VirtualService is the virtual service of the route
+	VirtualService *networkingv1beta1.VirtualService
+}
+
+// TCPRoute is a TCP route of a routing table and the virtual service it
+// comes from.
+type TCPRoute struct {
+	networkingv1beta1.TCPRoute
+	// VirtualService is the virtual service of the route
+	VirtualService *networkingv1beta1.VirtualService
+}
+
+// Table is the routing table of a host of a gateway, or of the mesh.
+type Table struct {
+	// Host is the fully qualified name of the host
+	Host string
+	// VirtualServices are the virtual services the routes come from, in the
+	// order they are merged
+	VirtualServices []*networkingv1beta1.VirtualService
+	// Ignored are the virtual services of the host which are not used, since
+	// the sidecars only use the oldest one
+	Ignored []*networkingv1beta1.VirtualService
+	// HTTP are the HTTP routes of the host, in the order they are matched
+	HTTP []HTTPRoute
+	// TLS are the TLS routes of the host
+	TLS []TLSRoute
+	// TCP are the TCP routes of the host
+	TCP []TCPRoute
+	// Shadowed are the HTTP routes which are never matched, since they come
+	// after a route matching every request
+	Shadowed []HTTPRoute
+}
+
+// Merge returns the routing tables of the hosts of the virtual services for
+// the gateway, in the namespace/name format, or for the mesh, ordered by host.
+// Only the virtual services and the HTTP route matches bound to the gateway
+// are merged. Short host names are resolved in the namespace of the virtual
+// service, assuming the DefaultDomainSuffix.
+func Merge(virtualServices []*networkingv1beta1.VirtualService, gateway string) []Table {
+	var sorted []*networkingv1beta1.VirtualService
+	for _, vs := range virtualServices {
+		if vs != nil && boundTo(vs.Spec.Gateways, vs.Namespace, gateway) {
+			sorted = append(sorted, vs)
+		}
+	}
+	sort.SliceStable(sorted, func(i, j int) bool {
+		a, b := sorted[i], sorted[j]
+		if !a.CreationTimestamp.Equal(&b.CreationTimestamp) {
+			return a.CreationTimestamp.Before(&b.CreationTimestamp)
+		}
+		if a.Name != b.Name {
+			return a.Name < b.Name
+		}
+		return a.Namespace < b.Namespace
+	})
+
+	tables := map[string]*Table{}
+	for _, vs := range sorted {
+		seen := map[string]bool{}
+		for _, host := range vs.Spec.Hosts {
+			host = strings.ToLower(hostname.Resolve(host, vs.Namespace))
+			if seen[host] {
+				continue
+			}
+			seen[host] = true
+
+			table, ok := tables[host]
+			if !ok {
+				table = &Table{Host: host}
+				tables[host] = table
+			}
+			if gateway == networkingv1beta1.MeshGateway && len(table.VirtualServices) > 0 {
+				table.Ignored = append(table.Ignored, vs)
+				continue
+			}
+			table.add(vs, gateway)
+		}
+	}
+
+	out := make([]Table, 0, len(tables))
+	for _, table := range tables {
+		out = append(out, *table)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Host < out[j].Host })
+
+	return out
+}
+
+// add appends the routes of the virtual service bound to the gateway to the
+// table.
+func (t *Table) add(vs *networkingv1beta1.VirtualService, gateway string) {
+	t.VirtualServices = append(t.VirtualServices, vs)
+	for i := range vs.Spec.HTTP {
+		route, ok := bind(vs.Spec.HTTP[i], vs, gateway)
+		if !ok {
+			continue
+		}
+		r := HTTPRoute{HTTPRoute: *route, VirtualService: vs, Index: i}
+		if len(t.Shadowed) > 0 || (len(t.HTTP) > 0 && catchAll(&t.HTTP[len(t.HTTP)-1].HTTPRoute)) {
+			t.Shadowed = append(t.Shadowed, r)
+		}
+		t.HTTP = append(t.HTTP, r)
+	}
+	for i := range vs.Spec.TLS {
+		t.TLS = append(t.TLS, TLSRoute{TLSRoute: *vs.Spec.TLS[i].DeepCopy(), VirtualService: vs})
+	}
+	for i := range vs.Spec.TCP {
+		t.TCP = append(t.TCP, TCPRoute{TCPRoute: *vs.Spec.TCP[i].DeepCopy(), VirtualService: vs})
+	}
+}
+
+// bind returns a copy of the route with only its matches bound to the
+// gateway, or false if it has matches and none is bound to it.
+func bind(route networkingv1beta1.HTTPRoute, vs *networkingv1beta1.VirtualService, gateway string) (*networkingv1beta1.HTTPRoute, bool) {
+	out := route.DeepCopy()
+	if len(route.Match) == 0 {
+		return out, true
+	}
+
+	matches := out.Match
+	out.Match = nil
+	for _, match := range matches {
+		if match != nil && (len(match.Gateways) == 0 || boundTo(match.Gateways, vs.Namespace, gateway)) {
+			out.Match = append(out.Match, match)
+		}
+	}
+
+	return out, len(out.Match) > 0
+}
+
+// boundTo returns true if the gateway is one of the gateways, the mesh if
+// there are none.
+func boundTo(gateways []string, namespace, gateway string) bool {
+	if len(gateways) == 0 {
+		return gateway == networkingv1beta1.MeshGateway
+	}
+	for _, g := range gateways {
+		if networkingv1beta1.ResolveGateway(g, namespace) == gateway {
+			return true
+		}
+	}
+
+	return false
+}
+
+// catchAll returns true if the route matches every request: it has no
+// matches, or a match without any condition.
+func catchAll(route *networkingv1beta1.HTTPRoute) bool {
+	if len(route.Match) == 0 {
+		return true
+	}
+	for _, match := range route.Match {
+		if match != nil && match.URI == nil && match.Scheme == nil && match.Method == nil && match.Authority == nil &&
+			len(match.Headers) == 0 && match.Port == nil && len(match.SourceLabels) == 0 && len(match.QueryParams) == 0 {
+			return true
+		}
+	}
+
+	return false
+}
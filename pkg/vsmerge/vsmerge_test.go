@@ -0,0 +1,118 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vsmerge
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/banzaicloud/istio-client-go/pkg/common/v1alpha1"
+	networkingv1beta1 "github.com/banzaicloud/istio-client-go/pkg/networking/v1beta1"
+)
+
+var created = time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func virtualService(name string, age int, hosts, gateways []string, routes ...networkingv1beta1.HTTPRoute) *networkingv1beta1.VirtualService {
+	return &networkingv1beta1.VirtualService{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:         "prod",
+			Name:              name,
+			CreationTimestamp: metav1.NewTime(created.Add(-time.Duration(age) * time.Hour)),
+		},
+		Spec: networkingv1beta1.VirtualServiceSpec{Hosts: hosts, Gateways: gateways, HTTP: routes},
+	}
+}
+
+func route(host string, matches ...*networkingv1beta1.HTTPMatchRequest) networkingv1beta1.HTTPRoute {
+	return networkingv1beta1.HTTPRoute{
+		Match: matches,
+		Route: []*networkingv1beta1.HTTPRouteDestination{{Destination: &networkingv1beta1.Destination{Host: host}}},
+	}
+}
+
+// summary returns the virtual services and the routes of the tables, e.g.
+// bookinfo.com: [api products] [api/0 products/0].
+func summary(tables []Table) []string {
+	var out []string
+	for _, table := range tables {
+		var services, ignored, routes, shadowed []string
+		for _, vs := range table.VirtualServices {
+			services = append(services, vs.Name)
+		}
+		for _, vs := range table.Ignored {
+			ignored = append(ignored, vs.Name)
+		}
+		for _, r := range table.HTTP {
+			routes = append(routes, fmt.Sprintf("%s/%d", r.VirtualService.Name, r.Index))
+		}
+		for _, r := range table.Shadowed {
+			shadowed = append(shadowed, fmt.Sprintf("%s/%d", r.VirtualService.Name, r.Index))
+		}
+		out = append(out, fmt.Sprintf("%s: %v %v %v %v", table.Host, services, ignored, routes, shadowed))
+	}
+
+	return out
+}
+
+func TestMerge(t *testing.T) {
+	gateway := []string{"istio-system/public"}
+	api := virtualService("api", 2, []string{"bookinfo.com"}, gateway,
+		route("api", &networkingv1beta1.HTTPMatchRequest{URI: v1alpha1.Prefix("/api")}),
+	)
+	products := virtualService("products", 1, []string{"bookinfo.com", "reviews"}, append(gateway, "mesh"),
+		route("products", &networkingv1beta1.HTTPMatchRequest{URI: v1alpha1.Prefix("/products"), Gateways: []string{"mesh"}}),
+		route("products"),
+	)
+	catchAll := virtualService("catch-all", 0, []string{"bookinfo.com"}, gateway, route("catch-all"))
+	reviews := virtualService("reviews", 0, []string{"reviews.prod.svc.cluster.local"}, nil, route("reviews"))
+	services := []*networkingv1beta1.VirtualService{reviews, catchAll, products, api}
+
+	tests := []struct {
+		gateway  string
+		expected []string
+	}{
+		{
+			gateway: "istio-system/public",
+			expected: []string{
+				"bookinfo.com: [api products catch-all] [] [api/0 products/1 catch-all/0] [catch-all/0]",
+				"reviews.prod.svc.cluster.local: [products] [] [products/1] []",
+			},
+		},
+		{
+			gateway: "mesh",
+			expected: []string{
+				"bookinfo.com: [products] [] [products/0 products/1] []",
+				"reviews.prod.svc.cluster.local: [products] [reviews] [products/0 products/1] []",
+			},
+		},
+		{
+			gateway: "istio-system/private",
+		},
+	}
+
+	for _, test := range tests {
+		if s := summary(Merge(services, test.gateway)); !reflect.DeepEqual(s, test.expected) {
+			t.Errorf("%s: unexpected tables\n%q\nexpected\n%q", test.gateway, s, test.expected)
+		}
+	}
+
+	if products.Spec.HTTP[0].Match[0].Gateways == nil {
+		t.Error("the virtual service was modified")
+	}
+}